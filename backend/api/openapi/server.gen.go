@@ -13,6 +13,12 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Получить журнал движения денег
+	// (GET /admin/payments/ledger)
+	ListLedgerEntries(ctx echo.Context, params ListLedgerEntriesParams) error
+	// Найти оплаты, расходящиеся с журналом
+	// (GET /admin/payments/unbalanced)
+	ListUnbalancedPayments(ctx echo.Context) error
 	// Выполнить вход по email и паролю
 	// (POST /auth/login)
 	LoginUser(ctx echo.Context) error
@@ -22,18 +28,312 @@ type ServerInterface interface {
 	// Зарегистрировать нового пользователя
 	// (POST /auth/register)
 	RegisterUser(ctx echo.Context) error
+	// Получить бронирование
+	// (GET /bookings/{id})
+	GetBookingById(ctx echo.Context, id int) error
+	// Скачать занятие в формате iCalendar
+	// (GET /bookings/{id}/calendar.ics)
+	GetBookingCalendar(ctx echo.Context, id int) error
+	// Отменить занятие
+	// (POST /bookings/{id}/cancel)
+	CancelBooking(ctx echo.Context, id int) error
+	// Отметить занятие проведенным
+	// (POST /bookings/{id}/complete)
+	CompleteBooking(ctx echo.Context, id int) error
+	// Подтвердить занятие
+	// (POST /bookings/{id}/confirm)
+	ConfirmBooking(ctx echo.Context, id int) error
+	// Получить домашние задания по занятию
+	// (GET /bookings/{id}/homework)
+	ListBookingHomework(ctx echo.Context, id int) error
+	// Выдать домашнее задание
+	// (POST /bookings/{id}/homework)
+	AssignHomework(ctx echo.Context, id int) error
+	// Отметить неявку ученика
+	// (POST /bookings/{id}/no-show)
+	MarkBookingNoShow(ctx echo.Context, id int) error
+	// Получить заметки о занятии
+	// (GET /bookings/{id}/notes)
+	ListSessionNotes(ctx echo.Context, id int) error
+	// Оставить заметку о занятии
+	// (POST /bookings/{id}/notes)
+	CreateSessionNote(ctx echo.Context, id int) error
+	// Получить оплату занятия
+	// (GET /bookings/{id}/payment)
+	GetBookingPayment(ctx echo.Context, id int) error
+	// Оплатить занятие
+	// (POST /bookings/{id}/payment)
+	CreateBookingPayment(ctx echo.Context, id int) error
+	// Перенести занятие
+	// (POST /bookings/{id}/reschedule)
+	RescheduleBooking(ctx echo.Context, id int) error
+	// Оставить отзыв о занятии
+	// (POST /bookings/{id}/review)
+	CreateReview(ctx echo.Context, id int) error
+	// Лента занятий для подписки в календаре
+	// (GET /calendar/feeds/{token})
+	GetCalendarFeed(ctx echo.Context, token string) error
+	// Получить диалоги текущего пользователя
+	// (GET /conversations)
+	ListConversations(ctx echo.Context, params ListConversationsParams) error
+	// Получить историю сообщений
+	// (GET /conversations/{id}/messages)
+	ListMessages(ctx echo.Context, id int, params ListMessagesParams) error
+	// Отправить сообщение
+	// (POST /conversations/{id}/messages)
+	SendMessage(ctx echo.Context, id int) error
+	// Отметить диалог прочитанным
+	// (POST /conversations/{id}/read)
+	MarkConversationRead(ctx echo.Context, id int) error
+	// Получить предстоящие события
+	// (GET /events)
+	ListEvents(ctx echo.Context, params ListEventsParams) error
+	// Получить событие
+	// (GET /events/{id})
+	GetEventById(ctx echo.Context, id int) error
+	// Изменить событие
+	// (PUT /events/{id})
+	UpdateEvent(ctx echo.Context, id int) error
+	// Отменить событие
+	// (POST /events/{id}/cancel)
+	CancelEvent(ctx echo.Context, id int) error
+	// Получить участников события
+	// (GET /events/{id}/participants)
+	ListEventParticipants(ctx echo.Context, id int) error
+	// Отметить посещение события
+	// (PUT /events/{id}/participants/{userId}/attendance)
+	MarkEventAttendance(ctx echo.Context, id int, userId int) error
+	// Отменить регистрацию на событие
+	// (DELETE /events/{id}/registration)
+	CancelEventRegistration(ctx echo.Context, id int) error
+	// Зарегистрироваться на событие
+	// (POST /events/{id}/registration)
+	RegisterForEvent(ctx echo.Context, id int) error
+	// Удалить домашнее задание
+	// (DELETE /homework/{id})
+	DeleteHomework(ctx echo.Context, id int) error
+	// Получить домашнее задание
+	// (GET /homework/{id})
+	GetHomework(ctx echo.Context, id int) error
 	// Получить список менторов
 	// (GET /mentors)
 	ListMentors(ctx echo.Context, params ListMentorsParams) error
+	// Подать заявку на менторство
+	// (POST /mentors/applications)
+	SubmitMentorApplication(ctx echo.Context) error
+	// Получить свои заявки на менторство
+	// (GET /mentors/applications/me)
+	ListMyMentorApplications(ctx echo.Context) error
+	// Обновить свою карточку ментора
+	// (PUT /mentors/me)
+	UpdateMyMentorProfile(ctx echo.Context) error
+	// Получить свое расписание
+	// (GET /mentors/me/availability)
+	GetMyAvailability(ctx echo.Context) error
+	// Обновить свое расписание
+	// (PUT /mentors/me/availability)
+	UpdateMyAvailability(ctx echo.Context) error
+	// Добавить исключение в расписание
+	// (POST /mentors/me/availability/exceptions)
+	CreateAvailabilityException(ctx echo.Context) error
+	// Удалить исключение из расписания
+	// (DELETE /mentors/me/availability/exceptions/{id})
+	DeleteAvailabilityException(ctx echo.Context, id int) error
+	// Получить бронирования своих учеников
+	// (GET /mentors/me/bookings)
+	ListMentorBookings(ctx echo.Context, params ListMentorBookingsParams) error
+	// Получить дашборд ментора
+	// (GET /mentors/me/dashboard)
+	GetMentorDashboard(ctx echo.Context, params GetMentorDashboardParams) error
+	// Получить свои события
+	// (GET /mentors/me/events)
+	ListMyHostedEvents(ctx echo.Context, params ListMyHostedEventsParams) error
+	// Создать событие
+	// (POST /mentors/me/events)
+	CreateEvent(ctx echo.Context) error
+	// Получить выданные домашние задания
+	// (GET /mentors/me/homework)
+	ListMentorHomework(ctx echo.Context, params ListMentorHomeworkParams) error
+	// Получить свои подтверждения опыта
+	// (GET /mentors/me/verifications)
+	ListMyVerifications(ctx echo.Context) error
+	// Отправить подтверждение опыта на проверку
+	// (POST /mentors/me/verifications)
+	SubmitVerification(ctx echo.Context) error
+	// Отозвать свое подтверждение до проверки
+	// (DELETE /mentors/me/verifications/{id})
+	WithdrawVerification(ctx echo.Context, id int) error
+	// Подобрать менторов под слабые темы
+	// (GET /mentors/recommended)
+	ListRecommendedMentors(ctx echo.Context, params ListRecommendedMentorsParams) error
+	// Получить профиль ментора
+	// (GET /mentors/{id})
+	GetMentorById(ctx echo.Context, id int) error
+	// Забронировать занятие с ментором
+	// (POST /mentors/{id}/bookings)
+	CreateBooking(ctx echo.Context, id int) error
+	// Запретить ментору видеть свой прогресс
+	// (DELETE /mentors/{id}/progress-sharing)
+	UnshareProgressWithMentor(ctx echo.Context, id int) error
+	// Разрешить ментору видеть свой прогресс
+	// (PUT /mentors/{id}/progress-sharing)
+	ShareProgressWithMentor(ctx echo.Context, id int) error
+	// Получить отзывы о менторе
+	// (GET /mentors/{id}/reviews)
+	ListMentorReviews(ctx echo.Context, id int, params ListMentorReviewsParams) error
+	// Получить свободные слоты ментора
+	// (GET /mentors/{id}/slots)
+	ListMentorSlots(ctx echo.Context, id int, params ListMentorSlotsParams) error
+	// Получить очередь заявок на менторство
+	// (GET /moderation/mentor-applications)
+	ListMentorApplications(ctx echo.Context, params ListMentorApplicationsParams) error
+	// Одобрить заявку на менторство
+	// (POST /moderation/mentor-applications/{id}/approve)
+	ApproveMentorApplication(ctx echo.Context, id int) error
+	// Отклонить заявку на менторство
+	// (POST /moderation/mentor-applications/{id}/reject)
+	RejectMentorApplication(ctx echo.Context, id int) error
+	// Получить очередь предложенных вопросов
+	// (GET /moderation/questions)
+	ListQuestionSubmissions(ctx echo.Context, params ListQuestionSubmissionsParams) error
+	// Исправить вопрос из очереди
+	// (PUT /moderation/questions/{id})
+	EditQuestionSubmission(ctx echo.Context, id int) error
+	// Опубликовать предложенный вопрос
+	// (POST /moderation/questions/{id}/approve)
+	ApproveQuestionSubmission(ctx echo.Context, id int) error
+	// Объединить предложенный вопрос с опубликованным
+	// (POST /moderation/questions/{id}/merge)
+	MergeQuestionSubmission(ctx echo.Context, id int) error
+	// Отклонить предложенный вопрос
+	// (POST /moderation/questions/{id}/reject)
+	RejectQuestionSubmission(ctx echo.Context, id int) error
+	// Получить очередь жалоб на отзывы
+	// (GET /moderation/review-reports)
+	ListReviewReports(ctx echo.Context, params ListReviewReportsParams) error
+	// Отклонить жалобу на отзыв
+	// (POST /moderation/review-reports/{id}/dismiss)
+	DismissReviewReport(ctx echo.Context, id int) error
+	// Скрыть отзыв
+	// (POST /moderation/reviews/{id}/hide)
+	HideReview(ctx echo.Context, id int) error
+	// Вернуть отзыв в публикацию
+	// (POST /moderation/reviews/{id}/restore)
+	RestoreReview(ctx echo.Context, id int) error
+	// Получить очередь подтверждений опыта менторов
+	// (GET /moderation/verifications)
+	ListVerifications(ctx echo.Context, params ListVerificationsParams) error
+	// Отклонить утверждение ментора
+	// (POST /moderation/verifications/{id}/reject)
+	RejectVerification(ctx echo.Context, id int) error
+	// Отозвать действующее подтверждение
+	// (POST /moderation/verifications/{id}/revoke)
+	RevokeVerification(ctx echo.Context, id int) error
+	// Подтвердить утверждение ментора
+	// (POST /moderation/verifications/{id}/verify)
+	VerifyVerification(ctx echo.Context, id int) error
+	// Принять событие платежного провайдера
+	// (POST /payments/webhook)
+	HandlePaymentWebhook(ctx echo.Context) error
 	// Получить список всех вопросов
 	// (GET /questions)
 	ListQuestions(ctx echo.Context, params ListQuestionsParams) error
+	// Создать вопрос
+	// (POST /questions)
+	CreateQuestion(ctx echo.Context) error
+	// Экспортировать вопросы в файл
+	// (GET /questions/export)
+	ExportQuestions(ctx echo.Context, params ExportQuestionsParams) error
+	// Импортировать вопросы из файла
+	// (POST /questions/import)
+	ImportQuestions(ctx echo.Context, params ImportQuestionsParams) error
+	// Полнотекстовый поиск вопросов
+	// (GET /questions/search)
+	SearchQuestions(ctx echo.Context, params SearchQuestionsParams) error
+	// Предложить вопрос
+	// (POST /questions/submissions)
+	SubmitQuestion(ctx echo.Context) error
+	// Мои предложенные вопросы
+	// (GET /questions/submissions/me)
+	ListMyQuestionSubmissions(ctx echo.Context) error
+	// Исправить свой предложенный вопрос
+	// (PUT /questions/submissions/{id})
+	UpdateQuestionSubmission(ctx echo.Context, id int) error
+	// Удалить вопрос
+	// (DELETE /questions/{id})
+	DeleteQuestion(ctx echo.Context, id int) error
 	// Получить вопрос по ID
 	// (GET /questions/{id})
 	GetQuestionById(ctx echo.Context, id int) error
+	// Изменить вопрос
+	// (PUT /questions/{id})
+	UpdateQuestion(ctx echo.Context, id int) error
+	// Ответить на вопрос
+	// (POST /questions/{id}/answers)
+	SubmitQuestionAnswer(ctx echo.Context, id int) error
+	// Сравнить ревизии вопроса
+	// (GET /questions/{id}/diff)
+	DiffQuestionRevisions(ctx echo.Context, id int, params DiffQuestionRevisionsParams) error
+	// История правок вопроса
+	// (GET /questions/{id}/revisions)
+	ListQuestionRevisions(ctx echo.Context, id int) error
+	// Откатить вопрос к ревизии
+	// (POST /questions/{id}/revisions/{revision}/rollback)
+	RollbackQuestion(ctx echo.Context, id int, revision int) error
+	// Ответить на отзыв
+	// (POST /reviews/{id}/reply)
+	ReplyToReview(ctx echo.Context, id int) error
+	// Пожаловаться на отзыв
+	// (POST /reviews/{id}/report)
+	ReportReview(ctx echo.Context, id int) error
+	// Удалить заметку о занятии
+	// (DELETE /session-notes/{id})
+	DeleteSessionNote(ctx echo.Context, id int) error
+	// Изменить заметку о занятии
+	// (PUT /session-notes/{id})
+	UpdateSessionNote(ctx echo.Context, id int) error
+	// Получить список технологий
+	// (GET /technologies)
+	ListTechnologies(ctx echo.Context) error
 	// Получить профиль текущего пользователя
 	// (GET /users/me)
 	GetCurrentUser(ctx echo.Context) error
+	// Получить свои бронирования
+	// (GET /users/me/bookings)
+	ListMyBookings(ctx echo.Context, params ListMyBookingsParams) error
+	// Отключить ленту календаря
+	// (DELETE /users/me/calendar-feed)
+	DeleteCalendarFeed(ctx echo.Context) error
+	// Получить ссылку на ленту календаря
+	// (POST /users/me/calendar-feed)
+	CreateCalendarFeed(ctx echo.Context) error
+	// Получить события, на которые я зарегистрирован
+	// (GET /users/me/event-registrations)
+	ListMyEvents(ctx echo.Context, params ListMyEventsParams) error
+	// Подписаться на события в реальном времени
+	// (GET /users/me/events)
+	StreamEvents(ctx echo.Context, params StreamEventsParams) error
+	// Получить свои домашние задания
+	// (GET /users/me/homework)
+	ListMyHomework(ctx echo.Context, params ListMyHomeworkParams) error
+	// Получить настройки доставки уведомлений
+	// (GET /users/me/notification-preferences)
+	GetNotificationPreferences(ctx echo.Context) error
+	// Обновить настройки доставки уведомлений
+	// (PUT /users/me/notification-preferences)
+	UpdateNotificationPreferences(ctx echo.Context) error
+	// Получить уведомления текущего пользователя
+	// (GET /users/me/notifications)
+	ListNotifications(ctx echo.Context, params ListNotificationsParams) error
+	// Отметить все уведомления прочитанными
+	// (POST /users/me/notifications/read-all)
+	MarkAllNotificationsRead(ctx echo.Context) error
+	// Отметить уведомление прочитанным
+	// (POST /users/me/notifications/{id}/read)
+	MarkNotificationRead(ctx echo.Context, id int) error
+	// Получить вопросы, которые пора повторить
+	// (GET /users/me/review-queue)
+	GetMyReviewQueue(ctx echo.Context, params GetMyReviewQueueParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -41,44 +341,2037 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// ListLedgerEntries converts echo context to params.
+func (w *ServerInterfaceWrapper) ListLedgerEntries(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLedgerEntriesParams
+	// ------------- Optional query parameter "paymentId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "paymentId", ctx.QueryParams(), &params.PaymentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter paymentId: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListLedgerEntries(ctx, params)
+	return err
+}
+
+// ListUnbalancedPayments converts echo context to params.
+func (w *ServerInterfaceWrapper) ListUnbalancedPayments(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListUnbalancedPayments(ctx)
+	return err
+}
+
 // LoginUser converts echo context to params.
 func (w *ServerInterfaceWrapper) LoginUser(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LoginUser(ctx)
+	err = w.Handler.LoginUser(ctx)
+	return err
+}
+
+// RefreshTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) RefreshTokens(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RefreshTokens(ctx)
+	return err
+}
+
+// RegisterUser converts echo context to params.
+func (w *ServerInterfaceWrapper) RegisterUser(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RegisterUser(ctx)
+	return err
+}
+
+// GetBookingById converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBookingById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBookingById(ctx, id)
+	return err
+}
+
+// GetBookingCalendar converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBookingCalendar(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBookingCalendar(ctx, id)
+	return err
+}
+
+// CancelBooking converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelBooking(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelBooking(ctx, id)
+	return err
+}
+
+// CompleteBooking converts echo context to params.
+func (w *ServerInterfaceWrapper) CompleteBooking(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CompleteBooking(ctx, id)
+	return err
+}
+
+// ConfirmBooking converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmBooking(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmBooking(ctx, id)
+	return err
+}
+
+// ListBookingHomework converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBookingHomework(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBookingHomework(ctx, id)
+	return err
+}
+
+// AssignHomework converts echo context to params.
+func (w *ServerInterfaceWrapper) AssignHomework(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AssignHomework(ctx, id)
+	return err
+}
+
+// MarkBookingNoShow converts echo context to params.
+func (w *ServerInterfaceWrapper) MarkBookingNoShow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MarkBookingNoShow(ctx, id)
+	return err
+}
+
+// ListSessionNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSessionNotes(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSessionNotes(ctx, id)
+	return err
+}
+
+// CreateSessionNote converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSessionNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSessionNote(ctx, id)
+	return err
+}
+
+// GetBookingPayment converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBookingPayment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBookingPayment(ctx, id)
+	return err
+}
+
+// CreateBookingPayment converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateBookingPayment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateBookingPayment(ctx, id)
+	return err
+}
+
+// RescheduleBooking converts echo context to params.
+func (w *ServerInterfaceWrapper) RescheduleBooking(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RescheduleBooking(ctx, id)
+	return err
+}
+
+// CreateReview converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateReview(ctx, id)
+	return err
+}
+
+// GetCalendarFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCalendarFeed(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", ctx.Param("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCalendarFeed(ctx, token)
+	return err
+}
+
+// ListConversations converts echo context to params.
+func (w *ServerInterfaceWrapper) ListConversations(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListConversationsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListConversations(ctx, params)
+	return err
+}
+
+// ListMessages converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMessages(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMessagesParams
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMessages(ctx, id, params)
+	return err
+}
+
+// SendMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) SendMessage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SendMessage(ctx, id)
+	return err
+}
+
+// MarkConversationRead converts echo context to params.
+func (w *ServerInterfaceWrapper) MarkConversationRead(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MarkConversationRead(ctx, id)
+	return err
+}
+
+// ListEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) ListEvents(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEventsParams
+	// ------------- Optional query parameter "mentorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mentorId", ctx.QueryParams(), &params.MentorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mentorId: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListEvents(ctx, params)
+	return err
+}
+
+// GetEventById converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEventById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEventById(ctx, id)
+	return err
+}
+
+// UpdateEvent converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateEvent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateEvent(ctx, id)
+	return err
+}
+
+// CancelEvent converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelEvent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelEvent(ctx, id)
+	return err
+}
+
+// ListEventParticipants converts echo context to params.
+func (w *ServerInterfaceWrapper) ListEventParticipants(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListEventParticipants(ctx, id)
+	return err
+}
+
+// MarkEventAttendance converts echo context to params.
+func (w *ServerInterfaceWrapper) MarkEventAttendance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MarkEventAttendance(ctx, id, userId)
+	return err
+}
+
+// CancelEventRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelEventRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelEventRegistration(ctx, id)
+	return err
+}
+
+// RegisterForEvent converts echo context to params.
+func (w *ServerInterfaceWrapper) RegisterForEvent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RegisterForEvent(ctx, id)
+	return err
+}
+
+// DeleteHomework converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteHomework(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteHomework(ctx, id)
+	return err
+}
+
+// GetHomework converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHomework(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHomework(ctx, id)
+	return err
+}
+
+// ListMentors converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMentors(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMentorsParams
+	// ------------- Optional query parameter "specialization" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "specialization", ctx.QueryParams(), &params.Specialization)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter specialization: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
+	// ------------- Optional query parameter "tagsMode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tagsMode", ctx.QueryParams(), &params.TagsMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagsMode: %s", err))
+	}
+
+	// ------------- Optional query parameter "grade" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "grade", ctx.QueryParams(), &params.Grade)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter grade: %s", err))
+	}
+
+	// ------------- Optional query parameter "minExperience" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minExperience", ctx.QueryParams(), &params.MinExperience)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minExperience: %s", err))
+	}
+
+	// ------------- Optional query parameter "priceMin" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priceMin", ctx.QueryParams(), &params.PriceMin)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priceMin: %s", err))
+	}
+
+	// ------------- Optional query parameter "priceMax" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priceMax", ctx.QueryParams(), &params.PriceMax)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priceMax: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", ctx.QueryParams(), &params.Language)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language: %s", err))
+	}
+
+	// ------------- Optional query parameter "available" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "available", ctx.QueryParams(), &params.Available)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter available: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMentors(ctx, params)
+	return err
+}
+
+// SubmitMentorApplication converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitMentorApplication(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitMentorApplication(ctx)
+	return err
+}
+
+// ListMyMentorApplications converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMyMentorApplications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMyMentorApplications(ctx)
+	return err
+}
+
+// UpdateMyMentorProfile converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMyMentorProfile(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMyMentorProfile(ctx)
+	return err
+}
+
+// GetMyAvailability converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMyAvailability(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMyAvailability(ctx)
+	return err
+}
+
+// UpdateMyAvailability converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMyAvailability(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMyAvailability(ctx)
+	return err
+}
+
+// CreateAvailabilityException converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateAvailabilityException(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateAvailabilityException(ctx)
+	return err
+}
+
+// DeleteAvailabilityException converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAvailabilityException(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAvailabilityException(ctx, id)
+	return err
+}
+
+// ListMentorBookings converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMentorBookings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMentorBookingsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMentorBookings(ctx, params)
+	return err
+}
+
+// GetMentorDashboard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMentorDashboard(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMentorDashboardParams
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", ctx.QueryParams(), &params.Period)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter period: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMentorDashboard(ctx, params)
+	return err
+}
+
+// ListMyHostedEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMyHostedEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMyHostedEventsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMyHostedEvents(ctx, params)
+	return err
+}
+
+// CreateEvent converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateEvent(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateEvent(ctx)
+	return err
+}
+
+// ListMentorHomework converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMentorHomework(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMentorHomeworkParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMentorHomework(ctx, params)
+	return err
+}
+
+// ListMyVerifications converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMyVerifications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMyVerifications(ctx)
+	return err
+}
+
+// SubmitVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitVerification(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitVerification(ctx)
+	return err
+}
+
+// WithdrawVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) WithdrawVerification(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WithdrawVerification(ctx, id)
+	return err
+}
+
+// ListRecommendedMentors converts echo context to params.
+func (w *ServerInterfaceWrapper) ListRecommendedMentors(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRecommendedMentorsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListRecommendedMentors(ctx, params)
+	return err
+}
+
+// GetMentorById converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMentorById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMentorById(ctx, id)
+	return err
+}
+
+// CreateBooking converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateBooking(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateBooking(ctx, id)
+	return err
+}
+
+// UnshareProgressWithMentor converts echo context to params.
+func (w *ServerInterfaceWrapper) UnshareProgressWithMentor(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnshareProgressWithMentor(ctx, id)
+	return err
+}
+
+// ShareProgressWithMentor converts echo context to params.
+func (w *ServerInterfaceWrapper) ShareProgressWithMentor(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ShareProgressWithMentor(ctx, id)
+	return err
+}
+
+// ListMentorReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMentorReviews(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMentorReviewsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMentorReviews(ctx, id, params)
+	return err
+}
+
+// ListMentorSlots converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMentorSlots(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMentorSlotsParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "durationMinutes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "durationMinutes", ctx.QueryParams(), &params.DurationMinutes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter durationMinutes: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMentorSlots(ctx, id, params)
+	return err
+}
+
+// ListMentorApplications converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMentorApplications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMentorApplicationsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMentorApplications(ctx, params)
+	return err
+}
+
+// ApproveMentorApplication converts echo context to params.
+func (w *ServerInterfaceWrapper) ApproveMentorApplication(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApproveMentorApplication(ctx, id)
+	return err
+}
+
+// RejectMentorApplication converts echo context to params.
+func (w *ServerInterfaceWrapper) RejectMentorApplication(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RejectMentorApplication(ctx, id)
+	return err
+}
+
+// ListQuestionSubmissions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListQuestionSubmissions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListQuestionSubmissionsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListQuestionSubmissions(ctx, params)
+	return err
+}
+
+// EditQuestionSubmission converts echo context to params.
+func (w *ServerInterfaceWrapper) EditQuestionSubmission(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EditQuestionSubmission(ctx, id)
+	return err
+}
+
+// ApproveQuestionSubmission converts echo context to params.
+func (w *ServerInterfaceWrapper) ApproveQuestionSubmission(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApproveQuestionSubmission(ctx, id)
+	return err
+}
+
+// MergeQuestionSubmission converts echo context to params.
+func (w *ServerInterfaceWrapper) MergeQuestionSubmission(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MergeQuestionSubmission(ctx, id)
+	return err
+}
+
+// RejectQuestionSubmission converts echo context to params.
+func (w *ServerInterfaceWrapper) RejectQuestionSubmission(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RejectQuestionSubmission(ctx, id)
+	return err
+}
+
+// ListReviewReports converts echo context to params.
+func (w *ServerInterfaceWrapper) ListReviewReports(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReviewReportsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListReviewReports(ctx, params)
+	return err
+}
+
+// DismissReviewReport converts echo context to params.
+func (w *ServerInterfaceWrapper) DismissReviewReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DismissReviewReport(ctx, id)
+	return err
+}
+
+// HideReview converts echo context to params.
+func (w *ServerInterfaceWrapper) HideReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HideReview(ctx, id)
+	return err
+}
+
+// RestoreReview converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreReview(ctx, id)
+	return err
+}
+
+// ListVerifications converts echo context to params.
+func (w *ServerInterfaceWrapper) ListVerifications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVerificationsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListVerifications(ctx, params)
+	return err
+}
+
+// RejectVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) RejectVerification(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RejectVerification(ctx, id)
+	return err
+}
+
+// RevokeVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeVerification(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeVerification(ctx, id)
+	return err
+}
+
+// VerifyVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyVerification(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyVerification(ctx, id)
+	return err
+}
+
+// HandlePaymentWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) HandlePaymentWebhook(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HandlePaymentWebhook(ctx)
+	return err
+}
+
+// ListQuestions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListQuestions(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListQuestionsParams
+	// ------------- Optional query parameter "technology" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "technology", ctx.QueryParams(), &params.Technology)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter technology: %s", err))
+	}
+
+	// ------------- Optional query parameter "technologyMode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "technologyMode", ctx.QueryParams(), &params.TechnologyMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter technologyMode: %s", err))
+	}
+
+	// ------------- Optional query parameter "difficulty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "difficulty", ctx.QueryParams(), &params.Difficulty)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter difficulty: %s", err))
+	}
+
+	// ------------- Optional query parameter "company" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "company", ctx.QueryParams(), &params.Company)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListQuestions(ctx, params)
+	return err
+}
+
+// CreateQuestion converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateQuestion(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateQuestion(ctx)
+	return err
+}
+
+// ExportQuestions converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportQuestions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportQuestionsParams
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "technology" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "technology", ctx.QueryParams(), &params.Technology)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter technology: %s", err))
+	}
+
+	// ------------- Optional query parameter "technologyMode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "technologyMode", ctx.QueryParams(), &params.TechnologyMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter technologyMode: %s", err))
+	}
+
+	// ------------- Optional query parameter "difficulty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "difficulty", ctx.QueryParams(), &params.Difficulty)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter difficulty: %s", err))
+	}
+
+	// ------------- Optional query parameter "company" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "company", ctx.QueryParams(), &params.Company)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportQuestions(ctx, params)
+	return err
+}
+
+// ImportQuestions converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportQuestions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportQuestionsParams
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportQuestions(ctx, params)
+	return err
+}
+
+// SearchQuestions converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchQuestions(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchQuestionsParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "technology" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "technology", ctx.QueryParams(), &params.Technology)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter technology: %s", err))
+	}
+
+	// ------------- Optional query parameter "technologyMode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "technologyMode", ctx.QueryParams(), &params.TechnologyMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter technologyMode: %s", err))
+	}
+
+	// ------------- Optional query parameter "difficulty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "difficulty", ctx.QueryParams(), &params.Difficulty)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter difficulty: %s", err))
+	}
+
+	// ------------- Optional query parameter "company" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "company", ctx.QueryParams(), &params.Company)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SearchQuestions(ctx, params)
+	return err
+}
+
+// SubmitQuestion converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitQuestion(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitQuestion(ctx)
+	return err
+}
+
+// ListMyQuestionSubmissions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMyQuestionSubmissions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMyQuestionSubmissions(ctx)
+	return err
+}
+
+// UpdateQuestionSubmission converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateQuestionSubmission(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateQuestionSubmission(ctx, id)
+	return err
+}
+
+// DeleteQuestion converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteQuestion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteQuestion(ctx, id)
+	return err
+}
+
+// GetQuestionById converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQuestionById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetQuestionById(ctx, id)
+	return err
+}
+
+// UpdateQuestion converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateQuestion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateQuestion(ctx, id)
+	return err
+}
+
+// SubmitQuestionAnswer converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitQuestionAnswer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitQuestionAnswer(ctx, id)
+	return err
+}
+
+// DiffQuestionRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) DiffQuestionRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffQuestionRevisionsParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DiffQuestionRevisions(ctx, id, params)
+	return err
+}
+
+// ListQuestionRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListQuestionRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListQuestionRevisions(ctx, id)
 	return err
 }
 
-// RefreshTokens converts echo context to params.
-func (w *ServerInterfaceWrapper) RefreshTokens(ctx echo.Context) error {
+// RollbackQuestion converts echo context to params.
+func (w *ServerInterfaceWrapper) RollbackQuestion(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", ctx.Param("revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revision: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RefreshTokens(ctx)
+	err = w.Handler.RollbackQuestion(ctx, id, revision)
 	return err
 }
 
-// RegisterUser converts echo context to params.
-func (w *ServerInterfaceWrapper) RegisterUser(ctx echo.Context) error {
+// ReplyToReview converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplyToReview(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegisterUser(ctx)
+	err = w.Handler.ReplyToReview(ctx, id)
 	return err
 }
 
-// ListMentors converts echo context to params.
-func (w *ServerInterfaceWrapper) ListMentors(ctx echo.Context) error {
+// ReportReview converts echo context to params.
+func (w *ServerInterfaceWrapper) ReportReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReportReview(ctx, id)
+	return err
+}
+
+// DeleteSessionNote converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSessionNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSessionNote(ctx, id)
+	return err
+}
+
+// UpdateSessionNote converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateSessionNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateSessionNote(ctx, id)
+	return err
+}
+
+// ListTechnologies converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTechnologies(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTechnologies(ctx)
+	return err
+}
+
+// GetCurrentUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCurrentUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCurrentUser(ctx)
+	return err
+}
+
+// ListMyBookings converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMyBookings(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListMentorsParams
-	// ------------- Optional query parameter "specialization" -------------
+	var params ListMyBookingsParams
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "specialization", ctx.QueryParams(), &params.Specialization)
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter specialization: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
@@ -96,23 +2389,85 @@ func (w *ServerInterfaceWrapper) ListMentors(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListMentors(ctx, params)
+	err = w.Handler.ListMyBookings(ctx, params)
 	return err
 }
 
-// ListQuestions converts echo context to params.
-func (w *ServerInterfaceWrapper) ListQuestions(ctx echo.Context) error {
+// DeleteCalendarFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCalendarFeed(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCalendarFeed(ctx)
+	return err
+}
+
+// CreateCalendarFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCalendarFeed(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCalendarFeed(ctx)
+	return err
+}
+
+// ListMyEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMyEvents(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListQuestionsParams
-	// ------------- Optional query parameter "technology" -------------
+	var params ListMyEventsParams
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "technology", ctx.QueryParams(), &params.Technology)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter technology: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMyEvents(ctx, params)
+	return err
+}
+
+// StreamEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamEvents(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+	// ------------- Optional query parameter "accessToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "accessToken", ctx.QueryParams(), &params.AccessToken)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accessToken: %s", err))
 	}
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamEvents(ctx, params)
+	return err
+}
+
+// ListMyHomework converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMyHomework(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMyHomeworkParams
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
@@ -128,12 +2483,79 @@ func (w *ServerInterfaceWrapper) ListQuestions(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListQuestions(ctx, params)
+	err = w.Handler.ListMyHomework(ctx, params)
 	return err
 }
 
-// GetQuestionById converts echo context to params.
-func (w *ServerInterfaceWrapper) GetQuestionById(ctx echo.Context) error {
+// GetNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNotificationPreferences(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNotificationPreferences(ctx)
+	return err
+}
+
+// UpdateNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNotificationPreferences(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateNotificationPreferences(ctx)
+	return err
+}
+
+// ListNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) ListNotifications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNotificationsParams
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "unreadOnly" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unreadOnly", ctx.QueryParams(), &params.UnreadOnly)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unreadOnly: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListNotifications(ctx, params)
+	return err
+}
+
+// MarkAllNotificationsRead converts echo context to params.
+func (w *ServerInterfaceWrapper) MarkAllNotificationsRead(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MarkAllNotificationsRead(ctx)
+	return err
+}
+
+// MarkNotificationRead converts echo context to params.
+func (w *ServerInterfaceWrapper) MarkNotificationRead(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -143,19 +2565,51 @@ func (w *ServerInterfaceWrapper) GetQuestionById(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetQuestionById(ctx, id)
+	err = w.Handler.MarkNotificationRead(ctx, id)
 	return err
 }
 
-// GetCurrentUser converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCurrentUser(ctx echo.Context) error {
+// GetMyReviewQueue converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMyReviewQueue(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMyReviewQueueParams
+	// ------------- Optional query parameter "technology" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "technology", ctx.QueryParams(), &params.Technology)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter technology: %s", err))
+	}
+
+	// ------------- Optional query parameter "difficulty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "difficulty", ctx.QueryParams(), &params.Difficulty)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter difficulty: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCurrentUser(ctx)
+	err = w.Handler.GetMyReviewQueue(ctx, params)
 	return err
 }
 
@@ -187,12 +2641,112 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/admin/payments/ledger", wrapper.ListLedgerEntries)
+	router.GET(baseURL+"/admin/payments/unbalanced", wrapper.ListUnbalancedPayments)
 	router.POST(baseURL+"/auth/login", wrapper.LoginUser)
 	router.POST(baseURL+"/auth/refresh", wrapper.RefreshTokens)
 	router.POST(baseURL+"/auth/register", wrapper.RegisterUser)
+	router.GET(baseURL+"/bookings/:id", wrapper.GetBookingById)
+	router.GET(baseURL+"/bookings/:id/calendar.ics", wrapper.GetBookingCalendar)
+	router.POST(baseURL+"/bookings/:id/cancel", wrapper.CancelBooking)
+	router.POST(baseURL+"/bookings/:id/complete", wrapper.CompleteBooking)
+	router.POST(baseURL+"/bookings/:id/confirm", wrapper.ConfirmBooking)
+	router.GET(baseURL+"/bookings/:id/homework", wrapper.ListBookingHomework)
+	router.POST(baseURL+"/bookings/:id/homework", wrapper.AssignHomework)
+	router.POST(baseURL+"/bookings/:id/no-show", wrapper.MarkBookingNoShow)
+	router.GET(baseURL+"/bookings/:id/notes", wrapper.ListSessionNotes)
+	router.POST(baseURL+"/bookings/:id/notes", wrapper.CreateSessionNote)
+	router.GET(baseURL+"/bookings/:id/payment", wrapper.GetBookingPayment)
+	router.POST(baseURL+"/bookings/:id/payment", wrapper.CreateBookingPayment)
+	router.POST(baseURL+"/bookings/:id/reschedule", wrapper.RescheduleBooking)
+	router.POST(baseURL+"/bookings/:id/review", wrapper.CreateReview)
+	router.GET(baseURL+"/calendar/feeds/:token", wrapper.GetCalendarFeed)
+	router.GET(baseURL+"/conversations", wrapper.ListConversations)
+	router.GET(baseURL+"/conversations/:id/messages", wrapper.ListMessages)
+	router.POST(baseURL+"/conversations/:id/messages", wrapper.SendMessage)
+	router.POST(baseURL+"/conversations/:id/read", wrapper.MarkConversationRead)
+	router.GET(baseURL+"/events", wrapper.ListEvents)
+	router.GET(baseURL+"/events/:id", wrapper.GetEventById)
+	router.PUT(baseURL+"/events/:id", wrapper.UpdateEvent)
+	router.POST(baseURL+"/events/:id/cancel", wrapper.CancelEvent)
+	router.GET(baseURL+"/events/:id/participants", wrapper.ListEventParticipants)
+	router.PUT(baseURL+"/events/:id/participants/:userId/attendance", wrapper.MarkEventAttendance)
+	router.DELETE(baseURL+"/events/:id/registration", wrapper.CancelEventRegistration)
+	router.POST(baseURL+"/events/:id/registration", wrapper.RegisterForEvent)
+	router.DELETE(baseURL+"/homework/:id", wrapper.DeleteHomework)
+	router.GET(baseURL+"/homework/:id", wrapper.GetHomework)
 	router.GET(baseURL+"/mentors", wrapper.ListMentors)
+	router.POST(baseURL+"/mentors/applications", wrapper.SubmitMentorApplication)
+	router.GET(baseURL+"/mentors/applications/me", wrapper.ListMyMentorApplications)
+	router.PUT(baseURL+"/mentors/me", wrapper.UpdateMyMentorProfile)
+	router.GET(baseURL+"/mentors/me/availability", wrapper.GetMyAvailability)
+	router.PUT(baseURL+"/mentors/me/availability", wrapper.UpdateMyAvailability)
+	router.POST(baseURL+"/mentors/me/availability/exceptions", wrapper.CreateAvailabilityException)
+	router.DELETE(baseURL+"/mentors/me/availability/exceptions/:id", wrapper.DeleteAvailabilityException)
+	router.GET(baseURL+"/mentors/me/bookings", wrapper.ListMentorBookings)
+	router.GET(baseURL+"/mentors/me/dashboard", wrapper.GetMentorDashboard)
+	router.GET(baseURL+"/mentors/me/events", wrapper.ListMyHostedEvents)
+	router.POST(baseURL+"/mentors/me/events", wrapper.CreateEvent)
+	router.GET(baseURL+"/mentors/me/homework", wrapper.ListMentorHomework)
+	router.GET(baseURL+"/mentors/me/verifications", wrapper.ListMyVerifications)
+	router.POST(baseURL+"/mentors/me/verifications", wrapper.SubmitVerification)
+	router.DELETE(baseURL+"/mentors/me/verifications/:id", wrapper.WithdrawVerification)
+	router.GET(baseURL+"/mentors/recommended", wrapper.ListRecommendedMentors)
+	router.GET(baseURL+"/mentors/:id", wrapper.GetMentorById)
+	router.POST(baseURL+"/mentors/:id/bookings", wrapper.CreateBooking)
+	router.DELETE(baseURL+"/mentors/:id/progress-sharing", wrapper.UnshareProgressWithMentor)
+	router.PUT(baseURL+"/mentors/:id/progress-sharing", wrapper.ShareProgressWithMentor)
+	router.GET(baseURL+"/mentors/:id/reviews", wrapper.ListMentorReviews)
+	router.GET(baseURL+"/mentors/:id/slots", wrapper.ListMentorSlots)
+	router.GET(baseURL+"/moderation/mentor-applications", wrapper.ListMentorApplications)
+	router.POST(baseURL+"/moderation/mentor-applications/:id/approve", wrapper.ApproveMentorApplication)
+	router.POST(baseURL+"/moderation/mentor-applications/:id/reject", wrapper.RejectMentorApplication)
+	router.GET(baseURL+"/moderation/questions", wrapper.ListQuestionSubmissions)
+	router.PUT(baseURL+"/moderation/questions/:id", wrapper.EditQuestionSubmission)
+	router.POST(baseURL+"/moderation/questions/:id/approve", wrapper.ApproveQuestionSubmission)
+	router.POST(baseURL+"/moderation/questions/:id/merge", wrapper.MergeQuestionSubmission)
+	router.POST(baseURL+"/moderation/questions/:id/reject", wrapper.RejectQuestionSubmission)
+	router.GET(baseURL+"/moderation/review-reports", wrapper.ListReviewReports)
+	router.POST(baseURL+"/moderation/review-reports/:id/dismiss", wrapper.DismissReviewReport)
+	router.POST(baseURL+"/moderation/reviews/:id/hide", wrapper.HideReview)
+	router.POST(baseURL+"/moderation/reviews/:id/restore", wrapper.RestoreReview)
+	router.GET(baseURL+"/moderation/verifications", wrapper.ListVerifications)
+	router.POST(baseURL+"/moderation/verifications/:id/reject", wrapper.RejectVerification)
+	router.POST(baseURL+"/moderation/verifications/:id/revoke", wrapper.RevokeVerification)
+	router.POST(baseURL+"/moderation/verifications/:id/verify", wrapper.VerifyVerification)
+	router.POST(baseURL+"/payments/webhook", wrapper.HandlePaymentWebhook)
 	router.GET(baseURL+"/questions", wrapper.ListQuestions)
+	router.POST(baseURL+"/questions", wrapper.CreateQuestion)
+	router.GET(baseURL+"/questions/export", wrapper.ExportQuestions)
+	router.POST(baseURL+"/questions/import", wrapper.ImportQuestions)
+	router.GET(baseURL+"/questions/search", wrapper.SearchQuestions)
+	router.POST(baseURL+"/questions/submissions", wrapper.SubmitQuestion)
+	router.GET(baseURL+"/questions/submissions/me", wrapper.ListMyQuestionSubmissions)
+	router.PUT(baseURL+"/questions/submissions/:id", wrapper.UpdateQuestionSubmission)
+	router.DELETE(baseURL+"/questions/:id", wrapper.DeleteQuestion)
 	router.GET(baseURL+"/questions/:id", wrapper.GetQuestionById)
+	router.PUT(baseURL+"/questions/:id", wrapper.UpdateQuestion)
+	router.POST(baseURL+"/questions/:id/answers", wrapper.SubmitQuestionAnswer)
+	router.GET(baseURL+"/questions/:id/diff", wrapper.DiffQuestionRevisions)
+	router.GET(baseURL+"/questions/:id/revisions", wrapper.ListQuestionRevisions)
+	router.POST(baseURL+"/questions/:id/revisions/:revision/rollback", wrapper.RollbackQuestion)
+	router.POST(baseURL+"/reviews/:id/reply", wrapper.ReplyToReview)
+	router.POST(baseURL+"/reviews/:id/report", wrapper.ReportReview)
+	router.DELETE(baseURL+"/session-notes/:id", wrapper.DeleteSessionNote)
+	router.PUT(baseURL+"/session-notes/:id", wrapper.UpdateSessionNote)
+	router.GET(baseURL+"/technologies", wrapper.ListTechnologies)
 	router.GET(baseURL+"/users/me", wrapper.GetCurrentUser)
+	router.GET(baseURL+"/users/me/bookings", wrapper.ListMyBookings)
+	router.DELETE(baseURL+"/users/me/calendar-feed", wrapper.DeleteCalendarFeed)
+	router.POST(baseURL+"/users/me/calendar-feed", wrapper.CreateCalendarFeed)
+	router.GET(baseURL+"/users/me/event-registrations", wrapper.ListMyEvents)
+	router.GET(baseURL+"/users/me/events", wrapper.StreamEvents)
+	router.GET(baseURL+"/users/me/homework", wrapper.ListMyHomework)
+	router.GET(baseURL+"/users/me/notification-preferences", wrapper.GetNotificationPreferences)
+	router.PUT(baseURL+"/users/me/notification-preferences", wrapper.UpdateNotificationPreferences)
+	router.GET(baseURL+"/users/me/notifications", wrapper.ListNotifications)
+	router.POST(baseURL+"/users/me/notifications/read-all", wrapper.MarkAllNotificationsRead)
+	router.POST(baseURL+"/users/me/notifications/:id/read", wrapper.MarkNotificationRead)
+	router.GET(baseURL+"/users/me/review-queue", wrapper.GetMyReviewQueue)
 
 }
@@ -4,6 +4,11 @@
 package openapi
 
 import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -11,13 +16,345 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AnswerSubmissionRecall.
+const (
+	AnswerSubmissionRecallAgain AnswerSubmissionRecall = "again"
+	AnswerSubmissionRecallEasy  AnswerSubmissionRecall = "easy"
+	AnswerSubmissionRecallGood  AnswerSubmissionRecall = "good"
+	AnswerSubmissionRecallHard  AnswerSubmissionRecall = "hard"
+)
+
+// Defines values for BookingStatus.
+const (
+	BookingStatusCancelled BookingStatus = "cancelled"
+	BookingStatusCompleted BookingStatus = "completed"
+	BookingStatusConfirmed BookingStatus = "confirmed"
+	BookingStatusNoShow    BookingStatus = "no_show"
+	BookingStatusRequested BookingStatus = "requested"
+)
+
+// Defines values for Currency.
+const (
+	EUR Currency = "EUR"
+	RUB Currency = "RUB"
+	USD Currency = "USD"
+)
+
+// Defines values for DashboardPeriod.
+const (
+	Month DashboardPeriod = "month"
+	Week  DashboardPeriod = "week"
+)
+
+// Defines values for DiffLineOp.
+const (
+	Delete DiffLineOp = "delete"
+	Equal  DiffLineOp = "equal"
+	Insert DiffLineOp = "insert"
+)
+
+// Defines values for EventRegistrationStatus.
+const (
+	Registered EventRegistrationStatus = "registered"
+	Waitlisted EventRegistrationStatus = "waitlisted"
+)
+
+// Defines values for EventStatus.
+const (
+	EventStatusCancelled EventStatus = "cancelled"
+	EventStatusScheduled EventStatus = "scheduled"
+)
+
+// Defines values for EventType.
+const (
+	GroupSession  EventType = "group_session"
+	MockInterview EventType = "mock_interview"
+)
+
+// Defines values for ExportQuestionsParamsDifficulty.
+const (
+	ExportQuestionsParamsDifficultyEasy   ExportQuestionsParamsDifficulty = "easy"
+	ExportQuestionsParamsDifficultyHard   ExportQuestionsParamsDifficulty = "hard"
+	ExportQuestionsParamsDifficultyMedium ExportQuestionsParamsDifficulty = "medium"
+)
+
+// Defines values for ExportQuestionsParamsTechnologyMode.
+const (
+	ExportQuestionsParamsTechnologyModeAll ExportQuestionsParamsTechnologyMode = "all"
+	ExportQuestionsParamsTechnologyModeAny ExportQuestionsParamsTechnologyMode = "any"
+)
+
+// Defines values for GetMyReviewQueueParamsDifficulty.
+const (
+	GetMyReviewQueueParamsDifficultyEasy   GetMyReviewQueueParamsDifficulty = "easy"
+	GetMyReviewQueueParamsDifficultyHard   GetMyReviewQueueParamsDifficulty = "hard"
+	GetMyReviewQueueParamsDifficultyMedium GetMyReviewQueueParamsDifficulty = "medium"
+)
+
+// Defines values for HomeworkItemType.
+const (
+	Module   HomeworkItemType = "module"
+	Question HomeworkItemType = "question"
+)
+
+// Defines values for LedgerEntryType.
+const (
+	Capture LedgerEntryType = "capture"
+	Hold    LedgerEntryType = "hold"
+	Refund  LedgerEntryType = "refund"
+)
+
+// Defines values for ListMentorsParamsOrder.
+const (
+	Asc  ListMentorsParamsOrder = "asc"
+	Desc ListMentorsParamsOrder = "desc"
+)
+
+// Defines values for ListMentorsParamsSort.
+const (
+	Experience ListMentorsParamsSort = "experience"
+	Price      ListMentorsParamsSort = "price"
+	Rating     ListMentorsParamsSort = "rating"
+)
+
+// Defines values for ListMentorsParamsTagsMode.
+const (
+	ListMentorsParamsTagsModeAll ListMentorsParamsTagsMode = "all"
+	ListMentorsParamsTagsModeAny ListMentorsParamsTagsMode = "any"
+)
+
+// Defines values for ListQuestionsParamsDifficulty.
+const (
+	ListQuestionsParamsDifficultyEasy   ListQuestionsParamsDifficulty = "easy"
+	ListQuestionsParamsDifficultyHard   ListQuestionsParamsDifficulty = "hard"
+	ListQuestionsParamsDifficultyMedium ListQuestionsParamsDifficulty = "medium"
+)
+
+// Defines values for ListQuestionsParamsSort.
+const (
+	Hardest       ListQuestionsParamsSort = "hardest"
+	MostAttempted ListQuestionsParamsSort = "most_attempted"
+	Newest        ListQuestionsParamsSort = "newest"
+)
+
+// Defines values for ListQuestionsParamsStatus.
+const (
+	ListQuestionsParamsStatusFailed     ListQuestionsParamsStatus = "failed"
+	ListQuestionsParamsStatusUnanswered ListQuestionsParamsStatus = "unanswered"
+)
+
+// Defines values for ListQuestionsParamsTechnologyMode.
+const (
+	ListQuestionsParamsTechnologyModeAll ListQuestionsParamsTechnologyMode = "all"
+	ListQuestionsParamsTechnologyModeAny ListQuestionsParamsTechnologyMode = "any"
+)
+
+// Defines values for MentorApplicationStatus.
+const (
+	MentorApplicationStatusApproved MentorApplicationStatus = "approved"
+	MentorApplicationStatusPending  MentorApplicationStatus = "pending"
+	MentorApplicationStatusRejected MentorApplicationStatus = "rejected"
+)
+
+// Defines values for MentorContactType.
+const (
+	MentorContactTypeEmail    MentorContactType = "email"
+	MentorContactTypeGithub   MentorContactType = "github"
+	MentorContactTypeLinkedin MentorContactType = "linkedin"
+	MentorContactTypePhone    MentorContactType = "phone"
+	MentorContactTypeTelegram MentorContactType = "telegram"
+	MentorContactTypeWebsite  MentorContactType = "website"
+)
+
+// Defines values for NoteVisibility.
+const (
+	Private NoteVisibility = "private"
+	Shared  NoteVisibility = "shared"
+)
+
+// Defines values for PaymentStatus.
+const (
+	PaymentStatusCancelled         PaymentStatus = "cancelled"
+	PaymentStatusCaptured          PaymentStatus = "captured"
+	PaymentStatusFailed            PaymentStatus = "failed"
+	PaymentStatusHeld              PaymentStatus = "held"
+	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded"
+	PaymentStatusPending           PaymentStatus = "pending"
+	PaymentStatusRefunded          PaymentStatus = "refunded"
+)
+
 // Defines values for QuestionDetailDifficulty.
 const (
-	Easy   QuestionDetailDifficulty = "easy"
-	Hard   QuestionDetailDifficulty = "hard"
-	Medium QuestionDetailDifficulty = "medium"
+	QuestionDetailDifficultyEasy   QuestionDetailDifficulty = "easy"
+	QuestionDetailDifficultyHard   QuestionDetailDifficulty = "hard"
+	QuestionDetailDifficultyMedium QuestionDetailDifficulty = "medium"
+)
+
+// Defines values for QuestionFileFormat.
+const (
+	Csv      QuestionFileFormat = "csv"
+	Json     QuestionFileFormat = "json"
+	Markdown QuestionFileFormat = "markdown"
+	Yaml     QuestionFileFormat = "yaml"
+)
+
+// Defines values for QuestionImportRowResultAction.
+const (
+	QuestionImportRowResultActionCreated   QuestionImportRowResultAction = "created"
+	QuestionImportRowResultActionFailed    QuestionImportRowResultAction = "failed"
+	QuestionImportRowResultActionUnchanged QuestionImportRowResultAction = "unchanged"
+	QuestionImportRowResultActionUpdated   QuestionImportRowResultAction = "updated"
+)
+
+// Defines values for QuestionListItemDifficulty.
+const (
+	QuestionListItemDifficultyEasy   QuestionListItemDifficulty = "easy"
+	QuestionListItemDifficultyHard   QuestionListItemDifficulty = "hard"
+	QuestionListItemDifficultyMedium QuestionListItemDifficulty = "medium"
+)
+
+// Defines values for QuestionRecordDifficulty.
+const (
+	QuestionRecordDifficultyEasy   QuestionRecordDifficulty = "easy"
+	QuestionRecordDifficultyHard   QuestionRecordDifficulty = "hard"
+	QuestionRecordDifficultyMedium QuestionRecordDifficulty = "medium"
+)
+
+// Defines values for QuestionRequestDifficulty.
+const (
+	QuestionRequestDifficultyEasy   QuestionRequestDifficulty = "easy"
+	QuestionRequestDifficultyHard   QuestionRequestDifficulty = "hard"
+	QuestionRequestDifficultyMedium QuestionRequestDifficulty = "medium"
+)
+
+// Defines values for QuestionRevisionDifficulty.
+const (
+	QuestionRevisionDifficultyEasy   QuestionRevisionDifficulty = "easy"
+	QuestionRevisionDifficultyHard   QuestionRevisionDifficulty = "hard"
+	QuestionRevisionDifficultyMedium QuestionRevisionDifficulty = "medium"
+)
+
+// Defines values for QuestionSearchHitDifficulty.
+const (
+	QuestionSearchHitDifficultyEasy   QuestionSearchHitDifficulty = "easy"
+	QuestionSearchHitDifficultyHard   QuestionSearchHitDifficulty = "hard"
+	QuestionSearchHitDifficultyMedium QuestionSearchHitDifficulty = "medium"
+)
+
+// Defines values for QuestionSubmissionStatus.
+const (
+	QuestionSubmissionStatusDraft     QuestionSubmissionStatus = "draft"
+	QuestionSubmissionStatusMerged    QuestionSubmissionStatus = "merged"
+	QuestionSubmissionStatusPublished QuestionSubmissionStatus = "published"
+	QuestionSubmissionStatusRejected  QuestionSubmissionStatus = "rejected"
+)
+
+// Defines values for QuestionTextDiffField.
+const (
+	Content     QuestionTextDiffField = "content"
+	Explanation QuestionTextDiffField = "explanation"
+	StarterCode QuestionTextDiffField = "starterCode"
+	Title       QuestionTextDiffField = "title"
+)
+
+// Defines values for QuestionType.
+const (
+	Code           QuestionType = "code"
+	FreeText       QuestionType = "free_text"
+	MultipleChoice QuestionType = "multiple_choice"
+	Numeric        QuestionType = "numeric"
+	Ordering       QuestionType = "ordering"
+	SingleChoice   QuestionType = "single_choice"
+)
+
+// Defines values for ReviewQueueItemDifficulty.
+const (
+	ReviewQueueItemDifficultyEasy   ReviewQueueItemDifficulty = "easy"
+	ReviewQueueItemDifficultyHard   ReviewQueueItemDifficulty = "hard"
+	ReviewQueueItemDifficultyMedium ReviewQueueItemDifficulty = "medium"
+)
+
+// Defines values for ReviewReportStatus.
+const (
+	Dismissed ReviewReportStatus = "dismissed"
+	Open      ReviewReportStatus = "open"
+	Resolved  ReviewReportStatus = "resolved"
+)
+
+// Defines values for ReviewStatus.
+const (
+	ReviewStatusHidden    ReviewStatus = "hidden"
+	ReviewStatusPublished ReviewStatus = "published"
 )
 
+// Defines values for SearchQuestionsParamsDifficulty.
+const (
+	SearchQuestionsParamsDifficultyEasy   SearchQuestionsParamsDifficulty = "easy"
+	SearchQuestionsParamsDifficultyHard   SearchQuestionsParamsDifficulty = "hard"
+	SearchQuestionsParamsDifficultyMedium SearchQuestionsParamsDifficulty = "medium"
+)
+
+// Defines values for SearchQuestionsParamsTechnologyMode.
+const (
+	SearchQuestionsParamsTechnologyModeAll SearchQuestionsParamsTechnologyMode = "all"
+	SearchQuestionsParamsTechnologyModeAny SearchQuestionsParamsTechnologyMode = "any"
+)
+
+// Defines values for VerificationStatus.
+const (
+	VerificationStatusExpired  VerificationStatus = "expired"
+	VerificationStatusPending  VerificationStatus = "pending"
+	VerificationStatusRejected VerificationStatus = "rejected"
+	VerificationStatusRevoked  VerificationStatus = "revoked"
+	VerificationStatusVerified VerificationStatus = "verified"
+)
+
+// Defines values for VerificationType.
+const (
+	VerificationTypeCertification VerificationType = "certification"
+	VerificationTypeEmployment    VerificationType = "employment"
+	VerificationTypeGithub        VerificationType = "github"
+)
+
+// AnswerResult defines model for AnswerResult.
+type AnswerResult struct {
+	// AnswerKey Правильный ответ, тип вопроса задается полем type. Возвращается только после попытки ответить, автору вопроса и администратору.
+	AnswerKey   QuestionAnswerKey `json:"answerKey"`
+	Explanation *string           `json:"explanation,omitempty"`
+	IsCorrect   bool              `json:"isCorrect"`
+
+	// Progress Прогресс текущего пользователя по вопросу
+	Progress   QuestionProgress `json:"progress"`
+	QuestionId int              `json:"questionId"`
+}
+
+// AnswerStats defines model for AnswerStats.
+type AnswerStats struct {
+	Answered int `json:"answered"`
+	Correct  int `json:"correct"`
+}
+
+// AnswerSubmission Ответ заполняется в зависимости от типа вопроса: answer - для single_choice, free_text и code, choices - для multiple_choice и ordering, value - для numeric.
+type AnswerSubmission struct {
+	// Answer Выбранный вариант, текст или код
+	Answer *string `json:"answer,omitempty"`
+
+	// Choices Выбранные варианты или все элементы в выбранном порядке
+	Choices *[]string `json:"choices,omitempty"`
+
+	// Recall Насколько легко вспомнился ответ; влияет на срок следующего повторения. Для неверного ответа не учитывается, again сбрасывает серию даже при верном ответе.
+	Recall *AnswerSubmissionRecall `json:"recall,omitempty"`
+
+	// TimeSpentSeconds Время, потраченное на попытку
+	TimeSpentSeconds *int `json:"timeSpentSeconds,omitempty"`
+
+	// Value Числовой ответ
+	Value *float64 `json:"value,omitempty"`
+}
+
+// AnswerSubmissionRecall Насколько легко вспомнился ответ; влияет на срок следующего повторения. Для неверного ответа не учитывается, again сбрасывает серию даже при верном ответе.
+type AnswerSubmissionRecall string
+
 // AuthLoginRequest defines model for AuthLoginRequest.
 type AuthLoginRequest struct {
 	Email    openapi_types.Email `json:"email"`
@@ -49,6 +386,172 @@ type AuthTokens struct {
 	RefreshToken string `json:"refreshToken"`
 }
 
+// Availability defines model for Availability.
+type Availability struct {
+	// Exceptions Предстоящие исключения
+	Exceptions []AvailabilityException `json:"exceptions"`
+	Rules      []AvailabilityRule      `json:"rules"`
+
+	// Timezone Часовой пояс IANA, например Europe/Moscow
+	Timezone string `json:"timezone"`
+}
+
+// AvailabilityException defines model for AvailabilityException.
+type AvailabilityException struct {
+	EndsAt time.Time `json:"endsAt"`
+	Id     int       `json:"id"`
+
+	// IsAvailable true - дополнительное окно, false - ментор недоступен
+	IsAvailable bool      `json:"isAvailable"`
+	Reason      *string   `json:"reason,omitempty"`
+	StartsAt    time.Time `json:"startsAt"`
+}
+
+// AvailabilityExceptionRequest defines model for AvailabilityExceptionRequest.
+type AvailabilityExceptionRequest struct {
+	EndsAt time.Time `json:"endsAt"`
+
+	// IsAvailable true - дополнительное окно, false - ментор недоступен
+	IsAvailable *bool     `json:"isAvailable,omitempty"`
+	Reason      *string   `json:"reason,omitempty"`
+	StartsAt    time.Time `json:"startsAt"`
+}
+
+// AvailabilityRule Еженедельное окно доступности во времени ментора
+type AvailabilityRule struct {
+	EndTime   string `json:"endTime"`
+	StartTime string `json:"startTime"`
+
+	// Weekday День недели, 0 - воскресенье
+	Weekday int `json:"weekday"`
+}
+
+// AvailabilityUpdate defines model for AvailabilityUpdate.
+type AvailabilityUpdate struct {
+	Rules []AvailabilityRule `json:"rules"`
+
+	// Timezone Часовой пояс IANA, например Europe/Moscow
+	Timezone string `json:"timezone"`
+}
+
+// Booking defines model for Booking.
+type Booking struct {
+	CancelReason *string   `json:"cancelReason,omitempty"`
+	Comment      *string   `json:"comment,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+	EndsAt       time.Time `json:"endsAt"`
+	Id           int       `json:"id"`
+	MenteeId     int       `json:"menteeId"`
+	MenteeName   string    `json:"menteeName"`
+	MentorId     int       `json:"mentorId"`
+	MentorName   string    `json:"mentorName"`
+	StartsAt     time.Time `json:"startsAt"`
+
+	// Status Статус бронирования. requested переходит в confirmed или cancelled, confirmed - в cancelled, completed или no_show.
+	Status BookingStatus `json:"status"`
+}
+
+// BookingCancelRequest defines model for BookingCancelRequest.
+type BookingCancelRequest struct {
+	// Reason Причина отмены для второго участника
+	Reason *string `json:"reason,omitempty"`
+}
+
+// BookingList defines model for BookingList.
+type BookingList struct {
+	Items []Booking `json:"items"`
+	Total *int      `json:"total,omitempty"`
+}
+
+// BookingRequest defines model for BookingRequest.
+type BookingRequest struct {
+	// Comment Пожелания к занятию
+	Comment         *string   `json:"comment,omitempty"`
+	DurationMinutes *int      `json:"durationMinutes,omitempty"`
+	StartsAt        time.Time `json:"startsAt"`
+}
+
+// BookingRescheduleRequest defines model for BookingRescheduleRequest.
+type BookingRescheduleRequest struct {
+	// StartsAt Новое время начала; длительность занятия сохраняется
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// BookingStatus Статус бронирования. requested переходит в confirmed или cancelled, confirmed - в cancelled, completed или no_show.
+type BookingStatus string
+
+// CalendarFeed defines model for CalendarFeed.
+type CalendarFeed struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Url Приватная ссылка для подписки в календаре
+	Url string `json:"url"`
+}
+
+// CodeAnswerKey defines model for CodeAnswerKey.
+type CodeAnswerKey struct {
+	// Solutions Эталонные решения. Код не выполняется: ответ сравнивается с решениями без учета пустых строк, пробелов в конце строк и общего отступа.
+	Solutions []string `json:"solutions"`
+	Type      string   `json:"type"`
+}
+
+// Conversation defines model for Conversation.
+type Conversation struct {
+	// CounterpartLastReadMessageId ID последнего сообщения, прочитанного собеседником (0 - ничего не прочитано)
+	CounterpartLastReadMessageId int       `json:"counterpartLastReadMessageId"`
+	CreatedAt                    time.Time `json:"createdAt"`
+	Id                           int       `json:"id"`
+	LastMessage                  *Message  `json:"lastMessage,omitempty"`
+	MenteeId                     int       `json:"menteeId"`
+	MenteeName                   string    `json:"menteeName"`
+	MentorId                     int       `json:"mentorId"`
+	MentorName                   string    `json:"mentorName"`
+	MentorUserId                 int       `json:"mentorUserId"`
+
+	// UnreadCount Непрочитанные сообщения текущего пользователя в диалоге
+	UnreadCount int `json:"unreadCount"`
+}
+
+// ConversationList defines model for ConversationList.
+type ConversationList struct {
+	Items []Conversation `json:"items"`
+	Total *int           `json:"total,omitempty"`
+
+	// UnreadCount Общее количество непрочитанных сообщений во всех диалогах
+	UnreadCount int `json:"unreadCount"`
+}
+
+// ConversationReadRequest defines model for ConversationReadRequest.
+type ConversationReadRequest struct {
+	// MessageId Последнее прочитанное сообщение, по умолчанию последнее в диалоге
+	MessageId *int `json:"messageId,omitempty"`
+}
+
+// CourseProgress defines model for CourseProgress.
+type CourseProgress struct {
+	CompletedModules int    `json:"completedModules"`
+	CourseId         int    `json:"courseId"`
+	ProgressPct      int    `json:"progressPct"`
+	Title            string `json:"title"`
+	TotalModules     int    `json:"totalModules"`
+}
+
+// Currency Валюта цены
+type Currency string
+
+// DashboardPeriod Период агрегации графиков дашборда
+type DashboardPeriod string
+
+// DiffLine defines model for DiffLine.
+type DiffLine struct {
+	// Op equal - строка есть в обеих ревизиях, delete - только в from, insert - только в to
+	Op   DiffLineOp `json:"op"`
+	Text string     `json:"text"`
+}
+
+// DiffLineOp equal - строка есть в обеих ревизиях, delete - только в from, insert - только в to
+type DiffLineOp string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	// Code Внутренний код ошибки
@@ -56,122 +559,1662 @@ type ErrorResponse struct {
 	Message string  `json:"message"`
 }
 
-// MentorCard defines model for MentorCard.
-type MentorCard struct {
-	FullName string `json:"fullName"`
+// Event defines model for Event.
+type Event struct {
+	Capacity    int       `json:"capacity"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Description *string   `json:"description,omitempty"`
+	EndsAt      time.Time `json:"endsAt"`
+
+	// HostName Имя ведущего ментора
+	HostName string `json:"hostName"`
 	Id       int    `json:"id"`
+	MentorId int    `json:"mentorId"`
 
-	// Skills Основные технологии и направления
-	Skills []string `json:"skills"`
+	// MyStatus Участник или в листе ожидания
+	MyStatus        *EventRegistrationStatus `json:"myStatus,omitempty"`
+	RegisteredCount int                      `json:"registeredCount"`
+	SpotsLeft       int                      `json:"spotsLeft"`
+	StartsAt        time.Time                `json:"startsAt"`
+	Status          EventStatus              `json:"status"`
+	Title           string                   `json:"title"`
 
-	// Title Основная должность или позиция ментора
-	Title string `json:"title"`
+	// Type Групповое занятие или групповое mock-собеседование
+	Type          EventType `json:"type"`
+	WaitlistCount int       `json:"waitlistCount"`
 
-	// YearsOfExperience Общий стаж работы
-	YearsOfExperience *int `json:"yearsOfExperience,omitempty"`
+	// WaitlistPosition Место текущего пользователя в листе ожидания
+	WaitlistPosition *int `json:"waitlistPosition,omitempty"`
 }
 
-// MentorList defines model for MentorList.
-type MentorList struct {
-	Items []MentorCard `json:"items"`
+// EventAttendance defines model for EventAttendance.
+type EventAttendance struct {
+	Attended bool `json:"attended"`
+}
 
-	// Total Общее количество доступных менторов
-	Total *int `json:"total,omitempty"`
+// EventList defines model for EventList.
+type EventList struct {
+	Items []Event `json:"items"`
+	Total *int    `json:"total,omitempty"`
 }
 
-// QuestionDetail defines model for QuestionDetail.
-type QuestionDetail struct {
-	// Content Полный текст вопроса
-	Content string `json:"content"`
+// EventParticipant defines model for EventParticipant.
+type EventParticipant struct {
+	// Attended Отметка ведущего о посещении, отсутствует до отметки
+	Attended  *bool   `json:"attended,omitempty"`
+	AvatarUrl *string `json:"avatarUrl,omitempty"`
+	Name      string  `json:"name"`
 
-	// CorrectAnswer Правильный ответ
-	CorrectAnswer string `json:"correctAnswer"`
+	// RegisteredAt Время постановки в очередь
+	RegisteredAt time.Time `json:"registeredAt"`
 
-	// Difficulty Уровень сложности вопроса
-	Difficulty QuestionDetailDifficulty `json:"difficulty"`
+	// Status Участник или в листе ожидания
+	Status EventRegistrationStatus `json:"status"`
+	UserId int                     `json:"userId"`
+}
 
-	// Explanation Объяснение правильного ответа
-	Explanation *string `json:"explanation,omitempty"`
-	Id          int     `json:"id"`
+// EventParticipantList defines model for EventParticipantList.
+type EventParticipantList struct {
+	Items []EventParticipant `json:"items"`
+}
 
-	// Options Варианты ответов
-	Options []string `json:"options"`
+// EventRegistrationStatus Участник или в листе ожидания
+type EventRegistrationStatus string
 
-	// Technology Технология, к которой относится вопрос
-	Technology string `json:"technology"`
+// EventRequest defines model for EventRequest.
+type EventRequest struct {
+	Capacity    int     `json:"capacity"`
+	Description *string `json:"description,omitempty"`
 
-	// Title Название вопроса
-	Title string `json:"title"`
+	// EndsAt От 15 минут до 8 часов после начала
+	EndsAt   time.Time `json:"endsAt"`
+	StartsAt time.Time `json:"startsAt"`
+	Title    string    `json:"title"`
+
+	// Type Групповое занятие или групповое mock-собеседование
+	Type EventType `json:"type"`
 }
 
-// QuestionDetailDifficulty Уровень сложности вопроса
-type QuestionDetailDifficulty string
+// EventStatus defines model for EventStatus.
+type EventStatus string
 
-// QuestionList defines model for QuestionList.
-type QuestionList struct {
-	Items []QuestionListItem `json:"items"`
+// EventType Групповое занятие или групповое mock-собеседование
+type EventType string
 
-	// Total Общее количество доступных вопросов
-	Total *int `json:"total,omitempty"`
+// EventUpdate defines model for EventUpdate.
+type EventUpdate struct {
+	Capacity    int       `json:"capacity"`
+	Description *string   `json:"description,omitempty"`
+	EndsAt      time.Time `json:"endsAt"`
+	StartsAt    time.Time `json:"startsAt"`
+	Title       string    `json:"title"`
 }
 
-// QuestionListItem defines model for QuestionListItem.
-type QuestionListItem struct {
-	Id int `json:"id"`
-
-	// Technology Технология, к которой относится вопрос
-	Technology string `json:"technology"`
+// FreeTextAnswerKey defines model for FreeTextAnswerKey.
+type FreeTextAnswerKey struct {
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
 
-	// Title Название вопроса
-	Title string `json:"title"`
+	// Patterns Регулярные выражения (синтаксис RE2), одному из которых должен целиком соответствовать ответ. Пробелы внутри ответа сжимаются до одного.
+	Patterns []string `json:"patterns"`
+	Type     string   `json:"type"`
 }
 
-// UserProfile defines model for UserProfile.
-type UserProfile struct {
-	Email    openapi_types.Email `json:"email"`
-	FullName string              `json:"fullName"`
-	Id       int                 `json:"id"`
+// Homework defines model for Homework.
+type Homework struct {
+	BookingId int     `json:"bookingId"`
+	Comment   *string `json:"comment,omitempty"`
 
-	// Role Роль пользователя в системе
-	Role string `json:"role"`
+	// CompletedItems Количество выполненных пунктов
+	CompletedItems int            `json:"completedItems"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	DueAt          *time.Time     `json:"dueAt,omitempty"`
+	Id             int            `json:"id"`
+	Items          []HomeworkItem `json:"items"`
+	MenteeId       int            `json:"menteeId"`
+	MenteeName     string         `json:"menteeName"`
+	MentorId       int            `json:"mentorId"`
+	MentorName     string         `json:"mentorName"`
+	Title          string         `json:"title"`
 }
 
-// BadRequest defines model for BadRequest.
-type BadRequest = ErrorResponse
+// HomeworkItem defines model for HomeworkItem.
+type HomeworkItem struct {
+	Completed bool `json:"completed"`
 
-// Conflict defines model for Conflict.
-type Conflict = ErrorResponse
+	// CompletedQuestions Сколько вопросов пункта ученик решил верно
+	CompletedQuestions int  `json:"completedQuestions"`
+	Id                 int  `json:"id"`
+	ModuleId           *int `json:"moduleId,omitempty"`
+	QuestionId         *int `json:"questionId,omitempty"`
 
-// NotFound defines model for NotFound.
-type NotFound = ErrorResponse
+	// Title Заголовок вопроса или название модуля
+	Title          string           `json:"title"`
+	TotalQuestions int              `json:"totalQuestions"`
+	Type           HomeworkItemType `json:"type"`
+}
 
-// Unauthorized defines model for Unauthorized.
-type Unauthorized = ErrorResponse
+// HomeworkItemType defines model for HomeworkItemType.
+type HomeworkItemType string
 
-// ListMentorsParams defines parameters for ListMentors.
-type ListMentorsParams struct {
-	// Specialization Фильтр по специализации ментора
-	Specialization *string `form:"specialization,omitempty" json:"specialization,omitempty"`
+// HomeworkList defines model for HomeworkList.
+type HomeworkList struct {
+	Items []Homework `json:"items"`
+	Total *int       `json:"total,omitempty"`
+}
 
-	// Limit Количество карточек в выдаче
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// HomeworkRequest Нужен хотя бы один вопрос или модуль, всего не больше 50
+type HomeworkRequest struct {
+	Comment *string `json:"comment,omitempty"`
 
-	// Offset Смещение для постраничной навигации
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	// DueAt Срок выполнения
+	DueAt       *time.Time `json:"dueAt,omitempty"`
+	ModuleIds   *[]int     `json:"moduleIds,omitempty"`
+	QuestionIds *[]int     `json:"questionIds,omitempty"`
+	Title       string     `json:"title"`
 }
 
-// ListQuestionsParams defines parameters for ListQuestions.
-type ListQuestionsParams struct {
-	// Technology Фильтр по технологии
-	Technology *string `form:"technology,omitempty" json:"technology,omitempty"`
+// LedgerEntry defines model for LedgerEntry.
+type LedgerEntry struct {
+	// Amount Сумма в целых единицах валюты
+	Amount    int       `json:"amount"`
+	BookingId int       `json:"bookingId"`
+	CreatedAt time.Time `json:"createdAt"`
 
-	// Limit Количество вопросов в выдаче
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Currency Валюта цены
+	Currency  Currency        `json:"currency"`
+	Id        int             `json:"id"`
+	PaymentId int             `json:"paymentId"`
+	Type      LedgerEntryType `json:"type"`
+}
 
-	// Offset Смещение для постраничной навигации
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+// LedgerEntryList defines model for LedgerEntryList.
+type LedgerEntryList struct {
+	Items []LedgerEntry `json:"items"`
+	Total *int          `json:"total,omitempty"`
 }
 
+// LedgerEntryType defines model for LedgerEntryType.
+type LedgerEntryType string
+
+// MenteeSummary defines model for MenteeSummary.
+type MenteeSummary struct {
+	Answers           *AnswerStats `json:"answers,omitempty"`
+	AvatarUrl         *string      `json:"avatarUrl,omitempty"`
+	CompletedSessions int          `json:"completedSessions"`
+
+	// Courses Прогресс по курсам, только с согласия ученика
+	Courses       *[]CourseProgress `json:"courses,omitempty"`
+	LastSessionAt *time.Time        `json:"lastSessionAt,omitempty"`
+	Name          string            `json:"name"`
+	NextSessionAt *time.Time        `json:"nextSessionAt,omitempty"`
+
+	// ProgressShared Разрешил ли ученик видеть свой прогресс
+	ProgressShared bool `json:"progressShared"`
+	UserId         int  `json:"userId"`
+}
+
+// MentorApplication defines model for MentorApplication.
+type MentorApplication struct {
+	ApplicantName string             `json:"applicantName"`
+	Contacts      *[]MentorContact   `json:"contacts,omitempty"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	Description   *string            `json:"description,omitempty"`
+	Grade         *string            `json:"grade,omitempty"`
+	Id            int                `json:"id"`
+	Pricelist     *[]MentorPriceItem `json:"pricelist,omitempty"`
+
+	// ReviewComment Комментарий модератора
+	ReviewComment     *string                 `json:"reviewComment,omitempty"`
+	ReviewedAt        *time.Time              `json:"reviewedAt,omitempty"`
+	Specialization    string                  `json:"specialization"`
+	Status            MentorApplicationStatus `json:"status"`
+	Tags              []string                `json:"tags"`
+	UserId            int                     `json:"userId"`
+	YearsOfExperience *int                    `json:"yearsOfExperience,omitempty"`
+}
+
+// MentorApplicationList defines model for MentorApplicationList.
+type MentorApplicationList struct {
+	Items []MentorApplication `json:"items"`
+	Total *int                `json:"total,omitempty"`
+}
+
+// MentorApplicationRequest defines model for MentorApplicationRequest.
+type MentorApplicationRequest struct {
+	// Contacts Контакты для связи
+	Contacts *[]MentorContact `json:"contacts,omitempty"`
+
+	// Description Рассказ о себе и своем опыте
+	Description *string `json:"description,omitempty"`
+
+	// Grade Грейд, например Middle или Senior
+	Grade *string `json:"grade,omitempty"`
+
+	// Pricelist Услуги и их стоимость
+	Pricelist *[]MentorPriceItem `json:"pricelist,omitempty"`
+
+	// Specialization Основное направление ментора
+	Specialization string `json:"specialization"`
+
+	// Tags Технологии и направления; сохраняются в нижнем регистре без пустых значений и повторов
+	Tags *[]string `json:"tags,omitempty"`
+
+	// YearsOfExperience Общий стаж работы
+	YearsOfExperience *int `json:"yearsOfExperience,omitempty"`
+}
+
+// MentorApplicationReview defines model for MentorApplicationReview.
+type MentorApplicationReview struct {
+	// Comment Комментарий модератора для заявителя
+	Comment *string `json:"comment,omitempty"`
+}
+
+// MentorApplicationStatus defines model for MentorApplicationStatus.
+type MentorApplicationStatus string
+
+// MentorBadge Действующее подтверждение опыта ментора
+type MentorBadge struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Title Подтвержденное утверждение, например "Senior Backend в Яндексе"
+	Title string `json:"title"`
+
+	// Type Тип утверждения - место работы, сертификат или профиль GitHub
+	Type       VerificationType `json:"type"`
+	VerifiedAt time.Time        `json:"verifiedAt"`
+}
+
+// MentorCard defines model for MentorCard.
+type MentorCard struct {
+	// Badges Действующие подтверждения опыта
+	Badges *[]MentorBadge `json:"badges,omitempty"`
+
+	// ContactChannels Каналы, по которым можно связаться с ментором
+	ContactChannels *[]MentorContactType `json:"contactChannels,omitempty"`
+	FullName        string               `json:"fullName"`
+
+	// Grade Грейд ментора, например Senior
+	Grade *string `json:"grade,omitempty"`
+	Id    int     `json:"id"`
+
+	// IsAvailable Принимает ли ментор новых учеников
+	IsAvailable *bool `json:"isAvailable,omitempty"`
+
+	// Languages Языки занятий, коды ISO 639-1
+	Languages *[]string `json:"languages,omitempty"`
+
+	// PriceRange Диапазон цен на услуги ментора
+	PriceRange *PriceRange `json:"priceRange,omitempty"`
+
+	// Rating Средняя оценка по отзывам
+	Rating *float64 `json:"rating,omitempty"`
+
+	// ReviewsCount Количество отзывов
+	ReviewsCount *int `json:"reviewsCount,omitempty"`
+
+	// Skills Основные технологии и направления
+	Skills []string `json:"skills"`
+
+	// Title Основная должность или позиция ментора
+	Title string `json:"title"`
+
+	// YearsOfExperience Общий стаж работы
+	YearsOfExperience *int `json:"yearsOfExperience,omitempty"`
+}
+
+// MentorContact defines model for MentorContact.
+type MentorContact struct {
+	// Type Канал связи с ментором
+	Type MentorContactType `json:"type"`
+
+	// Value Никнейм, адрес, телефон или ссылка
+	Value string `json:"value"`
+}
+
+// MentorContactType Канал связи с ментором
+type MentorContactType string
+
+// MentorDashboard defines model for MentorDashboard.
+type MentorDashboard struct {
+	// Currency Валюта цены
+	Currency *Currency       `json:"currency,omitempty"`
+	Mentees  []MenteeSummary `json:"mentees"`
+
+	// Period Период агрегации графиков дашборда
+	Period DashboardPeriod `json:"period"`
+	Rating *float64        `json:"rating,omitempty"`
+
+	// RatingTrend Средняя оценка по периодам от старых к новым
+	RatingTrend []RatingPoint `json:"ratingTrend"`
+
+	// Recent Последние прошедшие занятия
+	Recent []Booking `json:"recent"`
+
+	// Revenue Выручка по периодам от старых к новым
+	Revenue      []RevenuePoint `json:"revenue"`
+	ReviewsCount int            `json:"reviewsCount"`
+
+	// Stats Статистика занятий ментора за все время
+	Stats SessionStats `json:"stats"`
+
+	// Upcoming Ближайшие занятия
+	Upcoming []Booking `json:"upcoming"`
+}
+
+// MentorList defines model for MentorList.
+type MentorList struct {
+	Items []MentorCard `json:"items"`
+
+	// Total Общее количество доступных менторов
+	Total *int `json:"total,omitempty"`
+}
+
+// MentorPriceItem defines model for MentorPriceItem.
+type MentorPriceItem struct {
+	// Currency Валюта цены
+	Currency    Currency `json:"currency"`
+	Description *string  `json:"description,omitempty"`
+
+	// DurationMinutes Длительность услуги в минутах
+	DurationMinutes *int `json:"durationMinutes,omitempty"`
+
+	// Price Стоимость в целых единицах валюты
+	Price int `json:"price"`
+
+	// Title Название услуги
+	Title string `json:"title"`
+}
+
+// MentorProfile defines model for MentorProfile.
+type MentorProfile struct {
+	AvatarUrl *string `json:"avatarUrl,omitempty"`
+
+	// Badges Действующие подтверждения опыта
+	Badges *[]MentorBadge `json:"badges,omitempty"`
+
+	// Contacts Контакты для связи с ментором
+	Contacts  *[]MentorContact `json:"contacts,omitempty"`
+	CreatedAt time.Time        `json:"createdAt"`
+
+	// Description Рассказ ментора о себе
+	Description *string `json:"description,omitempty"`
+	FullName    string  `json:"fullName"`
+
+	// Grade Грейд ментора, например Senior
+	Grade *string `json:"grade,omitempty"`
+	Id    int     `json:"id"`
+
+	// IsAvailable Принимает ли ментор новых учеников
+	IsAvailable bool `json:"isAvailable"`
+
+	// Languages Языки занятий, коды ISO 639-1
+	Languages []string `json:"languages"`
+
+	// Pricelist Услуги ментора и их стоимость
+	Pricelist *[]MentorPriceItem `json:"pricelist,omitempty"`
+
+	// Rating Средняя оценка по отзывам
+	Rating *float64 `json:"rating,omitempty"`
+
+	// ReviewsCount Количество отзывов
+	ReviewsCount int `json:"reviewsCount"`
+
+	// Skills Основные технологии и направления
+	Skills []string `json:"skills"`
+
+	// Specialization Основное направление ментора
+	Specialization string `json:"specialization"`
+	UserId         int    `json:"userId"`
+
+	// YearsOfExperience Общий стаж работы
+	YearsOfExperience *int `json:"yearsOfExperience,omitempty"`
+}
+
+// MentorProfileUpdate defines model for MentorProfileUpdate.
+type MentorProfileUpdate struct {
+	// Contacts Контакты для связи
+	Contacts *[]MentorContact `json:"contacts,omitempty"`
+
+	// Description Рассказ о себе и своем опыте
+	Description *string `json:"description,omitempty"`
+
+	// Grade Грейд, например Middle или Senior
+	Grade *string `json:"grade,omitempty"`
+
+	// IsAvailable Принимает ли ментор новых учеников
+	IsAvailable *bool `json:"isAvailable,omitempty"`
+
+	// Languages Языки занятий, коды ISO 639-1, например ru или en
+	Languages *[]string `json:"languages,omitempty"`
+
+	// Pricelist Услуги и их стоимость в одной валюте
+	Pricelist *[]MentorPriceItem `json:"pricelist,omitempty"`
+
+	// Skills Технологии и направления; сохраняются в нижнем регистре без пустых значений и повторов
+	Skills *[]string `json:"skills,omitempty"`
+
+	// Specialization Основное направление ментора
+	Specialization string `json:"specialization"`
+
+	// YearsOfExperience Общий стаж работы
+	YearsOfExperience *int `json:"yearsOfExperience,omitempty"`
+}
+
+// MentorRecommendation defines model for MentorRecommendation.
+type MentorRecommendation struct {
+	// MatchedTechnologies Слабые технологии, которые закрывает ментор
+	MatchedTechnologies []string   `json:"matchedTechnologies"`
+	Mentor              MentorCard `json:"mentor"`
+
+	// Score Насколько ментор закрывает слабые технологии
+	Score float64 `json:"score"`
+}
+
+// MentorRecommendationList defines model for MentorRecommendationList.
+type MentorRecommendationList struct {
+	Items            []MentorRecommendation `json:"items"`
+	WeakTechnologies []TechnologyWeakness   `json:"weakTechnologies"`
+}
+
+// MentorVerification defines model for MentorVerification.
+type MentorVerification struct {
+	CreatedAt   time.Time `json:"createdAt"`
+	Details     *string   `json:"details,omitempty"`
+	EvidenceUrl string    `json:"evidenceUrl"`
+
+	// ExpiresAt До какого момента действует подтверждение
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	Id         int        `json:"id"`
+	MentorId   int        `json:"mentorId"`
+	MentorName string     `json:"mentorName"`
+
+	// ReviewComment Комментарий модератора или причина отзыва
+	ReviewComment *string    `json:"reviewComment,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewedAt,omitempty"`
+
+	// Status Статус подтверждения; expired - срок подтверждения истек
+	Status VerificationStatus `json:"status"`
+	Title  string             `json:"title"`
+
+	// Type Тип утверждения - место работы, сертификат или профиль GitHub
+	Type VerificationType `json:"type"`
+}
+
+// MentorVerificationList defines model for MentorVerificationList.
+type MentorVerificationList struct {
+	Items []MentorVerification `json:"items"`
+	Total *int                 `json:"total,omitempty"`
+}
+
+// MentorVerificationRequest defines model for MentorVerificationRequest.
+type MentorVerificationRequest struct {
+	// Details Пояснение для модератора
+	Details *string `json:"details,omitempty"`
+
+	// EvidenceUrl Ссылка на доказательство (http или https). Для типа github - ссылка на профиль github.com
+	EvidenceUrl string `json:"evidenceUrl"`
+
+	// Title Утверждение, которое нужно подтвердить
+	Title string `json:"title"`
+
+	// Type Тип утверждения - место работы, сертификат или профиль GitHub
+	Type VerificationType `json:"type"`
+}
+
+// Message defines model for Message.
+type Message struct {
+	Body           string    `json:"body"`
+	ConversationId int       `json:"conversationId"`
+	CreatedAt      time.Time `json:"createdAt"`
+	Id             int       `json:"id"`
+	SenderId       int       `json:"senderId"`
+}
+
+// MessageList defines model for MessageList.
+type MessageList struct {
+	Items []Message `json:"items"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *int `json:"nextCursor,omitempty"`
+}
+
+// MessageRequest defines model for MessageRequest.
+type MessageRequest struct {
+	Body string `json:"body"`
+}
+
+// MultipleChoiceAnswerKey defines model for MultipleChoiceAnswerKey.
+type MultipleChoiceAnswerKey struct {
+	// Answers Все правильные варианты. Ответ верен, только если выбраны все и ни одного лишнего
+	Answers []string `json:"answers"`
+	Type    string   `json:"type"`
+}
+
+// NoteVisibility Видимость заметки. private - только автору, shared - обоим участникам занятия.
+type NoteVisibility string
+
+// Notification defines model for Notification.
+type Notification struct {
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	Id        int       `json:"id"`
+	IsRead    bool      `json:"isRead"`
+
+	// Payload Дополнительные данные события
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+	ReadAt  *time.Time              `json:"readAt,omitempty"`
+	Title   string                  `json:"title"`
+
+	// Type Тип события, например booking_accepted
+	Type string `json:"type"`
+}
+
+// NotificationList defines model for NotificationList.
+type NotificationList struct {
+	Items []Notification `json:"items"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *int `json:"nextCursor,omitempty"`
+
+	// UnreadCount Общее количество непрочитанных уведомлений
+	UnreadCount int `json:"unreadCount"`
+}
+
+// NotificationPreference defines model for NotificationPreference.
+type NotificationPreference struct {
+	// Email Дублировать уведомления на email
+	Email bool `json:"email"`
+
+	// Telegram Дублировать уведомления в Telegram
+	Telegram bool `json:"telegram"`
+
+	// Type Тип события
+	Type string `json:"type"`
+}
+
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	Items []NotificationPreference `json:"items"`
+}
+
+// NumericAnswerKey defines model for NumericAnswerKey.
+type NumericAnswerKey struct {
+	// Tolerance Допустимое отклонение от value, по умолчанию 0
+	Tolerance *float64 `json:"tolerance,omitempty"`
+	Type      string   `json:"type"`
+	Value     float64  `json:"value"`
+}
+
+// OrderingAnswerKey defines model for OrderingAnswerKey.
+type OrderingAnswerKey struct {
+	// Order Все элементы options в правильном порядке
+	Order []string `json:"order"`
+	Type  string   `json:"type"`
+}
+
+// Payment defines model for Payment.
+type Payment struct {
+	// Amount Стоимость занятия в целых единицах валюты
+	Amount    int `json:"amount"`
+	BookingId int `json:"bookingId"`
+
+	// CapturedAmount Сумма в целых единицах валюты, списанная в пользу ментора
+	CapturedAmount int `json:"capturedAmount"`
+
+	// ClientSecret Секрет для подтверждения оплаты у провайдера, только для плательщика
+	ClientSecret *string   `json:"clientSecret,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+
+	// Currency Валюта цены
+	Currency         Currency `json:"currency"`
+	Id               int      `json:"id"`
+	Provider         string   `json:"provider"`
+	ProviderIntentId string   `json:"providerIntentId"`
+
+	// RefundedAmount Сумма в целых единицах валюты, возвращенная ученику
+	RefundedAmount int `json:"refundedAmount"`
+
+	// Status pending - ждет оплаты; held - деньги удерживаются до завершения занятия; captured - списаны в пользу ментора; partially_refunded - часть возвращена ученику; refunded - все возвращено; cancelled - платеж отменен до оплаты; failed - оплата не прошла.
+	Status    PaymentStatus `json:"status"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// PaymentList defines model for PaymentList.
+type PaymentList struct {
+	Items []Payment `json:"items"`
+}
+
+// PaymentStatus pending - ждет оплаты; held - деньги удерживаются до завершения занятия; captured - списаны в пользу ментора; partially_refunded - часть возвращена ученику; refunded - все возвращено; cancelled - платеж отменен до оплаты; failed - оплата не прошла.
+type PaymentStatus string
+
+// PaymentWebhookEvent Событие в формате провайдера
+type PaymentWebhookEvent = map[string]interface{}
+
+// PriceRange Диапазон цен на услуги ментора
+type PriceRange struct {
+	// Currency Валюта цены
+	Currency Currency `json:"currency"`
+	Max      int      `json:"max"`
+	Min      int      `json:"min"`
+}
+
+// QuestionAnswerKey Правильный ответ, тип вопроса задается полем type. Возвращается только после попытки ответить, автору вопроса и администратору.
+type QuestionAnswerKey struct {
+	union json.RawMessage
+}
+
+// QuestionDetail defines model for QuestionDetail.
+type QuestionDetail struct {
+	// AnswerKey Правильный ответ, тип вопроса задается полем type. Возвращается только после попытки ответить, автору вопроса и администратору.
+	AnswerKey *QuestionAnswerKey `json:"answerKey,omitempty"`
+
+	// AuthorId Автор вопроса или пользователь, который его предложил
+	AuthorId *int `json:"authorId,omitempty"`
+
+	// AuthorName Имя автора; отсутствует у вопросов из начального наполнения
+	AuthorName *string `json:"authorName,omitempty"`
+
+	// CompanyTags Компании, на собеседованиях в которых встречался вопрос
+	CompanyTags *[]string `json:"companyTags,omitempty"`
+
+	// Content Полный текст вопроса
+	Content string `json:"content"`
+
+	// Difficulty Уровень сложности вопроса
+	Difficulty QuestionDetailDifficulty `json:"difficulty"`
+
+	// Explanation Объяснение правильного ответа, только после попытки ответить
+	Explanation *string `json:"explanation,omitempty"`
+	Id          int     `json:"id"`
+
+	// Language Язык решения для вопросов типа code
+	Language *string `json:"language,omitempty"`
+
+	// MyProgress Прогресс текущего пользователя по вопросу
+	MyProgress *QuestionProgress `json:"myProgress,omitempty"`
+
+	// Options Варианты ответов для вопросов с выбором или элементы в порядке показа для ordering. Для остальных типов пустой.
+	Options []string `json:"options"`
+
+	// Revision Номер текущей ревизии, увеличивается при каждом изменении
+	Revision int `json:"revision"`
+
+	// StarterCode Заготовка решения для вопросов типа code
+	StarterCode *string `json:"starterCode,omitempty"`
+
+	// Technologies Технологии, к которым относится вопрос
+	Technologies []string `json:"technologies"`
+
+	// Title Название вопроса
+	Title string `json:"title"`
+
+	// Type single_choice - один вариант, multiple_choice - несколько вариантов, ordering - расставить элементы по порядку, numeric - число, free_text - короткий текст, code - фрагмент кода
+	Type      QuestionType `json:"type"`
+	UpdatedAt *time.Time   `json:"updatedAt,omitempty"`
+}
+
+// QuestionDetailDifficulty Уровень сложности вопроса
+type QuestionDetailDifficulty string
+
+// QuestionFileFormat Формат файла вопросов. JSON и YAML - список объектов QuestionRecord. CSV - таблица с заголовком, разделитель запятая или точка с запятой. Markdown - вопросы под заголовками второго уровня. Подробное описание CSV и Markdown - в README.
+type QuestionFileFormat string
+
+// QuestionImportReport defines model for QuestionImportReport.
+type QuestionImportReport struct {
+	// Applied Изменения сохранены; false при пробном импорте и при ошибках
+	Applied   bool                      `json:"applied"`
+	Created   int                       `json:"created"`
+	DryRun    bool                      `json:"dryRun"`
+	Failed    int                       `json:"failed"`
+	Rows      []QuestionImportRowResult `json:"rows"`
+	Unchanged int                       `json:"unchanged"`
+	Updated   int                       `json:"updated"`
+}
+
+// QuestionImportRowResult defines model for QuestionImportRowResult.
+type QuestionImportRowResult struct {
+	// Action Что произошло или произойдет с вопросом; unchanged - вопрос с таким ключом уже есть и не отличается от файла
+	Action QuestionImportRowResultAction `json:"action"`
+	Error  *string                       `json:"error,omitempty"`
+	Key    *string                       `json:"key,omitempty"`
+
+	// QuestionId ID созданного или обновленного вопроса; при пробном импорте только для обновляемых
+	QuestionId *int `json:"questionId,omitempty"`
+
+	// Row Порядковый номер вопроса в файле, начиная с 1
+	Row int `json:"row"`
+}
+
+// QuestionImportRowResultAction Что произошло или произойдет с вопросом; unchanged - вопрос с таким ключом уже есть и не отличается от файла
+type QuestionImportRowResultAction string
+
+// QuestionList defines model for QuestionList.
+type QuestionList struct {
+	Items []QuestionListItem `json:"items"`
+
+	// Total Общее количество доступных вопросов
+	Total *int `json:"total,omitempty"`
+}
+
+// QuestionListItem defines model for QuestionListItem.
+type QuestionListItem struct {
+	// AnsweredCount Сколько пользователей отвечали на вопрос
+	AnsweredCount int `json:"answeredCount"`
+
+	// AttemptsCount Сколько всего было попыток
+	AttemptsCount int                        `json:"attemptsCount"`
+	CompanyTags   []string                   `json:"companyTags"`
+	CreatedAt     time.Time                  `json:"createdAt"`
+	Difficulty    QuestionListItemDifficulty `json:"difficulty"`
+	Id            int                        `json:"id"`
+
+	// SuccessRate Доля пользователей, последний ответ которых верен; отсутствует, если ответов не было
+	SuccessRate *float64 `json:"successRate,omitempty"`
+
+	// Technologies Технологии, к которым относится вопрос
+	Technologies []string `json:"technologies"`
+
+	// Title Название вопроса
+	Title string `json:"title"`
+
+	// Type single_choice - один вариант, multiple_choice - несколько вариантов, ordering - расставить элементы по порядку, numeric - число, free_text - короткий текст, code - фрагмент кода
+	Type QuestionType `json:"type"`
+}
+
+// QuestionListItemDifficulty defines model for QuestionListItemDifficulty.
+type QuestionListItemDifficulty string
+
+// QuestionProgress Прогресс текущего пользователя по вопросу
+type QuestionProgress struct {
+	// AnsweredAt Время последней попытки
+	AnsweredAt time.Time `json:"answeredAt"`
+	Attempts   int       `json:"attempts"`
+
+	// IsCorrect Верна ли последняя попытка
+	IsCorrect  bool    `json:"isCorrect"`
+	LastAnswer *string `json:"lastAnswer,omitempty"`
+
+	// Revision Ревизия вопроса, по которой проверена последняя попытка
+	Revision *int `json:"revision,omitempty"`
+
+	// Schedule Расписание интервального повторения вопроса по алгоритму SM-2
+	Schedule ReviewSchedule `json:"schedule"`
+
+	// TimeSpentSeconds Суммарное время всех попыток
+	TimeSpentSeconds *int `json:"timeSpentSeconds,omitempty"`
+}
+
+// QuestionRecord Вопрос в файле импорта и экспорта
+type QuestionRecord struct {
+	// AnswerKey Ключ ответа в файле; заполняются поля, относящиеся к типу вопроса
+	AnswerKey   QuestionRecordAnswerKey  `json:"answerKey"`
+	CompanyTags *[]string                `json:"companyTags,omitempty"`
+	Content     string                   `json:"content"`
+	Difficulty  QuestionRecordDifficulty `json:"difficulty"`
+	Explanation *string                  `json:"explanation,omitempty"`
+
+	// Key Ключ вопроса; повторный импорт с тем же ключом обновляет вопрос
+	Key          *string   `json:"key,omitempty"`
+	Language     *string   `json:"language,omitempty"`
+	Options      *[]string `json:"options,omitempty"`
+	StarterCode  *string   `json:"starterCode,omitempty"`
+	Technologies []string  `json:"technologies"`
+	Title        string    `json:"title"`
+
+	// Type single_choice - один вариант, multiple_choice - несколько вариантов, ordering - расставить элементы по порядку, numeric - число, free_text - короткий текст, code - фрагмент кода
+	Type QuestionType `json:"type"`
+}
+
+// QuestionRecordDifficulty defines model for QuestionRecordDifficulty.
+type QuestionRecordDifficulty string
+
+// QuestionRecordAnswerKey Ключ ответа в файле; заполняются поля, относящиеся к типу вопроса
+type QuestionRecordAnswerKey struct {
+	Answer        *string   `json:"answer,omitempty"`
+	Answers       *[]string `json:"answers,omitempty"`
+	CaseSensitive *bool     `json:"caseSensitive,omitempty"`
+	Order         *[]string `json:"order,omitempty"`
+	Patterns      *[]string `json:"patterns,omitempty"`
+	Solutions     *[]string `json:"solutions,omitempty"`
+	Tolerance     *float64  `json:"tolerance,omitempty"`
+	Value         *float64  `json:"value,omitempty"`
+}
+
+// QuestionRecordList defines model for QuestionRecordList.
+type QuestionRecordList = []QuestionRecord
+
+// QuestionRequest defines model for QuestionRequest.
+type QuestionRequest struct {
+	// AnswerKey Правильный ответ, тип вопроса задается полем type. Возвращается только после попытки ответить, автору вопроса и администратору.
+	AnswerKey   QuestionAnswerKey         `json:"answerKey"`
+	CompanyTags *[]string                 `json:"companyTags,omitempty"`
+	Content     string                    `json:"content"`
+	Difficulty  QuestionRequestDifficulty `json:"difficulty"`
+	Explanation *string                   `json:"explanation,omitempty"`
+
+	// Language Язык решения, только для code
+	Language *string `json:"language,omitempty"`
+
+	// Options Варианты ответов для single_choice и multiple_choice, элементы в порядке показа для ordering. Для остальных типов не передаются.
+	Options *[]string `json:"options,omitempty"`
+
+	// StarterCode Заготовка решения, только для code
+	StarterCode *string `json:"starterCode,omitempty"`
+
+	// Technologies Названия технологий, регистр не учитывается
+	Technologies []string `json:"technologies"`
+	Title        string   `json:"title"`
+}
+
+// QuestionRequestDifficulty defines model for QuestionRequestDifficulty.
+type QuestionRequestDifficulty string
+
+// QuestionRevision Неизменяемый снимок содержимого вопроса после создания или правки
+type QuestionRevision struct {
+	// AnswerKey Правильный ответ, тип вопроса задается полем type. Возвращается только после попытки ответить, автору вопроса и администратору.
+	AnswerKey   *QuestionAnswerKey         `json:"answerKey,omitempty"`
+	CompanyTags *[]string                  `json:"companyTags,omitempty"`
+	Content     string                     `json:"content"`
+	CreatedAt   time.Time                  `json:"createdAt"`
+	Difficulty  QuestionRevisionDifficulty `json:"difficulty"`
+
+	// EditorId Кто сохранил ревизию; отсутствует, если пользователь удален
+	EditorId    *int     `json:"editorId,omitempty"`
+	Explanation *string  `json:"explanation,omitempty"`
+	Language    *string  `json:"language,omitempty"`
+	Options     []string `json:"options"`
+	Revision    int      `json:"revision"`
+
+	// RolledBackFrom Номер ревизии, к которой откатили вопрос
+	RolledBackFrom *int     `json:"rolledBackFrom,omitempty"`
+	StarterCode    *string  `json:"starterCode,omitempty"`
+	Technologies   []string `json:"technologies"`
+	Title          string   `json:"title"`
+
+	// Type single_choice - один вариант, multiple_choice - несколько вариантов, ordering - расставить элементы по порядку, numeric - число, free_text - короткий текст, code - фрагмент кода
+	Type QuestionType `json:"type"`
+}
+
+// QuestionRevisionDifficulty defines model for QuestionRevisionDifficulty.
+type QuestionRevisionDifficulty string
+
+// QuestionRevisionDiff defines model for QuestionRevisionDiff.
+type QuestionRevisionDiff struct {
+	// ChangedFields Поля, которыми различаются ревизии, в названиях QuestionRequest
+	ChangedFields []string `json:"changedFields"`
+
+	// From Неизменяемый снимок содержимого вопроса после создания или правки
+	From QuestionRevision `json:"from"`
+
+	// TextDiffs Построчная разница для измененных текстовых полей
+	TextDiffs []QuestionTextDiff `json:"textDiffs"`
+
+	// To Неизменяемый снимок содержимого вопроса после создания или правки
+	To QuestionRevision `json:"to"`
+}
+
+// QuestionRevisionList defines model for QuestionRevisionList.
+type QuestionRevisionList struct {
+	Items []QuestionRevision `json:"items"`
+}
+
+// QuestionSearchHit defines model for QuestionSearchHit.
+type QuestionSearchHit struct {
+	CompanyTags []string                    `json:"companyTags"`
+	Difficulty  QuestionSearchHitDifficulty `json:"difficulty"`
+	Id          int                         `json:"id"`
+
+	// Rank Релевантность запросу, больше - выше
+	Rank float64 `json:"rank"`
+
+	// Snippet HTML: фрагменты текста вопроса с экранированными спецсимволами (&amp;, &lt;, &gt;), найденные слова обернуты в тег mark
+	Snippet      string   `json:"snippet"`
+	Technologies []string `json:"technologies"`
+	Title        string   `json:"title"`
+
+	// TitleHighlight HTML: заголовок с экранированными спецсимволами (&amp;, &lt;, &gt;), найденные слова обернуты в тег mark
+	TitleHighlight string `json:"titleHighlight"`
+
+	// Type single_choice - один вариант, multiple_choice - несколько вариантов, ordering - расставить элементы по порядку, numeric - число, free_text - короткий текст, code - фрагмент кода
+	Type QuestionType `json:"type"`
+}
+
+// QuestionSearchHitDifficulty defines model for QuestionSearchHitDifficulty.
+type QuestionSearchHitDifficulty string
+
+// QuestionSearchResult defines model for QuestionSearchResult.
+type QuestionSearchResult struct {
+	Items []QuestionSearchHit `json:"items"`
+	Total int                 `json:"total"`
+}
+
+// QuestionSubmission Вопрос, предложенный пользователем, вместе с решением модератора
+type QuestionSubmission struct {
+	// DuplicateOf ID опубликованного вопроса, с которым объединен дубликат
+	DuplicateOf *int           `json:"duplicateOf,omitempty"`
+	Question    QuestionDetail `json:"question"`
+
+	// ReviewComment Комментарий модератора или причина отклонения
+	ReviewComment *string    `json:"reviewComment,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewedAt,omitempty"`
+
+	// Status draft - ожидает модерации, published - опубликован, rejected - отклонен, merged - объединен с опубликованным вопросом-дубликатом
+	Status QuestionSubmissionStatus `json:"status"`
+}
+
+// QuestionSubmissionList defines model for QuestionSubmissionList.
+type QuestionSubmissionList struct {
+	Items []QuestionSubmission `json:"items"`
+	Total *int                 `json:"total,omitempty"`
+}
+
+// QuestionSubmissionMerge defines model for QuestionSubmissionMerge.
+type QuestionSubmissionMerge struct {
+	// Comment Комментарий модератора для автора
+	Comment *string `json:"comment,omitempty"`
+
+	// DuplicateOf ID опубликованного вопроса
+	DuplicateOf int `json:"duplicateOf"`
+}
+
+// QuestionSubmissionRejection defines model for QuestionSubmissionRejection.
+type QuestionSubmissionRejection struct {
+	// Reason Причина отклонения, отправляется автору
+	Reason string `json:"reason"`
+}
+
+// QuestionSubmissionReview defines model for QuestionSubmissionReview.
+type QuestionSubmissionReview struct {
+	// Comment Комментарий модератора для автора
+	Comment *string `json:"comment,omitempty"`
+}
+
+// QuestionSubmissionStatus draft - ожидает модерации, published - опубликован, rejected - отклонен, merged - объединен с опубликованным вопросом-дубликатом
+type QuestionSubmissionStatus string
+
+// QuestionTextDiff defines model for QuestionTextDiff.
+type QuestionTextDiff struct {
+	Field QuestionTextDiffField `json:"field"`
+	Lines []DiffLine            `json:"lines"`
+}
+
+// QuestionTextDiffField defines model for QuestionTextDiffField.
+type QuestionTextDiffField string
+
+// QuestionType single_choice - один вариант, multiple_choice - несколько вариантов, ordering - расставить элементы по порядку, numeric - число, free_text - короткий текст, code - фрагмент кода
+type QuestionType string
+
+// RatingPoint defines model for RatingPoint.
+type RatingPoint struct {
+	// Average Средняя оценка отзывов за период, отсутствует без отзывов
+	Average      *float64  `json:"average,omitempty"`
+	PeriodStart  time.Time `json:"periodStart"`
+	ReviewsCount int       `json:"reviewsCount"`
+}
+
+// ReadReceipt defines model for ReadReceipt.
+type ReadReceipt struct {
+	ConversationId    int `json:"conversationId"`
+	LastReadMessageId int `json:"lastReadMessageId"`
+	UserId            int `json:"userId"`
+}
+
+// RealtimeMessageEvent Данные события message
+type RealtimeMessageEvent struct {
+	Message     Message `json:"message"`
+	UnreadCount int     `json:"unreadCount"`
+}
+
+// RealtimeReadEvent Данные события read
+type RealtimeReadEvent struct {
+	Receipt     ReadReceipt `json:"receipt"`
+	UnreadCount int         `json:"unreadCount"`
+}
+
+// RevenuePoint defines model for RevenuePoint.
+type RevenuePoint struct {
+	PeriodStart time.Time `json:"periodStart"`
+
+	// Revenue Сумма, списанная в пользу ментора по занятиям периода, в целых единицах валюты
+	Revenue int `json:"revenue"`
+
+	// Sessions Количество проведенных занятий
+	Sessions int `json:"sessions"`
+}
+
+// Review defines model for Review.
+type Review struct {
+	AuthorId   int       `json:"authorId"`
+	AuthorName string    `json:"authorName"`
+	BookingId  int       `json:"bookingId"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Id         int       `json:"id"`
+	MentorId   int       `json:"mentorId"`
+
+	// MentorReply Ответ ментора
+	MentorReply *string    `json:"mentorReply,omitempty"`
+	Rating      int        `json:"rating"`
+	RepliedAt   *time.Time `json:"repliedAt,omitempty"`
+
+	// Status Статус отзыва. hidden - скрыт модератором.
+	Status ReviewStatus `json:"status"`
+	Text   *string      `json:"text,omitempty"`
+}
+
+// ReviewList defines model for ReviewList.
+type ReviewList struct {
+	Items []Review `json:"items"`
+	Total *int     `json:"total,omitempty"`
+}
+
+// ReviewQueue defines model for ReviewQueue.
+type ReviewQueue struct {
+	Items []ReviewQueueItem `json:"items"`
+
+	// NextDueAt Ближайший срок повторения, который еще не наступил; отсутствует, если таких вопросов нет
+	NextDueAt *time.Time `json:"nextDueAt,omitempty"`
+
+	// Total Сколько всего вопросов пора повторить
+	Total int `json:"total"`
+}
+
+// ReviewQueueItem defines model for ReviewQueueItem.
+type ReviewQueueItem struct {
+	Attempts    int                       `json:"attempts"`
+	CompanyTags []string                  `json:"companyTags"`
+	Difficulty  ReviewQueueItemDifficulty `json:"difficulty"`
+
+	// IsCorrect Верна ли последняя попытка
+	IsCorrect  bool `json:"isCorrect"`
+	QuestionId int  `json:"questionId"`
+
+	// Schedule Расписание интервального повторения вопроса по алгоритму SM-2
+	Schedule     ReviewSchedule `json:"schedule"`
+	Technologies []string       `json:"technologies"`
+	Title        string         `json:"title"`
+
+	// Type single_choice - один вариант, multiple_choice - несколько вариантов, ordering - расставить элементы по порядку, numeric - число, free_text - короткий текст, code - фрагмент кода
+	Type QuestionType `json:"type"`
+}
+
+// ReviewQueueItemDifficulty defines model for ReviewQueueItemDifficulty.
+type ReviewQueueItemDifficulty string
+
+// ReviewReplyRequest defines model for ReviewReplyRequest.
+type ReviewReplyRequest struct {
+	Reply string `json:"reply"`
+}
+
+// ReviewReport defines model for ReviewReport.
+type ReviewReport struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	Id         int        `json:"id"`
+	Reason     string     `json:"reason"`
+	ReporterId int        `json:"reporterId"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+	Review     *Review    `json:"review,omitempty"`
+	ReviewId   int        `json:"reviewId"`
+
+	// Status Статус жалобы. resolved - отзыв скрыт модератором, dismissed - жалоба отклонена.
+	Status ReviewReportStatus `json:"status"`
+}
+
+// ReviewReportList defines model for ReviewReportList.
+type ReviewReportList struct {
+	Items []ReviewReport `json:"items"`
+	Total *int           `json:"total,omitempty"`
+}
+
+// ReviewReportRequest defines model for ReviewReportRequest.
+type ReviewReportRequest struct {
+	Reason string `json:"reason"`
+}
+
+// ReviewReportStatus Статус жалобы. resolved - отзыв скрыт модератором, dismissed - жалоба отклонена.
+type ReviewReportStatus string
+
+// ReviewRequest defines model for ReviewRequest.
+type ReviewRequest struct {
+	Rating int     `json:"rating"`
+	Text   *string `json:"text,omitempty"`
+}
+
+// ReviewSchedule Расписание интервального повторения вопроса по алгоритму SM-2
+type ReviewSchedule struct {
+	// DueAt Когда вопрос нужно повторить
+	DueAt time.Time `json:"dueAt"`
+
+	// EaseFactor Коэффициент легкости, на который умножается интервал после верного ответа
+	EaseFactor float64 `json:"easeFactor"`
+
+	// IntervalDays Текущий интервал повторения в днях
+	IntervalDays int `json:"intervalDays"`
+
+	// Lapses Сколько раз ответ был забыт
+	Lapses int `json:"lapses"`
+
+	// Repetitions Верных ответов подряд
+	Repetitions int `json:"repetitions"`
+}
+
+// ReviewStatus Статус отзыва. hidden - скрыт модератором.
+type ReviewStatus string
+
+// SessionNote defines model for SessionNote.
+type SessionNote struct {
+	AuthorId     int       `json:"authorId"`
+	BookingId    int       `json:"bookingId"`
+	CreatedAt    time.Time `json:"createdAt"`
+	Id           int       `json:"id"`
+	Improvements *string   `json:"improvements,omitempty"`
+	NextSteps    *string   `json:"nextSteps,omitempty"`
+	Strengths    *string   `json:"strengths,omitempty"`
+	Summary      string    `json:"summary"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+
+	// Visibility Видимость заметки. private - только автору, shared - обоим участникам занятия.
+	Visibility NoteVisibility `json:"visibility"`
+}
+
+// SessionNoteList defines model for SessionNoteList.
+type SessionNoteList struct {
+	Items []SessionNote `json:"items"`
+}
+
+// SessionNoteRequest defines model for SessionNoteRequest.
+type SessionNoteRequest struct {
+	// Improvements Что стоит подтянуть
+	Improvements *string `json:"improvements,omitempty"`
+
+	// NextSteps Следующие шаги
+	NextSteps *string `json:"nextSteps,omitempty"`
+
+	// Strengths Что получается хорошо
+	Strengths *string `json:"strengths,omitempty"`
+
+	// Summary Краткие итоги занятия
+	Summary string `json:"summary"`
+
+	// Visibility Видимость заметки. private - только автору, shared - обоим участникам занятия.
+	Visibility *NoteVisibility `json:"visibility,omitempty"`
+}
+
+// SessionStats Статистика занятий ментора за все время
+type SessionStats struct {
+	// Cancelled Отмененные занятия, в том числе отклоненные запросы
+	Cancelled int `json:"cancelled"`
+	Completed int `json:"completed"`
+
+	// CompletionRate Доля проведенных среди прошедших занятий (completed + no_show)
+	CompletionRate *float64 `json:"completionRate,omitempty"`
+	NoShow         int      `json:"noShow"`
+
+	// NoShowRate Доля неявок среди прошедших занятий
+	NoShowRate *float64 `json:"noShowRate,omitempty"`
+	Total      int      `json:"total"`
+
+	// Upcoming Запрошенные и подтвержденные занятия, которые еще не начались
+	Upcoming int `json:"upcoming"`
+}
+
+// SingleChoiceAnswerKey defines model for SingleChoiceAnswerKey.
+type SingleChoiceAnswerKey struct {
+	// Answer Правильный вариант, один из options
+	Answer string `json:"answer"`
+	Type   string `json:"type"`
+}
+
+// Slot defines model for Slot.
+type Slot struct {
+	EndsAt   time.Time `json:"endsAt"`
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// SlotList defines model for SlotList.
+type SlotList struct {
+	Items []Slot `json:"items"`
+
+	// Timezone Часовой пояс ментора
+	Timezone string `json:"timezone"`
+}
+
+// Technology defines model for Technology.
+type Technology struct {
+	Id            int    `json:"id"`
+	Name          string `json:"name"`
+	QuestionCount int    `json:"questionCount"`
+}
+
+// TechnologyList defines model for TechnologyList.
+type TechnologyList struct {
+	Items []Technology `json:"items"`
+}
+
+// TechnologyWeakness defines model for TechnologyWeakness.
+type TechnologyWeakness struct {
+	// Answered Количество вопросов по технологии, на которые отвечал пользователь
+	Answered int `json:"answered"`
+
+	// Incorrect Из них с неверным последним ответом
+	Incorrect int `json:"incorrect"`
+
+	// Score Сглаженная доля ошибок от 0 до 1, чем больше - тем слабее
+	Score      float64 `json:"score"`
+	Technology string  `json:"technology"`
+}
+
+// UserProfile defines model for UserProfile.
+type UserProfile struct {
+	Email    openapi_types.Email `json:"email"`
+	FullName string              `json:"fullName"`
+	Id       int                 `json:"id"`
+
+	// Role Роль пользователя в системе
+	Role string `json:"role"`
+}
+
+// VerificationDecision defines model for VerificationDecision.
+type VerificationDecision struct {
+	// Comment Комментарий модератора для ментора
+	Comment *string `json:"comment,omitempty"`
+
+	// ExpiresAt Срок действия подтверждения, по умолчанию через год; только для verify
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// VerificationRevocation defines model for VerificationRevocation.
+type VerificationRevocation struct {
+	// Reason Причина отзыва, отправляется ментору
+	Reason string `json:"reason"`
+}
+
+// VerificationStatus Статус подтверждения; expired - срок подтверждения истек
+type VerificationStatus string
+
+// VerificationType Тип утверждения - место работы, сертификат или профиль GitHub
+type VerificationType string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
+
+// Conflict defines model for Conflict.
+type Conflict = ErrorResponse
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// NotFound defines model for NotFound.
+type NotFound = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
+// ListLedgerEntriesParams defines parameters for ListLedgerEntries.
+type ListLedgerEntriesParams struct {
+	// PaymentId Только записи одной оплаты
+	PaymentId *int `form:"paymentId,omitempty" json:"paymentId,omitempty"`
+	Limit     *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset    *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListConversationsParams defines parameters for ListConversations.
+type ListConversationsParams struct {
+	// Limit Количество диалогов в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListMessagesParams defines parameters for ListMessages.
+type ListMessagesParams struct {
+	// Cursor Курсор из поля nextCursor предыдущей страницы
+	Cursor *int `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Количество сообщений в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListEventsParams defines parameters for ListEvents.
+type ListEventsParams struct {
+	// MentorId Только события указанного ментора
+	MentorId *int `form:"mentorId,omitempty" json:"mentorId,omitempty"`
+
+	// Type Тип события
+	Type *EventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// From Начало интервала, по умолчанию текущий момент
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// Limit Количество событий в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListMentorsParams defines parameters for ListMentors.
+type ListMentorsParams struct {
+	// Specialization Фильтр по специализации ментора
+	Specialization *string `form:"specialization,omitempty" json:"specialization,omitempty"`
+
+	// Tags Технологии ментора без учета регистра, например tags=Go&tags=PostgreSQL
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// TagsMode any - нужна хотя бы одна из технологий, all - все сразу
+	TagsMode *ListMentorsParamsTagsMode `form:"tagsMode,omitempty" json:"tagsMode,omitempty"`
+
+	// Grade Грейд ментора, например Senior
+	Grade *string `form:"grade,omitempty" json:"grade,omitempty"`
+
+	// MinExperience Минимальный стаж работы в годах
+	MinExperience *int `form:"minExperience,omitempty" json:"minExperience,omitempty"`
+
+	// PriceMin Нижняя граница цены; прайс-лист ментора должен пересекаться с диапазоном. Границы цены задаются только вместе с currency
+	PriceMin *int `form:"priceMin,omitempty" json:"priceMin,omitempty"`
+
+	// PriceMax Верхняя граница цены
+	PriceMax *int `form:"priceMax,omitempty" json:"priceMax,omitempty"`
+
+	// Currency Валюта прайс-листа
+	Currency *Currency `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// Language Код языка ISO 639-1, на котором ментор проводит занятия
+	Language *string `form:"language,omitempty" json:"language,omitempty"`
+
+	// Available Только менторы, которые принимают новых учеников
+	Available *bool `form:"available,omitempty" json:"available,omitempty"`
+
+	// Sort Поле сортировки; без него менторы выдаются в порядке добавления
+	Sort *ListMentorsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки; по умолчанию desc для rating и experience, asc для price
+	Order *ListMentorsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Количество карточек в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListMentorsParamsTagsMode defines parameters for ListMentors.
+type ListMentorsParamsTagsMode string
+
+// ListMentorsParamsSort defines parameters for ListMentors.
+type ListMentorsParamsSort string
+
+// ListMentorsParamsOrder defines parameters for ListMentors.
+type ListMentorsParamsOrder string
+
+// ListMentorBookingsParams defines parameters for ListMentorBookings.
+type ListMentorBookingsParams struct {
+	// Status Фильтр по статусу бронирования
+	Status *BookingStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int           `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int           `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetMentorDashboardParams defines parameters for GetMentorDashboard.
+type GetMentorDashboardParams struct {
+	// Period Период агрегации графиков, по умолчанию month
+	Period *DashboardPeriod `form:"period,omitempty" json:"period,omitempty"`
+}
+
+// ListMyHostedEventsParams defines parameters for ListMyHostedEvents.
+type ListMyHostedEventsParams struct {
+	// Limit Количество событий в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListMentorHomeworkParams defines parameters for ListMentorHomework.
+type ListMentorHomeworkParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListRecommendedMentorsParams defines parameters for ListRecommendedMentors.
+type ListRecommendedMentorsParams struct {
+	// Limit Количество менторов в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListMentorReviewsParams defines parameters for ListMentorReviews.
+type ListMentorReviewsParams struct {
+	// Limit Количество отзывов в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListMentorSlotsParams defines parameters for ListMentorSlots.
+type ListMentorSlotsParams struct {
+	// From Начало интервала
+	From time.Time `form:"from" json:"from"`
+
+	// To Конец интервала, не более 31 дня от начала
+	To time.Time `form:"to" json:"to"`
+
+	// DurationMinutes Длительность слота, по умолчанию 60 минут
+	DurationMinutes *int `form:"durationMinutes,omitempty" json:"durationMinutes,omitempty"`
+}
+
+// ListMentorApplicationsParams defines parameters for ListMentorApplications.
+type ListMentorApplicationsParams struct {
+	// Status Статус заявок, по умолчанию pending
+	Status *MentorApplicationStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Количество заявок в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListQuestionSubmissionsParams defines parameters for ListQuestionSubmissions.
+type ListQuestionSubmissionsParams struct {
+	// Status Статус вопросов, по умолчанию draft - ожидающие модерации
+	Status *QuestionSubmissionStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Количество вопросов в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListReviewReportsParams defines parameters for ListReviewReports.
+type ListReviewReportsParams struct {
+	// Status Статус жалоб, по умолчанию open
+	Status *ReviewReportStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Количество жалоб в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListVerificationsParams defines parameters for ListVerifications.
+type ListVerificationsParams struct {
+	// Status Статус подтверждений, по умолчанию pending
+	Status *VerificationStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Количество подтверждений в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListQuestionsParams defines parameters for ListQuestions.
+type ListQuestionsParams struct {
+	// Technology Технологии вопроса, например technology=Go&technology=PostgreSQL
+	Technology *[]string `form:"technology,omitempty" json:"technology,omitempty"`
+
+	// TechnologyMode any - нужна хотя бы одна из технологий, all - все сразу
+	TechnologyMode *ListQuestionsParamsTechnologyMode `form:"technologyMode,omitempty" json:"technologyMode,omitempty"`
+
+	// Difficulty Уровни сложности, подходит любой из перечисленных
+	Difficulty *[]ListQuestionsParamsDifficulty `form:"difficulty,omitempty" json:"difficulty,omitempty"`
+
+	// Company Теги компаний, подходит вопрос хотя бы с одним из них
+	Company *[]string `form:"company,omitempty" json:"company,omitempty"`
+
+	// Status Отбор по прогрессу текущего пользователя (требует авторизации): unanswered - вопросы без ответа, failed - вопросы, последний ответ на которые неверен
+	Status *ListQuestionsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Порядок выдачи: newest - сначала новые, hardest - по возрастанию доли верных ответов (вопросы без ответов в конце), most_attempted - по числу попыток. Без него вопросы выдаются в порядке добавления.
+	Sort *ListQuestionsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Количество вопросов в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListQuestionsParamsTechnologyMode defines parameters for ListQuestions.
+type ListQuestionsParamsTechnologyMode string
+
+// ListQuestionsParamsDifficulty defines parameters for ListQuestions.
+type ListQuestionsParamsDifficulty string
+
+// ListQuestionsParamsStatus defines parameters for ListQuestions.
+type ListQuestionsParamsStatus string
+
+// ListQuestionsParamsSort defines parameters for ListQuestions.
+type ListQuestionsParamsSort string
+
+// ExportQuestionsParams defines parameters for ExportQuestions.
+type ExportQuestionsParams struct {
+	Format QuestionFileFormat `form:"format" json:"format"`
+
+	// Technology Технологии вопроса, например technology=Go&technology=PostgreSQL
+	Technology *[]string `form:"technology,omitempty" json:"technology,omitempty"`
+
+	// TechnologyMode any - нужна хотя бы одна из технологий, all - все сразу
+	TechnologyMode *ExportQuestionsParamsTechnologyMode `form:"technologyMode,omitempty" json:"technologyMode,omitempty"`
+
+	// Difficulty Уровни сложности, подходит любой из перечисленных
+	Difficulty *[]ExportQuestionsParamsDifficulty `form:"difficulty,omitempty" json:"difficulty,omitempty"`
+
+	// Company Теги компаний, подходит вопрос хотя бы с одним из них
+	Company *[]string `form:"company,omitempty" json:"company,omitempty"`
+}
+
+// ExportQuestionsParamsTechnologyMode defines parameters for ExportQuestions.
+type ExportQuestionsParamsTechnologyMode string
+
+// ExportQuestionsParamsDifficulty defines parameters for ExportQuestions.
+type ExportQuestionsParamsDifficulty string
+
+// ImportQuestionsParams defines parameters for ImportQuestions.
+type ImportQuestionsParams struct {
+	Format QuestionFileFormat `form:"format" json:"format"`
+
+	// DryRun Только проверить файл, ничего не сохраняя
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// SearchQuestionsParams defines parameters for SearchQuestions.
+type SearchQuestionsParams struct {
+	// Q Текст запроса
+	Q string `form:"q" json:"q"`
+
+	// Technology Технологии вопроса, например technology=Go&technology=PostgreSQL
+	Technology *[]string `form:"technology,omitempty" json:"technology,omitempty"`
+
+	// TechnologyMode any - нужна хотя бы одна из технологий, all - все сразу
+	TechnologyMode *SearchQuestionsParamsTechnologyMode `form:"technologyMode,omitempty" json:"technologyMode,omitempty"`
+
+	// Difficulty Уровни сложности, подходит любой из перечисленных
+	Difficulty *[]SearchQuestionsParamsDifficulty `form:"difficulty,omitempty" json:"difficulty,omitempty"`
+
+	// Company Теги компаний, подходит вопрос хотя бы с одним из них
+	Company *[]string `form:"company,omitempty" json:"company,omitempty"`
+
+	// Limit Количество вопросов в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// SearchQuestionsParamsTechnologyMode defines parameters for SearchQuestions.
+type SearchQuestionsParamsTechnologyMode string
+
+// SearchQuestionsParamsDifficulty defines parameters for SearchQuestions.
+type SearchQuestionsParamsDifficulty string
+
+// DiffQuestionRevisionsParams defines parameters for DiffQuestionRevisions.
+type DiffQuestionRevisionsParams struct {
+	// From Номер исходной ревизии
+	From int `form:"from" json:"from"`
+
+	// To Номер конечной ревизии, по умолчанию текущая
+	To *int `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListMyBookingsParams defines parameters for ListMyBookings.
+type ListMyBookingsParams struct {
+	// Status Фильтр по статусу бронирования
+	Status *BookingStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int           `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int           `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListMyEventsParams defines parameters for ListMyEvents.
+type ListMyEventsParams struct {
+	// Limit Количество событий в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для постраничной навигации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// AccessToken Access токен, если нельзя передать заголовок Authorization
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// ListMyHomeworkParams defines parameters for ListMyHomework.
+type ListMyHomeworkParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListNotificationsParams defines parameters for ListNotifications.
+type ListNotificationsParams struct {
+	// Cursor Курсор из поля nextCursor предыдущей страницы
+	Cursor *int `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Количество уведомлений в выдаче
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// UnreadOnly Вернуть только непрочитанные уведомления
+	UnreadOnly *bool `form:"unreadOnly,omitempty" json:"unreadOnly,omitempty"`
+}
+
+// GetMyReviewQueueParams defines parameters for GetMyReviewQueue.
+type GetMyReviewQueueParams struct {
+	// Technology Технологии вопроса, подходит любая из перечисленных
+	Technology *[]string `form:"technology,omitempty" json:"technology,omitempty"`
+
+	// Difficulty Уровни сложности, подходит любой из перечисленных
+	Difficulty *[]GetMyReviewQueueParamsDifficulty `form:"difficulty,omitempty" json:"difficulty,omitempty"`
+	Limit      *int                                `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset     *int                                `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetMyReviewQueueParamsDifficulty defines parameters for GetMyReviewQueue.
+type GetMyReviewQueueParamsDifficulty string
+
 // LoginUserJSONRequestBody defines body for LoginUser for application/json ContentType.
 type LoginUserJSONRequestBody = AuthLoginRequest
 
@@ -180,3 +2223,320 @@ type RefreshTokensJSONRequestBody = AuthRefreshRequest
 
 // RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
 type RegisterUserJSONRequestBody = AuthRegisterRequest
+
+// CancelBookingJSONRequestBody defines body for CancelBooking for application/json ContentType.
+type CancelBookingJSONRequestBody = BookingCancelRequest
+
+// AssignHomeworkJSONRequestBody defines body for AssignHomework for application/json ContentType.
+type AssignHomeworkJSONRequestBody = HomeworkRequest
+
+// CreateSessionNoteJSONRequestBody defines body for CreateSessionNote for application/json ContentType.
+type CreateSessionNoteJSONRequestBody = SessionNoteRequest
+
+// RescheduleBookingJSONRequestBody defines body for RescheduleBooking for application/json ContentType.
+type RescheduleBookingJSONRequestBody = BookingRescheduleRequest
+
+// CreateReviewJSONRequestBody defines body for CreateReview for application/json ContentType.
+type CreateReviewJSONRequestBody = ReviewRequest
+
+// SendMessageJSONRequestBody defines body for SendMessage for application/json ContentType.
+type SendMessageJSONRequestBody = MessageRequest
+
+// MarkConversationReadJSONRequestBody defines body for MarkConversationRead for application/json ContentType.
+type MarkConversationReadJSONRequestBody = ConversationReadRequest
+
+// UpdateEventJSONRequestBody defines body for UpdateEvent for application/json ContentType.
+type UpdateEventJSONRequestBody = EventUpdate
+
+// MarkEventAttendanceJSONRequestBody defines body for MarkEventAttendance for application/json ContentType.
+type MarkEventAttendanceJSONRequestBody = EventAttendance
+
+// SubmitMentorApplicationJSONRequestBody defines body for SubmitMentorApplication for application/json ContentType.
+type SubmitMentorApplicationJSONRequestBody = MentorApplicationRequest
+
+// UpdateMyMentorProfileJSONRequestBody defines body for UpdateMyMentorProfile for application/json ContentType.
+type UpdateMyMentorProfileJSONRequestBody = MentorProfileUpdate
+
+// UpdateMyAvailabilityJSONRequestBody defines body for UpdateMyAvailability for application/json ContentType.
+type UpdateMyAvailabilityJSONRequestBody = AvailabilityUpdate
+
+// CreateAvailabilityExceptionJSONRequestBody defines body for CreateAvailabilityException for application/json ContentType.
+type CreateAvailabilityExceptionJSONRequestBody = AvailabilityExceptionRequest
+
+// CreateEventJSONRequestBody defines body for CreateEvent for application/json ContentType.
+type CreateEventJSONRequestBody = EventRequest
+
+// SubmitVerificationJSONRequestBody defines body for SubmitVerification for application/json ContentType.
+type SubmitVerificationJSONRequestBody = MentorVerificationRequest
+
+// CreateBookingJSONRequestBody defines body for CreateBooking for application/json ContentType.
+type CreateBookingJSONRequestBody = BookingRequest
+
+// ApproveMentorApplicationJSONRequestBody defines body for ApproveMentorApplication for application/json ContentType.
+type ApproveMentorApplicationJSONRequestBody = MentorApplicationReview
+
+// RejectMentorApplicationJSONRequestBody defines body for RejectMentorApplication for application/json ContentType.
+type RejectMentorApplicationJSONRequestBody = MentorApplicationReview
+
+// EditQuestionSubmissionJSONRequestBody defines body for EditQuestionSubmission for application/json ContentType.
+type EditQuestionSubmissionJSONRequestBody = QuestionRequest
+
+// ApproveQuestionSubmissionJSONRequestBody defines body for ApproveQuestionSubmission for application/json ContentType.
+type ApproveQuestionSubmissionJSONRequestBody = QuestionSubmissionReview
+
+// MergeQuestionSubmissionJSONRequestBody defines body for MergeQuestionSubmission for application/json ContentType.
+type MergeQuestionSubmissionJSONRequestBody = QuestionSubmissionMerge
+
+// RejectQuestionSubmissionJSONRequestBody defines body for RejectQuestionSubmission for application/json ContentType.
+type RejectQuestionSubmissionJSONRequestBody = QuestionSubmissionRejection
+
+// RejectVerificationJSONRequestBody defines body for RejectVerification for application/json ContentType.
+type RejectVerificationJSONRequestBody = VerificationDecision
+
+// RevokeVerificationJSONRequestBody defines body for RevokeVerification for application/json ContentType.
+type RevokeVerificationJSONRequestBody = VerificationRevocation
+
+// VerifyVerificationJSONRequestBody defines body for VerifyVerification for application/json ContentType.
+type VerifyVerificationJSONRequestBody = VerificationDecision
+
+// HandlePaymentWebhookJSONRequestBody defines body for HandlePaymentWebhook for application/json ContentType.
+type HandlePaymentWebhookJSONRequestBody = PaymentWebhookEvent
+
+// CreateQuestionJSONRequestBody defines body for CreateQuestion for application/json ContentType.
+type CreateQuestionJSONRequestBody = QuestionRequest
+
+// ImportQuestionsJSONRequestBody defines body for ImportQuestions for application/json ContentType.
+type ImportQuestionsJSONRequestBody = QuestionRecordList
+
+// SubmitQuestionJSONRequestBody defines body for SubmitQuestion for application/json ContentType.
+type SubmitQuestionJSONRequestBody = QuestionRequest
+
+// UpdateQuestionSubmissionJSONRequestBody defines body for UpdateQuestionSubmission for application/json ContentType.
+type UpdateQuestionSubmissionJSONRequestBody = QuestionRequest
+
+// UpdateQuestionJSONRequestBody defines body for UpdateQuestion for application/json ContentType.
+type UpdateQuestionJSONRequestBody = QuestionRequest
+
+// SubmitQuestionAnswerJSONRequestBody defines body for SubmitQuestionAnswer for application/json ContentType.
+type SubmitQuestionAnswerJSONRequestBody = AnswerSubmission
+
+// ReplyToReviewJSONRequestBody defines body for ReplyToReview for application/json ContentType.
+type ReplyToReviewJSONRequestBody = ReviewReplyRequest
+
+// ReportReviewJSONRequestBody defines body for ReportReview for application/json ContentType.
+type ReportReviewJSONRequestBody = ReviewReportRequest
+
+// UpdateSessionNoteJSONRequestBody defines body for UpdateSessionNote for application/json ContentType.
+type UpdateSessionNoteJSONRequestBody = SessionNoteRequest
+
+// UpdateNotificationPreferencesJSONRequestBody defines body for UpdateNotificationPreferences for application/json ContentType.
+type UpdateNotificationPreferencesJSONRequestBody = NotificationPreferences
+
+// AsSingleChoiceAnswerKey returns the union data inside the QuestionAnswerKey as a SingleChoiceAnswerKey
+func (t QuestionAnswerKey) AsSingleChoiceAnswerKey() (SingleChoiceAnswerKey, error) {
+	var body SingleChoiceAnswerKey
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSingleChoiceAnswerKey overwrites any union data inside the QuestionAnswerKey as the provided SingleChoiceAnswerKey
+func (t *QuestionAnswerKey) FromSingleChoiceAnswerKey(v SingleChoiceAnswerKey) error {
+	v.Type = "single_choice"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSingleChoiceAnswerKey performs a merge with any union data inside the QuestionAnswerKey, using the provided SingleChoiceAnswerKey
+func (t *QuestionAnswerKey) MergeSingleChoiceAnswerKey(v SingleChoiceAnswerKey) error {
+	v.Type = "single_choice"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsMultipleChoiceAnswerKey returns the union data inside the QuestionAnswerKey as a MultipleChoiceAnswerKey
+func (t QuestionAnswerKey) AsMultipleChoiceAnswerKey() (MultipleChoiceAnswerKey, error) {
+	var body MultipleChoiceAnswerKey
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMultipleChoiceAnswerKey overwrites any union data inside the QuestionAnswerKey as the provided MultipleChoiceAnswerKey
+func (t *QuestionAnswerKey) FromMultipleChoiceAnswerKey(v MultipleChoiceAnswerKey) error {
+	v.Type = "multiple_choice"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMultipleChoiceAnswerKey performs a merge with any union data inside the QuestionAnswerKey, using the provided MultipleChoiceAnswerKey
+func (t *QuestionAnswerKey) MergeMultipleChoiceAnswerKey(v MultipleChoiceAnswerKey) error {
+	v.Type = "multiple_choice"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrderingAnswerKey returns the union data inside the QuestionAnswerKey as a OrderingAnswerKey
+func (t QuestionAnswerKey) AsOrderingAnswerKey() (OrderingAnswerKey, error) {
+	var body OrderingAnswerKey
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrderingAnswerKey overwrites any union data inside the QuestionAnswerKey as the provided OrderingAnswerKey
+func (t *QuestionAnswerKey) FromOrderingAnswerKey(v OrderingAnswerKey) error {
+	v.Type = "ordering"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrderingAnswerKey performs a merge with any union data inside the QuestionAnswerKey, using the provided OrderingAnswerKey
+func (t *QuestionAnswerKey) MergeOrderingAnswerKey(v OrderingAnswerKey) error {
+	v.Type = "ordering"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsNumericAnswerKey returns the union data inside the QuestionAnswerKey as a NumericAnswerKey
+func (t QuestionAnswerKey) AsNumericAnswerKey() (NumericAnswerKey, error) {
+	var body NumericAnswerKey
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNumericAnswerKey overwrites any union data inside the QuestionAnswerKey as the provided NumericAnswerKey
+func (t *QuestionAnswerKey) FromNumericAnswerKey(v NumericAnswerKey) error {
+	v.Type = "numeric"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNumericAnswerKey performs a merge with any union data inside the QuestionAnswerKey, using the provided NumericAnswerKey
+func (t *QuestionAnswerKey) MergeNumericAnswerKey(v NumericAnswerKey) error {
+	v.Type = "numeric"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsFreeTextAnswerKey returns the union data inside the QuestionAnswerKey as a FreeTextAnswerKey
+func (t QuestionAnswerKey) AsFreeTextAnswerKey() (FreeTextAnswerKey, error) {
+	var body FreeTextAnswerKey
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFreeTextAnswerKey overwrites any union data inside the QuestionAnswerKey as the provided FreeTextAnswerKey
+func (t *QuestionAnswerKey) FromFreeTextAnswerKey(v FreeTextAnswerKey) error {
+	v.Type = "free_text"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFreeTextAnswerKey performs a merge with any union data inside the QuestionAnswerKey, using the provided FreeTextAnswerKey
+func (t *QuestionAnswerKey) MergeFreeTextAnswerKey(v FreeTextAnswerKey) error {
+	v.Type = "free_text"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCodeAnswerKey returns the union data inside the QuestionAnswerKey as a CodeAnswerKey
+func (t QuestionAnswerKey) AsCodeAnswerKey() (CodeAnswerKey, error) {
+	var body CodeAnswerKey
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCodeAnswerKey overwrites any union data inside the QuestionAnswerKey as the provided CodeAnswerKey
+func (t *QuestionAnswerKey) FromCodeAnswerKey(v CodeAnswerKey) error {
+	v.Type = "code"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCodeAnswerKey performs a merge with any union data inside the QuestionAnswerKey, using the provided CodeAnswerKey
+func (t *QuestionAnswerKey) MergeCodeAnswerKey(v CodeAnswerKey) error {
+	v.Type = "code"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t QuestionAnswerKey) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t QuestionAnswerKey) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "code":
+		return t.AsCodeAnswerKey()
+	case "free_text":
+		return t.AsFreeTextAnswerKey()
+	case "multiple_choice":
+		return t.AsMultipleChoiceAnswerKey()
+	case "numeric":
+		return t.AsNumericAnswerKey()
+	case "ordering":
+		return t.AsOrderingAnswerKey()
+	case "single_choice":
+		return t.AsSingleChoiceAnswerKey()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t QuestionAnswerKey) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *QuestionAnswerKey) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
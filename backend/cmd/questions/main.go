@@ -0,0 +1,187 @@
+// Команда questions импортирует и экспортирует банк вопросов напрямую через базу данных,
+// в обход API. Права администратора: импорт может обновить любой вопрос.
+//
+//	questions import [-format csv] [-dry-run] [-author ID] FILE
+//	questions export [-format markdown] [-technology Go,SQL] [-difficulty easy,hard] [-company Yandex] [-o FILE]
+//
+// Формат по умолчанию определяется по расширению файла. Строка подключения берется
+// из флага -database или переменной окружения DATABASE_URL.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+	"it_rabotyagi/internal/data/database"
+	"it_rabotyagi/internal/data/repositories"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const usage = `usage:
+  questions import [-format json|yaml|csv|markdown] [-dry-run] [-author ID] FILE
+  questions export [-format json|yaml|csv|markdown] [-technology A,B] [-difficulty easy,hard] [-company C] [-o FILE]`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = runImport(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "questions:", err)
+		os.Exit(1)
+	}
+}
+
+// errImportFailed означает, что в файле есть вопросы с ошибками; сами ошибки уже напечатаны
+var errImportFailed = errors.New("import failed, nothing was saved")
+
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	format := fs.String("format", "", "file format, by default taken from the file extension")
+	dryRun := fs.Bool("dry-run", false, "only validate the file")
+	authorID := fs.Int("author", 0, "user ID set as the author of created questions")
+	databaseURL := fs.String("database", os.Getenv("DATABASE_URL"), "PostgreSQL connection string")
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		return errors.New("exactly one FILE is expected")
+	}
+	path := fs.Arg(0)
+
+	if *format == "" {
+		*format = formatFromPath(path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	service, closeDB, err := newQuestionService(*databaseURL)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	report, err := service.Import(context.Background(), *authorID, models.RoleAdmin, *format, file, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range report.Rows {
+		key := ""
+		if row.ExternalKey != nil {
+			key = " [" + *row.ExternalKey + "]"
+		}
+		if row.Error != "" {
+			fmt.Printf("row %d%s: %s: %s\n", row.Row, key, row.Action, row.Error)
+		} else {
+			fmt.Printf("row %d%s: %s\n", row.Row, key, row.Action)
+		}
+	}
+	fmt.Printf("created: %d, updated: %d, unchanged: %d, failed: %d\n", report.Created, report.Updated, report.Unchanged, report.Failed)
+
+	switch {
+	case report.Failed > 0:
+		return errImportFailed
+	case report.DryRun:
+		fmt.Println("dry run, nothing was saved")
+	}
+	return nil
+}
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "", "file format, by default taken from -o or json")
+	output := fs.String("o", "", "output file, stdout by default")
+	technologies := fs.String("technology", "", "comma separated technologies, any of them")
+	difficulties := fs.String("difficulty", "", "comma separated difficulties")
+	companies := fs.String("company", "", "comma separated company tags")
+	databaseURL := fs.String("database", os.Getenv("DATABASE_URL"), "PostgreSQL connection string")
+	_ = fs.Parse(args)
+
+	if *format == "" {
+		*format = formatFromPath(*output)
+	}
+
+	filter := models.QuestionFilter{
+		Technologies: splitFlag(*technologies),
+		Difficulties: splitFlag(*difficulties),
+		CompanyTags:  splitFlag(*companies),
+	}
+
+	service, closeDB, err := newQuestionService(*databaseURL)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	return service.Export(context.Background(), 0, models.RoleAdmin, *format, filter, w)
+}
+
+func newQuestionService(databaseURL string) (*services.QuestionService, func(), error) {
+	if databaseURL == "" {
+		return nil, nil, errors.New("DATABASE_URL is required")
+	}
+	db, err := database.NewPostgresConnection(databaseURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	service := services.NewQuestionService(
+		repositories.NewQuestionRepository(db.Pool),
+		repositories.NewProgressRepository(db),
+	)
+	// db.Close пишет в глобальный логгер, который команда не настраивает
+	return service, db.Pool.Close, nil
+}
+
+// formatFromPath определяет формат по расширению файла, по умолчанию - JSON
+func formatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return models.QuestionFormatYAML
+	case ".csv":
+		return models.QuestionFormatCSV
+	case ".md", ".markdown":
+		return models.QuestionFormatMarkdown
+	}
+	return models.QuestionFormatJSON
+}
+
+func splitFlag(value string) []string {
+	var result []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
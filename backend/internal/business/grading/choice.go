@@ -0,0 +1,119 @@
+package grading
+
+import (
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+)
+
+// singleChoiceGrader проверяет вопросы с одним правильным вариантом
+type singleChoiceGrader struct{}
+
+func (singleChoiceGrader) Validate(q *models.Question) error {
+	options, err := normalizeOptions(q.Options)
+	if err != nil {
+		return err
+	}
+	q.Options = options
+
+	answer, err := selectChoices(options, []string{q.AnswerKey.Answer})
+	if err != nil {
+		return fmt.Errorf("answer key: %v", err)
+	}
+	q.AnswerKey = &models.AnswerKey{Answer: answer[0]}
+	return nil
+}
+
+func (singleChoiceGrader) Grade(q *models.Question, answer models.SubmittedAnswer) (bool, error) {
+	choice, err := selectChoices(q.Options, []string{answer.Text})
+	if err != nil {
+		return false, err
+	}
+	return choice[0] == q.AnswerKey.Answer, nil
+}
+
+// multipleChoiceGrader проверяет вопросы с несколькими правильными вариантами.
+// Ответ верен, только если выбраны все правильные варианты и ни одного лишнего.
+type multipleChoiceGrader struct{}
+
+func (multipleChoiceGrader) Validate(q *models.Question) error {
+	options, err := normalizeOptions(q.Options)
+	if err != nil {
+		return err
+	}
+	q.Options = options
+
+	if len(q.AnswerKey.Answers) == 0 {
+		return errors.New("answer key must contain at least one correct option")
+	}
+	answers, err := selectChoices(options, q.AnswerKey.Answers)
+	if err != nil {
+		return fmt.Errorf("answer key: %v", err)
+	}
+	q.AnswerKey = &models.AnswerKey{Answers: answers}
+	return nil
+}
+
+func (multipleChoiceGrader) Grade(q *models.Question, answer models.SubmittedAnswer) (bool, error) {
+	if len(answer.Choices) == 0 {
+		return false, errors.New("at least one option must be selected")
+	}
+	choices, err := selectChoices(q.Options, answer.Choices)
+	if err != nil {
+		return false, err
+	}
+	if len(choices) != len(q.AnswerKey.Answers) {
+		return false, nil
+	}
+
+	correct := make(map[string]bool, len(q.AnswerKey.Answers))
+	for _, a := range q.AnswerKey.Answers {
+		correct[a] = true
+	}
+	for _, c := range choices {
+		if !correct[c] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// orderingGrader проверяет вопросы на упорядочивание. Варианты хранятся в порядке показа,
+// ключ - в правильном порядке.
+type orderingGrader struct{}
+
+func (orderingGrader) Validate(q *models.Question) error {
+	options, err := normalizeOptions(q.Options)
+	if err != nil {
+		return err
+	}
+	q.Options = options
+
+	order, err := arrangement(options, q.AnswerKey.Order)
+	if err != nil {
+		return fmt.Errorf("answer key: %v", err)
+	}
+	q.AnswerKey = &models.AnswerKey{Order: order}
+	return nil
+}
+
+func (orderingGrader) Grade(q *models.Question, answer models.SubmittedAnswer) (bool, error) {
+	order, err := arrangement(q.Options, answer.Choices)
+	if err != nil {
+		return false, err
+	}
+	for i := range order {
+		if order[i] != q.AnswerKey.Order[i] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// arrangement проверяет, что порядок содержит каждый вариант ровно один раз
+func arrangement(options, order []string) ([]string, error) {
+	if len(order) != len(options) {
+		return nil, fmt.Errorf("all %d options must be arranged", len(options))
+	}
+	return selectChoices(options, order)
+}
@@ -0,0 +1,129 @@
+// Package grading проверяет ответы на вопросы разных типов. Для каждого типа есть Grader,
+// который проверяет ключ ответа при редактировании вопроса и оценивает ответ пользователя.
+package grading
+
+import (
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"strings"
+	"unicode/utf8"
+)
+
+// Ограничения вариантов ответа
+const (
+	minOptions      = 2
+	maxOptions      = 10
+	maxOptionLength = 500
+)
+
+// Grader проверяет ответы на вопросы одного типа
+type Grader interface {
+	// Validate проверяет и нормализует варианты и ключ ответа вопроса
+	Validate(q *models.Question) error
+	// Grade оценивает ответ пользователя. Ошибка означает, что ответ не подходит
+	// к вопросу по форме, например выбран несуществующий вариант.
+	Grade(q *models.Question, answer models.SubmittedAnswer) (bool, error)
+}
+
+var graders = map[string]Grader{
+	models.QuestionTypeSingleChoice:   singleChoiceGrader{},
+	models.QuestionTypeMultipleChoice: multipleChoiceGrader{},
+	models.QuestionTypeOrdering:       orderingGrader{},
+	models.QuestionTypeNumeric:        numericGrader{},
+	models.QuestionTypeFreeText:       freeTextGrader{},
+	models.QuestionTypeCode:           codeGrader{},
+}
+
+// For возвращает проверяющего для типа вопроса
+func For(questionType string) (Grader, error) {
+	g, ok := graders[questionType]
+	if !ok {
+		return nil, fmt.Errorf("unknown question type %q", questionType)
+	}
+	return g, nil
+}
+
+// Validate проверяет ключ ответа вопроса проверяющим его типа
+func Validate(q *models.Question) error {
+	g, err := For(q.Type)
+	if err != nil {
+		return err
+	}
+	if q.AnswerKey == nil {
+		return errors.New("answer key is required")
+	}
+	if q.Type != models.QuestionTypeCode && (q.Language != nil || q.StarterCode != nil) {
+		return errors.New("language and starterCode are only allowed for code questions")
+	}
+	return g.Validate(q)
+}
+
+// Grade оценивает ответ пользователя проверяющим типа вопроса
+func Grade(q *models.Question, answer models.SubmittedAnswer) (bool, error) {
+	g, err := For(q.Type)
+	if err != nil {
+		return false, err
+	}
+	if q.AnswerKey == nil {
+		return false, errors.New("question has no answer key")
+	}
+	return g.Grade(q, answer)
+}
+
+// normalizeOptions убирает пробелы по краям вариантов и проверяет их количество и уникальность
+func normalizeOptions(options []string) ([]string, error) {
+	if len(options) < minOptions || len(options) > maxOptions {
+		return nil, fmt.Errorf("question must have between %d and %d options", minOptions, maxOptions)
+	}
+
+	result := make([]string, 0, len(options))
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return nil, errors.New("options must not be empty")
+		}
+		if utf8.RuneCountInString(option) > maxOptionLength {
+			return nil, fmt.Errorf("option must be at most %d characters", maxOptionLength)
+		}
+		if seen[option] {
+			return nil, fmt.Errorf("duplicate option %q", option)
+		}
+		seen[option] = true
+		result = append(result, option)
+	}
+	return result, nil
+}
+
+// noOptions проверяет, что у вопроса без выбора не заданы варианты
+func noOptions(q *models.Question) error {
+	if len(q.Options) > 0 {
+		return fmt.Errorf("%s question must not have options", q.Type)
+	}
+	return nil
+}
+
+// selectChoices проверяет, что каждый выбранный вариант есть среди вариантов вопроса
+// и выбран один раз, и возвращает выбранные варианты без пробелов по краям
+func selectChoices(options, choices []string) ([]string, error) {
+	known := make(map[string]bool, len(options))
+	for _, option := range options {
+		known[option] = true
+	}
+
+	result := make([]string, 0, len(choices))
+	seen := make(map[string]bool, len(choices))
+	for _, choice := range choices {
+		choice = strings.TrimSpace(choice)
+		if !known[choice] {
+			return nil, fmt.Errorf("%q is not one of the options", choice)
+		}
+		if seen[choice] {
+			return nil, fmt.Errorf("%q is selected more than once", choice)
+		}
+		seen[choice] = true
+		result = append(result, choice)
+	}
+	return result, nil
+}
@@ -0,0 +1,35 @@
+package grading
+
+import (
+	"errors"
+	"it_rabotyagi/internal/business/models"
+	"math"
+)
+
+// numericEpsilon поглощает ошибку округления при сравнении с допустимым отклонением
+const numericEpsilon = 1e-9
+
+// numericGrader проверяет вопросы с числовым ответом. Ответ верен, если отличается
+// от правильного не больше чем на допустимое отклонение.
+type numericGrader struct{}
+
+func (numericGrader) Validate(q *models.Question) error {
+	if err := noOptions(q); err != nil {
+		return err
+	}
+	if q.AnswerKey.Value == nil || math.IsNaN(*q.AnswerKey.Value) || math.IsInf(*q.AnswerKey.Value, 0) {
+		return errors.New("answer key must contain a finite value")
+	}
+	if q.AnswerKey.Tolerance < 0 || math.IsInf(q.AnswerKey.Tolerance, 0) {
+		return errors.New("tolerance must be a non-negative number")
+	}
+	q.AnswerKey = &models.AnswerKey{Value: q.AnswerKey.Value, Tolerance: q.AnswerKey.Tolerance}
+	return nil
+}
+
+func (numericGrader) Grade(q *models.Question, answer models.SubmittedAnswer) (bool, error) {
+	if answer.Value == nil || math.IsNaN(*answer.Value) || math.IsInf(*answer.Value, 0) {
+		return false, errors.New("numeric answer is required")
+	}
+	return math.Abs(*answer.Value-*q.AnswerKey.Value) <= q.AnswerKey.Tolerance+numericEpsilon, nil
+}
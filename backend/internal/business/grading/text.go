@@ -0,0 +1,145 @@
+package grading
+
+import (
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Ограничения ключей текстовых ответов
+const (
+	maxPatterns          = 20
+	maxPatternLength     = 500
+	maxSolutions         = 10
+	maxSolutionLength    = 10000
+	maxLanguageLength    = 50
+	maxStarterCodeLength = 10000
+)
+
+// freeTextGrader проверяет ответы текстом. Ответ верен, если целиком соответствует
+// хотя бы одному из регулярных выражений ключа. Выражения RE2 выполняются за линейное
+// время, поэтому авторские шаблоны не могут подвесить проверку.
+type freeTextGrader struct{}
+
+func (freeTextGrader) Validate(q *models.Question) error {
+	if err := noOptions(q); err != nil {
+		return err
+	}
+
+	key := q.AnswerKey
+	if len(key.Patterns) == 0 || len(key.Patterns) > maxPatterns {
+		return fmt.Errorf("answer key must contain between 1 and %d patterns", maxPatterns)
+	}
+	patterns := make([]string, 0, len(key.Patterns))
+	for _, pattern := range key.Patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" || utf8.RuneCountInString(pattern) > maxPatternLength {
+			return fmt.Errorf("pattern must be between 1 and %d characters", maxPatternLength)
+		}
+		if _, err := compilePattern(pattern, key.CaseSensitive); err != nil {
+			return fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	q.AnswerKey = &models.AnswerKey{Patterns: patterns, CaseSensitive: key.CaseSensitive}
+	return nil
+}
+
+func (freeTextGrader) Grade(q *models.Question, answer models.SubmittedAnswer) (bool, error) {
+	text := strings.TrimSpace(answer.Text)
+	if text == "" {
+		return false, errors.New("answer is required")
+	}
+
+	// Пробелы внутри ответа не должны влиять на проверку
+	text = strings.Join(strings.Fields(text), " ")
+	for _, pattern := range q.AnswerKey.Patterns {
+		re, err := compilePattern(pattern, q.AnswerKey.CaseSensitive)
+		if err != nil {
+			return false, err
+		}
+		if re.MatchString(text) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// compilePattern компилирует шаблон так, чтобы он совпадал только со всем ответом целиком
+func compilePattern(pattern string, caseSensitive bool) (*regexp.Regexp, error) {
+	flags := ""
+	if !caseSensitive {
+		flags = "(?i)"
+	}
+	return regexp.Compile(flags + `^(?:` + pattern + `)$`)
+}
+
+// codeGrader проверяет ответы кодом сравнением с эталонными решениями. Код не выполняется:
+// сравнение не учитывает пробелы в конце строк, пустые строки и отступ всего фрагмента.
+type codeGrader struct{}
+
+func (codeGrader) Validate(q *models.Question) error {
+	if err := noOptions(q); err != nil {
+		return err
+	}
+	if q.Language != nil {
+		language := strings.ToLower(strings.TrimSpace(*q.Language))
+		if language == "" || utf8.RuneCountInString(language) > maxLanguageLength {
+			return fmt.Errorf("language must be between 1 and %d characters", maxLanguageLength)
+		}
+		q.Language = &language
+	}
+	if q.StarterCode != nil && utf8.RuneCountInString(*q.StarterCode) > maxStarterCodeLength {
+		return fmt.Errorf("starterCode must be at most %d characters", maxStarterCodeLength)
+	}
+
+	key := q.AnswerKey
+	if len(key.Solutions) == 0 || len(key.Solutions) > maxSolutions {
+		return fmt.Errorf("answer key must contain between 1 and %d solutions", maxSolutions)
+	}
+	solutions := make([]string, 0, len(key.Solutions))
+	for _, solution := range key.Solutions {
+		if normalizeCode(solution) == "" || utf8.RuneCountInString(solution) > maxSolutionLength {
+			return fmt.Errorf("solution must be between 1 and %d characters", maxSolutionLength)
+		}
+		solutions = append(solutions, solution)
+	}
+	q.AnswerKey = &models.AnswerKey{Solutions: solutions}
+	return nil
+}
+
+func (codeGrader) Grade(q *models.Question, answer models.SubmittedAnswer) (bool, error) {
+	code := normalizeCode(answer.Text)
+	if code == "" {
+		return false, errors.New("answer is required")
+	}
+	for _, solution := range q.AnswerKey.Solutions {
+		if normalizeCode(solution) == code {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// normalizeCode убирает пустые строки, пробелы в конце строк и общий отступ фрагмента
+func normalizeCode(code string) string {
+	var lines []string
+	indent := -1
+	for _, line := range strings.Split(strings.ReplaceAll(code, "\r\n", "\n"), "\n") {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			continue
+		}
+		if n := len(line) - len(strings.TrimLeft(line, " \t")); indent < 0 || n < indent {
+			indent = n
+		}
+		lines = append(lines, line)
+	}
+	for i := range lines {
+		lines[i] = lines[i][indent:]
+	}
+	return strings.Join(lines, "\n")
+}
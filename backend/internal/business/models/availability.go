@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// AvailabilityRule описывает еженедельное окно, в которое ментор готов проводить занятия.
+// Время окна задается в часовом поясе ментора.
+type AvailabilityRule struct {
+	ID        int
+	MentorID  int
+	Weekday   time.Weekday
+	StartTime string // ЧЧ:ММ
+	EndTime   string // ЧЧ:ММ
+}
+
+// AvailabilityException описывает разовое исключение из расписания: дополнительное окно
+// (IsAvailable = true) или время, когда ментор недоступен (IsAvailable = false)
+type AvailabilityException struct {
+	ID          int
+	MentorID    int
+	StartsAt    time.Time
+	EndsAt      time.Time
+	IsAvailable bool
+	Reason      *string
+	CreatedAt   time.Time
+}
+
+// Availability представляет календарь доступности ментора
+type Availability struct {
+	MentorID   int
+	Timezone   string
+	Rules      []AvailabilityRule
+	Exceptions []AvailabilityException
+}
+
+// Slot представляет свободный интервал для бронирования
+type Slot struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+}
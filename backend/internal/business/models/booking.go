@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// Статусы бронирования
+const (
+	BookingRequested = "requested"
+	BookingConfirmed = "confirmed"
+	BookingCancelled = "cancelled"
+	BookingCompleted = "completed"
+	BookingNoShow    = "no_show"
+)
+
+// bookingTransitions перечисляет допустимые переходы между статусами бронирования
+var bookingTransitions = map[string][]string{
+	BookingRequested: {BookingConfirmed, BookingCancelled},
+	BookingConfirmed: {BookingCancelled, BookingCompleted, BookingNoShow},
+}
+
+// CanTransitionBooking проверяет, можно ли перевести бронирование из статуса from в статус to
+func CanTransitionBooking(from, to string) bool {
+	for _, allowed := range bookingTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Booking представляет бронирование занятия с ментором
+type Booking struct {
+	ID           int
+	MentorID     int
+	MentorName   string
+	MentorUserID int
+	MenteeID     int
+	MenteeName   string
+	StartsAt     time.Time
+	EndsAt       time.Time
+	Status       string
+	Comment      *string
+	CancelReason *string
+	CancelledBy  *int
+	Sequence     int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// Conversation представляет диалог ментора и ученика
+type Conversation struct {
+	ID               int
+	MentorID         int
+	MentorUserID     int
+	MentorName       string
+	MenteeID         int
+	MenteeName       string
+	MentorLastReadID int
+	MenteeLastReadID int
+	LastMessage      *Message
+	// UnreadCount - количество непрочитанных сообщений для пользователя, запросившего диалог
+	UnreadCount   int
+	LastMessageAt *time.Time
+	CreatedAt     time.Time
+}
+
+// IsParticipant проверяет, что пользователь участвует в диалоге
+func (c *Conversation) IsParticipant(userID int) bool {
+	return c.MenteeID == userID || c.MentorUserID == userID
+}
+
+// CounterpartID возвращает ID пользователя второго участника диалога
+func (c *Conversation) CounterpartID(userID int) int {
+	if c.MenteeID == userID {
+		return c.MentorUserID
+	}
+	return c.MenteeID
+}
+
+// CounterpartLastReadID возвращает ID последнего сообщения, прочитанного вторым участником
+func (c *Conversation) CounterpartLastReadID(userID int) int {
+	if c.MenteeID == userID {
+		return c.MentorLastReadID
+	}
+	return c.MenteeLastReadID
+}
+
+// Message представляет сообщение в диалоге
+type Message struct {
+	ID             int
+	ConversationID int
+	SenderID       int
+	Body           string
+	CreatedAt      time.Time
+}
+
+// ReadReceipt сообщает, до какого сообщения участник прочитал диалог
+type ReadReceipt struct {
+	ConversationID    int
+	UserID            int
+	LastReadMessageID int
+}
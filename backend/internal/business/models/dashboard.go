@@ -0,0 +1,78 @@
+package models
+
+import "time"
+
+// Периоды агрегации дашборда ментора
+const (
+	DashboardPeriodWeek  = "week"
+	DashboardPeriodMonth = "month"
+)
+
+// SessionStats - статистика занятий ментора за все время
+type SessionStats struct {
+	Total     int
+	Upcoming  int
+	Completed int
+	NoShow    int
+	Cancelled int
+	// CompletionRate и NoShowRate считаются от прошедших занятий (completed + no_show)
+	CompletionRate *float64
+	NoShowRate     *float64
+}
+
+// RevenuePoint - проведенные занятия и списанная в пользу ментора выручка за период
+type RevenuePoint struct {
+	PeriodStart time.Time
+	Sessions    int
+	Revenue     int
+}
+
+// RatingPoint - средняя оценка опубликованных отзывов за период
+type RatingPoint struct {
+	PeriodStart  time.Time
+	Average      *float64
+	ReviewsCount int
+}
+
+// CourseProgress - прогресс пользователя по курсу
+type CourseProgress struct {
+	CourseID         int
+	Title            string
+	CompletedModules int
+	TotalModules     int
+	ProgressPct      int
+}
+
+// AnswerStats - количество отвеченных пользователем вопросов и верных ответов
+type AnswerStats struct {
+	Answered int
+	Correct  int
+}
+
+// MenteeSummary - ученик ментора. Courses и Answers заполняются,
+// только если ученик разрешил ментору видеть свой прогресс.
+type MenteeSummary struct {
+	UserID            int
+	Name              string
+	AvatarURL         *string
+	CompletedSessions int
+	LastSessionAt     *time.Time
+	NextSessionAt     *time.Time
+	ProgressShared    bool
+	Courses           []CourseProgress
+	Answers           *AnswerStats
+}
+
+// MentorDashboard - сводка активности ментора
+type MentorDashboard struct {
+	Period       string
+	Stats        SessionStats
+	Upcoming     []*Booking
+	Recent       []*Booking
+	Currency     *string
+	Revenue      []RevenuePoint
+	Rating       *float64
+	ReviewsCount int
+	RatingTrend  []RatingPoint
+	Mentees      []*MenteeSummary
+}
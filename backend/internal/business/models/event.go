@@ -0,0 +1,78 @@
+package models
+
+import "time"
+
+// Типы событий
+const (
+	EventTypeGroupSession  = "group_session"
+	EventTypeMockInterview = "mock_interview"
+)
+
+// IsKnownEventType проверяет, что тип события поддерживается
+func IsKnownEventType(t string) bool {
+	return t == EventTypeGroupSession || t == EventTypeMockInterview
+}
+
+// Статусы события
+const (
+	EventScheduled = "scheduled"
+	EventCancelled = "cancelled"
+)
+
+// Статусы регистрации на событие
+const (
+	EventRegistrationRegistered = "registered"
+	EventRegistrationWaitlisted = "waitlisted"
+	EventRegistrationCancelled  = "cancelled"
+)
+
+// Event представляет групповое занятие или mock-собеседование ментора
+type Event struct {
+	ID              int
+	MentorID        int
+	HostUserID      int
+	HostName        string
+	Type            string
+	Title           string
+	Description     *string
+	StartsAt        time.Time
+	EndsAt          time.Time
+	Capacity        int
+	Status          string
+	RegisteredCount int
+	WaitlistCount   int
+	// ViewerStatus - статус регистрации текущего пользователя, nil если он не зарегистрирован
+	ViewerStatus *string
+	// WaitlistPosition - место текущего пользователя в листе ожидания, начиная с 1
+	WaitlistPosition *int
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// SpotsLeft возвращает количество свободных мест
+func (e *Event) SpotsLeft() int {
+	if left := e.Capacity - e.RegisteredCount; left > 0 {
+		return left
+	}
+	return 0
+}
+
+// EventRegistration представляет регистрацию пользователя на событие
+type EventRegistration struct {
+	ID        int
+	EventID   int
+	UserID    int
+	UserName  string
+	AvatarURL *string
+	Status    string
+	Attended  *bool
+	QueuedAt  time.Time
+	CreatedAt time.Time
+}
+
+// EventFilter задает условия поиска предстоящих событий
+type EventFilter struct {
+	MentorID *int
+	Type     *string
+	From     time.Time
+}
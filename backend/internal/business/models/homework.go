@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+// Видимость заметок о занятии
+const (
+	// NoteVisibilityPrivate - заметка видна только автору
+	NoteVisibilityPrivate = "private"
+	// NoteVisibilityShared - заметка видна обоим участникам занятия
+	NoteVisibilityShared = "shared"
+)
+
+// Типы пунктов домашнего задания
+const (
+	HomeworkItemQuestion = "question"
+	HomeworkItemModule   = "module"
+)
+
+// SessionNote представляет заметку ментора о занятии
+type SessionNote struct {
+	ID           int
+	BookingID    int
+	AuthorID     int
+	Visibility   string
+	Summary      string
+	Strengths    *string
+	Improvements *string
+	NextSteps    *string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// HomeworkItem - пункт домашнего задания: вопрос или модуль курса.
+// Выполненными считаются вопросы, на которые ученик ответил верно.
+type HomeworkItem struct {
+	ID                 int
+	QuestionID         *int
+	ModuleID           *int
+	Title              string
+	TotalQuestions     int
+	CompletedQuestions int
+}
+
+// Type возвращает тип пункта
+func (i *HomeworkItem) Type() string {
+	if i.ModuleID != nil {
+		return HomeworkItemModule
+	}
+	return HomeworkItemQuestion
+}
+
+// Completed проверяет, что ученик верно ответил на все вопросы пункта
+func (i *HomeworkItem) Completed() bool {
+	return i.TotalQuestions > 0 && i.CompletedQuestions >= i.TotalQuestions
+}
+
+// HomeworkAssignment представляет домашнее задание, выданное ментором по занятию
+type HomeworkAssignment struct {
+	ID           int
+	BookingID    int
+	MentorID     int
+	MentorName   string
+	MentorUserID int
+	MenteeID     int
+	MenteeName   string
+	Title        string
+	Comment      *string
+	DueAt        *time.Time
+	CreatedAt    time.Time
+	Items        []HomeworkItem
+}
+
+// CompletedItems возвращает количество выполненных пунктов задания
+func (a *HomeworkAssignment) CompletedItems() int {
+	completed := 0
+	for i := range a.Items {
+		if a.Items[i].Completed() {
+			completed++
+		}
+	}
+	return completed
+}
+
+// IsParticipant проверяет, что пользователь - ментор или ученик задания
+func (a *HomeworkAssignment) IsParticipant(userID int) bool {
+	return a.MentorUserID == userID || a.MenteeID == userID
+}
@@ -0,0 +1,187 @@
+package models
+
+import "time"
+
+// Mentor представляет профиль ментора вместе с данными пользователя
+type Mentor struct {
+	ID              int
+	UserID          int
+	FullName        string
+	AvatarURL       *string
+	Specialization  string
+	Grade           *string
+	ExperienceYears *int
+	Description     *string
+	Tags            []string
+	Contacts        []MentorContact
+	Pricelist       []MentorPriceItem
+	Languages       []string
+	IsAvailable     bool
+	Rating          *float64
+	ReviewsCount    int
+	Badges          []MentorBadge
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Каналы связи с ментором
+const (
+	ContactTelegram = "telegram"
+	ContactEmail    = "email"
+	ContactPhone    = "phone"
+	ContactWebsite  = "website"
+	ContactLinkedIn = "linkedin"
+	ContactGitHub   = "github"
+)
+
+// ContactTypes - все известные каналы связи
+var ContactTypes = []string{
+	ContactTelegram,
+	ContactEmail,
+	ContactPhone,
+	ContactWebsite,
+	ContactLinkedIn,
+	ContactGitHub,
+}
+
+// IsKnownContactType проверяет, что канал связи поддерживается
+func IsKnownContactType(t string) bool {
+	for _, known := range ContactTypes {
+		if known == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Валюты прайс-листа
+const (
+	CurrencyRUB = "RUB"
+	CurrencyUSD = "USD"
+	CurrencyEUR = "EUR"
+)
+
+// Currencies - все поддерживаемые валюты
+var Currencies = []string{CurrencyRUB, CurrencyUSD, CurrencyEUR}
+
+// IsKnownCurrency проверяет, что валюта поддерживается
+func IsKnownCurrency(c string) bool {
+	for _, known := range Currencies {
+		if known == c {
+			return true
+		}
+	}
+	return false
+}
+
+// MentorContact представляет один канал связи с ментором
+type MentorContact struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// MentorPriceItem представляет услугу из прайс-листа ментора.
+// Цена указывается в целых единицах валюты.
+type MentorPriceItem struct {
+	Title           string  `json:"title"`
+	Description     *string `json:"description,omitempty"`
+	Price           int     `json:"price"`
+	Currency        string  `json:"currency"`
+	DurationMinutes *int    `json:"durationMinutes,omitempty"`
+}
+
+// PriceRange представляет диапазон цен на услуги ментора
+type PriceRange struct {
+	Min      int
+	Max      int
+	Currency string
+}
+
+// PriceRange возвращает диапазон цен прайс-листа или nil, если прайс-лист пуст.
+// Все услуги ментора указываются в одной валюте.
+func (m *Mentor) PriceRange() *PriceRange {
+	if len(m.Pricelist) == 0 {
+		return nil
+	}
+
+	r := &PriceRange{
+		Min:      m.Pricelist[0].Price,
+		Max:      m.Pricelist[0].Price,
+		Currency: m.Pricelist[0].Currency,
+	}
+	for _, item := range m.Pricelist[1:] {
+		if item.Price < r.Min {
+			r.Min = item.Price
+		}
+		if item.Price > r.Max {
+			r.Max = item.Price
+		}
+	}
+	return r
+}
+
+// EstimateSessionPrice оценивает стоимость занятия длительностью minutes по прайс-листу.
+// Приоритет: услуга ровно такой длительности, затем ближайшая по длительности услуга
+// с пересчетом пропорционально времени, затем самая дешевая услуга без длительности.
+// Возвращает false, если прайс-лист пуст.
+func (m *Mentor) EstimateSessionPrice(minutes int) (int, bool) {
+	var exact, closest, flat *MentorPriceItem
+	for i := range m.Pricelist {
+		item := &m.Pricelist[i]
+		switch {
+		case item.DurationMinutes == nil || *item.DurationMinutes <= 0:
+			if flat == nil || item.Price < flat.Price {
+				flat = item
+			}
+		case *item.DurationMinutes == minutes:
+			if exact == nil || item.Price < exact.Price {
+				exact = item
+			}
+		case closest == nil || absInt(*item.DurationMinutes-minutes) < absInt(*closest.DurationMinutes-minutes):
+			closest = item
+		}
+	}
+
+	switch {
+	case exact != nil:
+		return exact.Price, true
+	case closest != nil:
+		return closest.Price * minutes / *closest.DurationMinutes, true
+	case flat != nil:
+		return flat.Price, true
+	}
+	return 0, false
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Поля сортировки каталога менторов
+const (
+	MentorSortRating     = "rating"
+	MentorSortPrice      = "price"
+	MentorSortExperience = "experience"
+)
+
+// MentorFilter задает параметры фильтрации и сортировки каталога менторов
+type MentorFilter struct {
+	Specialization *string
+	// Tags - технологии ментора; при TagsMatchAll нужны все, иначе хотя бы одна
+	Tags          []string
+	TagsMatchAll  bool
+	Grade         *string
+	MinExperience *int
+	// PriceMin и PriceMax задают диапазон цен, с которым должен пересекаться прайс-лист
+	PriceMin  *int
+	PriceMax  *int
+	Currency  *string
+	Language  *string
+	Available *bool
+	// Sort - одно из MentorSort*; пустое значение сохраняет порядок по ID
+	Sort     string
+	SortDesc bool
+}
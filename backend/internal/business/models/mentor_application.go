@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// Статусы заявки на менторство
+const (
+	MentorApplicationPending  = "pending"
+	MentorApplicationApproved = "approved"
+	MentorApplicationRejected = "rejected"
+)
+
+// MentorApplication представляет заявку пользователя на получение статуса ментора
+type MentorApplication struct {
+	ID              int
+	UserID          int
+	ApplicantName   string
+	Specialization  string
+	Grade           *string
+	ExperienceYears *int
+	Description     *string
+	Tags            []string
+	Contacts        []MentorContact
+	Pricelist       []MentorPriceItem
+	Status          string
+	ReviewerID      *int
+	ReviewComment   *string
+	ReviewedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
@@ -0,0 +1,71 @@
+package models
+
+import "time"
+
+// Типы подтверждаемых утверждений ментора
+const (
+	VerificationTypeEmployment    = "employment"
+	VerificationTypeCertification = "certification"
+	VerificationTypeGitHub        = "github"
+)
+
+// VerificationTypes - все типы подтверждений
+var VerificationTypes = []string{
+	VerificationTypeEmployment,
+	VerificationTypeCertification,
+	VerificationTypeGitHub,
+}
+
+// IsKnownVerificationType проверяет, что тип подтверждения поддерживается
+func IsKnownVerificationType(t string) bool {
+	for _, known := range VerificationTypes {
+		if known == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Статусы подтверждения. Статус expired не хранится в базе:
+// подтверждение считается истекшим, когда наступает его expires_at.
+const (
+	VerificationPending  = "pending"
+	VerificationVerified = "verified"
+	VerificationRejected = "rejected"
+	VerificationRevoked  = "revoked"
+	VerificationExpired  = "expired"
+)
+
+// MentorVerification представляет утверждение ментора о своем опыте вместе с доказательством
+type MentorVerification struct {
+	ID            int
+	MentorID      int
+	MentorName    string
+	Type          string
+	Title         string
+	EvidenceURL   string
+	Details       *string
+	Status        string
+	ReviewerID    *int
+	ReviewComment *string
+	ReviewedAt    *time.Time
+	ExpiresAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// EffectiveStatus возвращает статус с учетом истечения срока подтверждения на момент now
+func (v *MentorVerification) EffectiveStatus(now time.Time) string {
+	if v.Status == VerificationVerified && v.ExpiresAt != nil && !v.ExpiresAt.After(now) {
+		return VerificationExpired
+	}
+	return v.Status
+}
+
+// MentorBadge представляет действующее подтверждение, которое показывается в карточке ментора
+type MentorBadge struct {
+	Type       string    `json:"type"`
+	Title      string    `json:"title"`
+	VerifiedAt time.Time `json:"verifiedAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
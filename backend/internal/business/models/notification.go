@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+// Типы уведомлений
+const (
+	NotificationTypeBookingAccepted = "booking_accepted"
+	NotificationTypeNewQuestions    = "new_questions"
+
+	NotificationTypeMentorApplicationApproved = "mentor_application_approved"
+	NotificationTypeMentorApplicationRejected = "mentor_application_rejected"
+
+	NotificationTypeBookingRequested   = "booking_requested"
+	NotificationTypeBookingCancelled   = "booking_cancelled"
+	NotificationTypeBookingRescheduled = "booking_rescheduled"
+
+	NotificationTypeReviewReceived = "review_received"
+
+	NotificationTypeSessionNoteShared = "session_note_shared"
+	NotificationTypeHomeworkAssigned  = "homework_assigned"
+
+	NotificationTypeVerificationApproved = "verification_approved"
+	NotificationTypeVerificationRejected = "verification_rejected"
+	NotificationTypeVerificationRevoked  = "verification_revoked"
+
+	NotificationTypeEventPromoted    = "event_promoted"
+	NotificationTypeEventReminder    = "event_reminder"
+	NotificationTypeEventCancelled   = "event_cancelled"
+	NotificationTypeEventRescheduled = "event_rescheduled"
+
+	NotificationTypeQuestionSubmissionApproved = "question_submission_approved"
+	NotificationTypeQuestionSubmissionRejected = "question_submission_rejected"
+	NotificationTypeQuestionSubmissionMerged   = "question_submission_merged"
+)
+
+// NotificationTypes перечисляет все типы уведомлений, которые можно настраивать
+var NotificationTypes = []string{
+	NotificationTypeBookingAccepted,
+	NotificationTypeNewQuestions,
+	NotificationTypeMentorApplicationApproved,
+	NotificationTypeMentorApplicationRejected,
+	NotificationTypeBookingRequested,
+	NotificationTypeBookingCancelled,
+	NotificationTypeBookingRescheduled,
+	NotificationTypeReviewReceived,
+	NotificationTypeSessionNoteShared,
+	NotificationTypeHomeworkAssigned,
+	NotificationTypeVerificationApproved,
+	NotificationTypeVerificationRejected,
+	NotificationTypeVerificationRevoked,
+	NotificationTypeEventPromoted,
+	NotificationTypeEventReminder,
+	NotificationTypeEventCancelled,
+	NotificationTypeEventRescheduled,
+	NotificationTypeQuestionSubmissionApproved,
+	NotificationTypeQuestionSubmissionRejected,
+	NotificationTypeQuestionSubmissionMerged,
+}
+
+// IsKnownNotificationType проверяет, что тип уведомления существует
+func IsKnownNotificationType(t string) bool {
+	for _, known := range NotificationTypes {
+		if known == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Notification представляет уведомление пользователя
+type Notification struct {
+	ID        int
+	UserID    int
+	Type      string
+	Title     string
+	Body      string
+	Payload   map[string]interface{}
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+// NotificationPreference представляет настройки доставки уведомлений одного типа
+type NotificationPreference struct {
+	Type            string
+	EmailEnabled    bool
+	TelegramEnabled bool
+}
@@ -0,0 +1,93 @@
+package models
+
+import "time"
+
+// Статусы оплаты
+const (
+	// PaymentPending - платеж создан и ждет оплаты учеником
+	PaymentPending = "pending"
+	// PaymentHeld - деньги удерживаются до завершения занятия
+	PaymentHeld = "held"
+	// PaymentCaptured - вся сумма списана в пользу ментора
+	PaymentCaptured = "captured"
+	// PaymentPartiallyRefunded - часть суммы списана, остаток возвращен ученику
+	PaymentPartiallyRefunded = "partially_refunded"
+	// PaymentRefunded - вся удержанная сумма возвращена ученику
+	PaymentRefunded = "refunded"
+	// PaymentCancelled - платеж отменен до оплаты
+	PaymentCancelled = "cancelled"
+	// PaymentFailed - оплата не прошла
+	PaymentFailed = "failed"
+)
+
+// Типы записей журнала движения денег
+const (
+	LedgerHold    = "hold"
+	LedgerCapture = "capture"
+	LedgerRefund  = "refund"
+)
+
+// Payment представляет оплату занятия. Суммы оплаты и журнала - в целых единицах валюты,
+// как в прайс-листе ментора; провайдеру они передаются в минимальных единицах.
+type Payment struct {
+	ID               int
+	BookingID        int
+	PayerID          int
+	MentorID         int
+	Amount           int
+	Currency         string
+	Provider         string
+	ProviderIntentID string
+	ClientSecret     *string
+	Status           string
+	CapturedAmount   int
+	RefundedAmount   int
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// PaymentEvent - событие платежного провайдера, полученное через webhook
+type PaymentEvent struct {
+	Provider         string
+	EventID          string
+	Type             string
+	ProviderIntentID string
+	Payload          []byte
+}
+
+// LedgerEntry - запись журнала движения денег по оплате
+type LedgerEntry struct {
+	ID        int
+	PaymentID int
+	BookingID int
+	EntryType string
+	Amount    int
+	Currency  string
+	CreatedAt time.Time
+}
+
+// CancellationPolicy определяет, какая часть оплаты возвращается ученику при отмене занятия
+type CancellationPolicy struct {
+	// FullRefundBefore - при отмене не позже чем за это время до начала возвращается вся сумма
+	FullRefundBefore time.Duration
+	// PartialRefundBefore - при отмене не позже чем за это время возвращается PartialRefundPercent
+	PartialRefundBefore  time.Duration
+	PartialRefundPercent int
+}
+
+// RefundPercent возвращает процент возврата при отмене занятия в момент cancelledAt.
+// Если занятие отменил ментор, ученик получает всю сумму.
+func (p CancellationPolicy) RefundPercent(startsAt, cancelledAt time.Time, byMentor bool) int {
+	if byMentor {
+		return 100
+	}
+	notice := startsAt.Sub(cancelledAt)
+	switch {
+	case notice >= p.FullRefundBefore:
+		return 100
+	case notice >= p.PartialRefundBefore:
+		return p.PartialRefundPercent
+	default:
+		return 0
+	}
+}
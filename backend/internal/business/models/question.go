@@ -0,0 +1,342 @@
+package models
+
+import "time"
+
+// Уровни сложности вопроса
+const (
+	DifficultyEasy   = "easy"
+	DifficultyMedium = "medium"
+	DifficultyHard   = "hard"
+)
+
+// IsKnownDifficulty проверяет, что уровень сложности поддерживается
+func IsKnownDifficulty(d string) bool {
+	return d == DifficultyEasy || d == DifficultyMedium || d == DifficultyHard
+}
+
+// Типы вопросов
+const (
+	QuestionTypeSingleChoice   = "single_choice"
+	QuestionTypeMultipleChoice = "multiple_choice"
+	QuestionTypeOrdering       = "ordering"
+	QuestionTypeNumeric        = "numeric"
+	QuestionTypeFreeText       = "free_text"
+	QuestionTypeCode           = "code"
+)
+
+// QuestionTypes - все поддерживаемые типы вопросов
+var QuestionTypes = []string{
+	QuestionTypeSingleChoice,
+	QuestionTypeMultipleChoice,
+	QuestionTypeOrdering,
+	QuestionTypeNumeric,
+	QuestionTypeFreeText,
+	QuestionTypeCode,
+}
+
+// Статусы публикации вопроса
+const (
+	// QuestionDraft - вопрос, предложенный пользователем и ожидающий модерации
+	QuestionDraft     = "draft"
+	QuestionPublished = "published"
+	QuestionRejected  = "rejected"
+	// QuestionMerged - предложенный вопрос оказался дубликатом опубликованного
+	QuestionMerged = "merged"
+)
+
+// Question представляет вопрос банка вопросов вместе с ключом ответа
+type Question struct {
+	ID int
+	// AuthorID - автор вопроса, nil для вопросов из начального наполнения.
+	// У предложенных пользователями вопросов автор - тот, кто предложил.
+	AuthorID *int
+	// AuthorName - имя автора для указания авторства
+	AuthorName *string
+	// Status - одно из QuestionDraft, QuestionPublished, QuestionRejected, QuestionMerged;
+	// пустое значение при создании означает QuestionPublished
+	Status string
+	// ExternalKey - ключ вопроса в файлах импорта, по нему повторный импорт обновляет вопрос
+	ExternalKey *string
+	Title       string
+	Content     string
+	Difficulty  string
+	Type        string
+	// Options - варианты ответа для вопросов с выбором или элементы для упорядочивания
+	Options []string
+	// Language и StarterCode - язык и заготовка решения для вопросов с ответом кодом
+	Language    *string
+	StarterCode *string
+	// AnswerKey - данные для проверки ответа, nil если ключ скрыт от пользователя
+	AnswerKey    *AnswerKey
+	Explanation  *string
+	Technologies []string
+	CompanyTags  []string
+	// Revision - номер текущей ревизии, растет с каждой правкой
+	Revision  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AnswerKey - правильный ответ на вопрос. Заполняются только поля, относящиеся к типу вопроса.
+type AnswerKey struct {
+	// Answer - правильный вариант вопроса с одним ответом
+	Answer string `json:"answer,omitempty"`
+	// Answers - все правильные варианты вопроса с несколькими ответами
+	Answers []string `json:"answers,omitempty"`
+	// Order - элементы в правильном порядке
+	Order []string `json:"order,omitempty"`
+	// Value и Tolerance - правильное число и допустимое отклонение от него
+	Value     *float64 `json:"value,omitempty"`
+	Tolerance float64  `json:"tolerance,omitempty"`
+	// Patterns - регулярные выражения, которым должен целиком соответствовать текстовый ответ
+	Patterns      []string `json:"patterns,omitempty"`
+	CaseSensitive bool     `json:"caseSensitive,omitempty"`
+	// Solutions - эталонные решения для ответа кодом
+	Solutions []string `json:"solutions,omitempty"`
+}
+
+// SubmittedAnswer - ответ пользователя. Заполняется поле, относящееся к типу вопроса:
+// Text для одного варианта, текста и кода, Choices для нескольких вариантов и порядка,
+// Value для числа.
+type SubmittedAnswer struct {
+	Text    string
+	Choices []string
+	Value   *float64
+}
+
+// QuestionSummary - краткая информация о вопросе для списка
+type QuestionSummary struct {
+	ID           int
+	Title        string
+	Type         string
+	Difficulty   string
+	Technologies []string
+	CompanyTags  []string
+	CreatedAt    time.Time
+	// AnsweredCount - сколько пользователей отвечали на вопрос, AttemptsCount - сколько всего было попыток
+	AnsweredCount int
+	AttemptsCount int
+	// SuccessRate - доля пользователей, последний ответ которых верен; nil, если ответов еще не было
+	SuccessRate *float64
+}
+
+// Отборы вопросов по прогрессу пользователя
+const (
+	// QuestionStatusUnanswered - вопросы, на которые пользователь еще не отвечал
+	QuestionStatusUnanswered = "unanswered"
+	// QuestionStatusFailed - вопросы, последний ответ на которые неверен
+	QuestionStatusFailed = "failed"
+)
+
+// Порядок списка вопросов
+const (
+	QuestionSortNewest = "newest"
+	// QuestionSortHardest - по возрастанию доли верных ответов, вопросы без ответов в конце
+	QuestionSortHardest       = "hardest"
+	QuestionSortMostAttempted = "most_attempted"
+)
+
+// QuestionFilter задает условия выборки и порядок вопросов
+type QuestionFilter struct {
+	// Technologies - названия технологий в нижнем регистре; при TechnologiesMatchAll
+	// нужны все, иначе хотя бы одна
+	Technologies         []string
+	TechnologiesMatchAll bool
+	// Difficulties - допустимые уровни сложности
+	Difficulties []string
+	// CompanyTags - теги компаний в нижнем регистре, нужен хотя бы один
+	CompanyTags []string
+	// AuthorID - только вопросы этого автора, 0 - любого
+	AuthorID int
+	// UserID и Status - отбор по прогрессу пользователя, одно из QuestionStatus*
+	UserID int
+	Status string
+	// Sort - одно из QuestionSort*; пустое значение сохраняет порядок по ID
+	Sort string
+}
+
+// QuestionSearchHit - вопрос, найденный полнотекстовым поиском
+type QuestionSearchHit struct {
+	ID           int
+	Title        string
+	Type         string
+	Difficulty   string
+	Technologies []string
+	CompanyTags  []string
+	// Rank - релевантность вопроса запросу, больше - выше
+	Rank float64
+	// TitleHighlight и Snippet - HTML: заголовок и фрагменты текста, экранированные,
+	// с найденными словами в <mark>
+	TitleHighlight string
+	Snippet        string
+}
+
+// Technology - технология вместе с количеством вопросов по ней
+type Technology struct {
+	ID            int
+	Name          string
+	QuestionCount int
+}
+
+// QuestionProgress - результат последней попытки пользователя ответить на вопрос
+type QuestionProgress struct {
+	QuestionID int
+	IsCorrect  bool
+	Attempts   int
+	LastAnswer *string
+	// TimeSpentSeconds - суммарное время всех попыток, если клиент его передавал
+	TimeSpentSeconds *int
+	// Revision - ревизия вопроса, по которой проверена последняя попытка;
+	// nil для ответов, данных до появления ревизий
+	Revision   *int
+	AnsweredAt time.Time
+	// Schedule - когда и с каким интервалом вопрос нужно повторить
+	Schedule ReviewSchedule
+}
+
+// Оценки, которыми пользователь отмечает, насколько легко вспомнил ответ
+const (
+	RecallAgain = "again"
+	RecallHard  = "hard"
+	RecallGood  = "good"
+	RecallEasy  = "easy"
+)
+
+// ReviewSchedule - расписание интервального повторения вопроса по алгоритму SM-2
+type ReviewSchedule struct {
+	EaseFactor   float64
+	IntervalDays int
+	// Repetitions - число верных ответов подряд, Lapses - сколько раз ответ был забыт
+	Repetitions int
+	Lapses      int
+	DueAt       time.Time
+}
+
+// ReviewQueueItem - вопрос, который пора повторить
+type ReviewQueueItem struct {
+	Question  *QuestionSummary
+	IsCorrect bool
+	Attempts  int
+	Schedule  ReviewSchedule
+}
+
+// ReviewQueue - страница вопросов, которые пора повторить, по возрастанию срока
+type ReviewQueue struct {
+	Items []*ReviewQueueItem
+	// Total - сколько всего вопросов пора повторить
+	Total int
+	// NextDueAt - ближайший срок повторения среди еще не наступивших; nil, если таких нет
+	NextDueAt *time.Time
+}
+
+// AnswerResult - результат проверки ответа. Правильный ответ и объяснение
+// раскрываются только после попытки.
+type AnswerResult struct {
+	Progress     *QuestionProgress
+	QuestionType string
+	AnswerKey    *AnswerKey
+	Explanation  *string
+}
+
+// Форматы файлов импорта и экспорта вопросов
+const (
+	QuestionFormatJSON     = "json"
+	QuestionFormatYAML     = "yaml"
+	QuestionFormatCSV      = "csv"
+	QuestionFormatMarkdown = "markdown"
+)
+
+// QuestionImportRow - вопрос, прочитанный из файла импорта
+type QuestionImportRow struct {
+	// Row - порядковый номер вопроса в файле, начиная с 1
+	Row      int
+	Question *Question
+	// Err - ошибка разбора вопроса, такой вопрос не импортируется
+	Err error
+}
+
+// Результаты импорта вопроса
+const (
+	QuestionImportCreated = "created"
+	QuestionImportUpdated = "updated"
+	// QuestionImportUnchanged - вопрос с ключом уже есть в банке с тем же содержимым
+	QuestionImportUnchanged = "unchanged"
+	QuestionImportFailed    = "failed"
+)
+
+// QuestionImportRowResult - результат импорта одного вопроса из файла
+type QuestionImportRowResult struct {
+	Row         int
+	ExternalKey *string
+	// QuestionID - ID созданного или обновленного вопроса; при пробном импорте только для обновляемых
+	QuestionID *int
+	// Action - одно из QuestionImport*
+	Action string
+	Error  string
+}
+
+// QuestionImportReport - итог импорта. Вопросы сохраняются, только если ни в одном нет ошибок.
+type QuestionImportReport struct {
+	DryRun bool
+	// Applied - изменения сохранены в банке вопросов
+	Applied   bool
+	Created   int
+	Updated   int
+	Unchanged int
+	Failed    int
+	Rows      []QuestionImportRowResult
+}
+
+// QuestionRevision - неизменяемый снимок содержимого вопроса после создания, правки или отката
+type QuestionRevision struct {
+	QuestionID int
+	Revision   int
+	// EditorID - кто сохранил ревизию, nil для начального наполнения и импорта без автора
+	EditorID *int
+	// RolledBackFrom - номер ревизии, к которой откатили вопрос
+	RolledBackFrom *int
+	// Question - содержимое вопроса в этой ревизии
+	Question  *Question
+	CreatedAt time.Time
+}
+
+// QuestionSubmission - вопрос, предложенный пользователем, вместе с решением модератора
+type QuestionSubmission struct {
+	Question *Question
+	// ReviewerID, ReviewComment и ReviewedAt - кто, с каким комментарием и когда рассмотрел вопрос;
+	// пусты, пока вопрос в очереди
+	ReviewerID    *int
+	ReviewComment *string
+	ReviewedAt    *time.Time
+	// DuplicateOf - опубликованный вопрос, с которым объединен дубликат
+	DuplicateOf *int
+}
+
+// Операции построчной разницы текстов
+const (
+	DiffEqual  = "equal"
+	DiffInsert = "insert"
+	DiffDelete = "delete"
+)
+
+// DiffLine - строка построчной разницы: общая для обоих текстов, добавленная или удаленная
+type DiffLine struct {
+	Op   string
+	Text string
+}
+
+// QuestionTextDiff - построчная разница текстового поля вопроса
+type QuestionTextDiff struct {
+	Field string
+	Lines []DiffLine
+}
+
+// QuestionRevisionDiff - разница между двумя ревизиями вопроса
+type QuestionRevisionDiff struct {
+	From *QuestionRevision
+	To   *QuestionRevision
+	// ChangedFields - названия измененных полей, как в API
+	ChangedFields []string
+	// TextDiffs - построчная разница измененных текстовых полей
+	TextDiffs []QuestionTextDiff
+}
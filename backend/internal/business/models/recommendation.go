@@ -0,0 +1,22 @@
+package models
+
+// TechnologyStat - статистика ответов пользователя по одной технологии
+type TechnologyStat struct {
+	Technology string
+	Answered   int
+	Incorrect  int
+}
+
+// TechnologyWeakness - технология, в которой пользователь ошибается чаще всего.
+// Score - сглаженная доля ошибок от 0 до 1.
+type TechnologyWeakness struct {
+	TechnologyStat
+	Score float64
+}
+
+// MentorRecommendation - ментор, закрывающий слабые технологии пользователя
+type MentorRecommendation struct {
+	Mentor              *Mentor
+	Score               float64
+	MatchedTechnologies []string
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// Статусы отзыва
+const (
+	ReviewPublished = "published"
+	ReviewHidden    = "hidden"
+)
+
+// Статусы жалобы на отзыв
+const (
+	ReviewReportOpen      = "open"
+	ReviewReportResolved  = "resolved"
+	ReviewReportDismissed = "dismissed"
+)
+
+// Review представляет отзыв ученика о проведенном занятии
+type Review struct {
+	ID          int
+	BookingID   int
+	MentorID    int
+	AuthorID    int
+	AuthorName  string
+	Rating      int
+	Text        *string
+	MentorReply *string
+	RepliedAt   *time.Time
+	Status      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ReviewReport представляет жалобу пользователя на отзыв
+type ReviewReport struct {
+	ID         int
+	ReviewID   int
+	ReporterID int
+	Reason     string
+	Status     string
+	ResolvedBy *int
+	ResolvedAt *time.Time
+	CreatedAt  time.Time
+	Review     *Review
+}
@@ -2,6 +2,15 @@ package models
 
 import "time"
 
+// Роли пользователей
+const (
+	RoleUser      = "user"
+	RoleMentor    = "mentor"
+	RoleAuthor    = "author"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
 // User представляет модель пользователя
 type User struct {
 	ID          int
@@ -0,0 +1,231 @@
+package questionio
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"it_rabotyagi/internal/business/models"
+	"strconv"
+	"strings"
+)
+
+// csvColumns - колонки CSV в порядке экспорта. При импорте порядок колонок любой,
+// отсутствующие колонки считаются пустыми.
+//
+// Списки вариантов, ответов, порядка и шаблонов пишутся по одному значению на строку
+// внутри ячейки, технологии и компании - через запятую, эталонные решения разделяются
+// строкой "---".
+var csvColumns = []string{
+	"key",
+	"title",
+	"content",
+	"difficulty",
+	"type",
+	"technologies",
+	"company_tags",
+	"options",
+	"answer",
+	"answers",
+	"order",
+	"value",
+	"tolerance",
+	"patterns",
+	"case_sensitive",
+	"solutions",
+	"language",
+	"starter_code",
+	"explanation",
+}
+
+// csvSolutionSeparator разделяет эталонные решения в ячейке solutions
+const csvSolutionSeparator = "---"
+
+// utf8BOM добавляет в начало CSV Excel
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// readCSV читает таблицу с заголовком. Разделитель - запятая или точка с запятой:
+// таблицы с русской локалью сохраняются с точкой с запятой.
+func readCSV(data []byte) ([]models.QuestionImportRow, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+
+	r := csv.NewReader(bytes.NewReader(data))
+	header := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		header = data[:i]
+	}
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		r.Comma = ';'
+	}
+
+	names, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	columns := make(map[string]int, len(names))
+	known := make(map[string]bool, len(csvColumns))
+	for _, name := range csvColumns {
+		known[name] = true
+	}
+	for i, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !known[name] {
+			return nil, fmt.Errorf("header: unknown column %q", name)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("header: duplicate column %q", name)
+		}
+		columns[name] = i
+	}
+
+	var rows []models.QuestionImportRow
+	for n := 1; ; n++ {
+		fields, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		// Строку с другим числом колонок можно пропустить, остальные ошибки ломают разбор файла
+		if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+			return nil, err
+		}
+		if err != nil {
+			rows = append(rows, models.QuestionImportRow{Row: n, Err: csv.ErrFieldCount})
+			continue
+		}
+
+		get := func(column string) string {
+			if i, ok := columns[column]; ok {
+				return fields[i]
+			}
+			return ""
+		}
+		rec, err := csvRecord(get)
+		rows = append(rows, newRow(n, rec, err))
+	}
+	return rows, nil
+}
+
+// csvRecord собирает запись из ячеек строки
+func csvRecord(get func(column string) string) (record, error) {
+	rec := record{
+		Key:          strings.TrimSpace(get("key")),
+		Title:        get("title"),
+		Content:      get("content"),
+		Difficulty:   get("difficulty"),
+		Type:         get("type"),
+		Options:      splitList(get("options"), "\n"),
+		Language:     strings.TrimSpace(get("language")),
+		StarterCode:  get("starter_code"),
+		Explanation:  get("explanation"),
+		Technologies: splitList(get("technologies"), ","),
+		CompanyTags:  splitList(get("company_tags"), ","),
+		AnswerKey: answerKey{
+			Answer:    strings.TrimSpace(get("answer")),
+			Answers:   splitList(get("answers"), "\n"),
+			Order:     splitList(get("order"), "\n"),
+			Patterns:  splitList(get("patterns"), "\n"),
+			Solutions: splitSolutions(get("solutions")),
+		},
+	}
+
+	if v := strings.TrimSpace(get("value")); v != "" {
+		value, err := parseNumber(v)
+		if err != nil {
+			return rec, fmt.Errorf("value: %q is not a number", v)
+		}
+		rec.AnswerKey.Value = &value
+	}
+	if v := strings.TrimSpace(get("tolerance")); v != "" {
+		tolerance, err := parseNumber(v)
+		if err != nil {
+			return rec, fmt.Errorf("tolerance: %q is not a number", v)
+		}
+		rec.AnswerKey.Tolerance = tolerance
+	}
+	if v := strings.TrimSpace(get("case_sensitive")); v != "" {
+		caseSensitive, err := strconv.ParseBool(v)
+		if err != nil {
+			return rec, fmt.Errorf("case_sensitive: %q is not a boolean", v)
+		}
+		rec.AnswerKey.CaseSensitive = caseSensitive
+	}
+
+	return rec, nil
+}
+
+// splitSolutions разбивает ячейку с эталонными решениями по строкам-разделителям
+func splitSolutions(s string) []string {
+	var solutions []string
+	var current []string
+	flush := func() {
+		if solution := strings.Trim(strings.Join(current, "\n"), "\n"); strings.TrimSpace(solution) != "" {
+			solutions = append(solutions, solution)
+		}
+		current = nil
+	}
+	for _, line := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == csvSolutionSeparator {
+			flush()
+			continue
+		}
+		current = append(current, line)
+	}
+	flush()
+	return solutions
+}
+
+func writeCSV(w io.Writer, questions []*models.Question) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+
+	for _, q := range questions {
+		rec := newRecord(q)
+		value := ""
+		if rec.AnswerKey.Value != nil {
+			value = formatNumber(*rec.AnswerKey.Value)
+		}
+		tolerance := ""
+		if rec.AnswerKey.Tolerance != 0 {
+			tolerance = formatNumber(rec.AnswerKey.Tolerance)
+		}
+		caseSensitive := ""
+		if rec.AnswerKey.CaseSensitive {
+			caseSensitive = "true"
+		}
+
+		err := cw.Write([]string{
+			rec.Key,
+			rec.Title,
+			rec.Content,
+			rec.Difficulty,
+			rec.Type,
+			strings.Join(rec.Technologies, ", "),
+			strings.Join(rec.CompanyTags, ", "),
+			strings.Join(rec.Options, "\n"),
+			rec.AnswerKey.Answer,
+			strings.Join(rec.AnswerKey.Answers, "\n"),
+			strings.Join(rec.AnswerKey.Order, "\n"),
+			value,
+			tolerance,
+			strings.Join(rec.AnswerKey.Patterns, "\n"),
+			caseSensitive,
+			strings.Join(rec.AnswerKey.Solutions, "\n"+csvSolutionSeparator+"\n"),
+			rec.Language,
+			rec.StarterCode,
+			rec.Explanation,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
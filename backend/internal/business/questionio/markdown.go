@@ -0,0 +1,462 @@
+package questionio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"it_rabotyagi/internal/business/models"
+	"regexp"
+	"strings"
+)
+
+// Формат Markdown: каждый вопрос начинается с заголовка второго уровня. Под заголовком -
+// список метаданных вида "- type: single_choice", затем текст вопроса и разделы третьего уровня:
+//
+//	## Чем slice отличается от массива?
+//
+//	- key: go-slices-1
+//	- difficulty: easy
+//	- technologies: Go
+//
+//	Текст вопроса.
+//
+//	### Options
+//
+//	- [x] Длина slice может меняться
+//	- [ ] Ничем
+//
+//	### Explanation
+//
+//	Объяснение.
+//
+// Правильные варианты вопросов с выбором отмечаются [x]. В разделе Answer пишется ответ
+// остальных типов: нумерованный список для порядка, число с необязательным "± допуск",
+// список шаблонов для текста и блоки кода для эталонных решений. Заголовки второго
+// и третьего уровня внутри текста вопроса не поддерживаются.
+
+// Разделы вопроса в Markdown
+const (
+	mdSectionOptions     = "options"
+	mdSectionAnswer      = "answer"
+	mdSectionStarterCode = "starter code"
+	mdSectionExplanation = "explanation"
+)
+
+// Метаданные вопроса в Markdown
+const (
+	mdMetaKey           = "key"
+	mdMetaType          = "type"
+	mdMetaDifficulty    = "difficulty"
+	mdMetaTechnologies  = "technologies"
+	mdMetaCompanies     = "companies"
+	mdMetaLanguage      = "language"
+	mdMetaCaseSensitive = "case-sensitive"
+)
+
+var (
+	mdMetaRe     = regexp.MustCompile(`^[-*]\s+([a-z-]+):\s*(.*)$`)
+	mdListRe     = regexp.MustCompile(`^[-*]\s+(.*)$`)
+	mdNumberedRe = regexp.MustCompile(`^\d+[.)]\s+(.*)$`)
+	mdCheckboxRe = regexp.MustCompile(`^\[([ xX])\]\s+(.*)$`)
+	mdNumericRe  = regexp.MustCompile(`^(\S+)\s*(?:(?:±|\+-|\+/-)\s*(\S+))?$`)
+)
+
+// mdMetaKeys - поддерживаемые метаданные; строка списка с другим ключом считается текстом вопроса
+var mdMetaKeys = map[string]bool{
+	mdMetaKey:           true,
+	mdMetaType:          true,
+	mdMetaDifficulty:    true,
+	mdMetaTechnologies:  true,
+	mdMetaCompanies:     true,
+	mdMetaLanguage:      true,
+	mdMetaCaseSensitive: true,
+}
+
+// readMarkdown делит файл на вопросы по заголовкам второго уровня вне блоков кода.
+// Текст до первого вопроса, например общий заголовок файла, пропускается.
+func readMarkdown(data []byte) ([]models.QuestionImportRow, error) {
+	var blocks [][]string
+	fence := ""
+	for _, line := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		fence = nextFence(fence, line)
+		if fence == "" && strings.HasPrefix(line, "## ") {
+			blocks = append(blocks, []string{line})
+			continue
+		}
+		if len(blocks) > 0 {
+			blocks[len(blocks)-1] = append(blocks[len(blocks)-1], line)
+		}
+	}
+
+	rows := make([]models.QuestionImportRow, 0, len(blocks))
+	for i, block := range blocks {
+		rec, err := markdownRecord(block)
+		rows = append(rows, newRow(i+1, rec, err))
+	}
+	return rows, nil
+}
+
+// nextFence возвращает открытый блок кода после строки line: открывающую его
+// последовательность обратных кавычек или пустую строку вне блока
+func nextFence(fence, line string) string {
+	marker := fenceMarker(line)
+	switch {
+	case fence == "":
+		return marker
+	case marker != "" && len(marker) >= len(fence) && strings.TrimSpace(line) == marker:
+		return ""
+	}
+	return fence
+}
+
+// fenceMarker возвращает обратные кавычки, с которых начинается строка, если их хотя бы три
+func fenceMarker(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	n := len(trimmed) - len(strings.TrimLeft(trimmed, "`"))
+	if n < 3 {
+		return ""
+	}
+	return trimmed[:n]
+}
+
+// markdownRecord разбирает вопрос: заголовок, метаданные, текст и разделы
+func markdownRecord(lines []string) (record, error) {
+	rec := record{Title: strings.TrimSpace(strings.TrimPrefix(lines[0], "## "))}
+
+	body, sections, err := splitSections(lines[1:])
+	if err != nil {
+		return rec, err
+	}
+
+	// Метаданные идут списком в начале, первая строка другого вида начинает текст вопроса
+	caseSensitive := ""
+	i := 0
+	for ; i < len(body); i++ {
+		line := strings.TrimSpace(body[i])
+		if line == "" {
+			continue
+		}
+		m := mdMetaRe.FindStringSubmatch(line)
+		if m == nil || !mdMetaKeys[m[1]] {
+			break
+		}
+		value := strings.TrimSpace(m[2])
+		switch m[1] {
+		case mdMetaKey:
+			rec.Key = value
+		case mdMetaType:
+			rec.Type = value
+		case mdMetaDifficulty:
+			rec.Difficulty = value
+		case mdMetaTechnologies:
+			rec.Technologies = splitList(value, ",")
+		case mdMetaCompanies:
+			rec.CompanyTags = splitList(value, ",")
+		case mdMetaLanguage:
+			rec.Language = value
+		case mdMetaCaseSensitive:
+			caseSensitive = value
+		}
+	}
+	rec.Content = strings.TrimSpace(strings.Join(body[i:], "\n"))
+
+	switch caseSensitive {
+	case "", "false", "no":
+	case "true", "yes":
+		rec.AnswerKey.CaseSensitive = true
+	default:
+		return rec, fmt.Errorf("case-sensitive: %q is not a boolean", caseSensitive)
+	}
+	if rec.Type == "" {
+		rec.Type = models.QuestionTypeSingleChoice
+	}
+
+	if err := parseOptions(&rec, sections[mdSectionOptions]); err != nil {
+		return rec, err
+	}
+	if err := parseAnswer(&rec, sections[mdSectionAnswer]); err != nil {
+		return rec, err
+	}
+	if code := sections[mdSectionStarterCode]; code != nil {
+		blocks, info, err := codeBlocks(code)
+		if err != nil {
+			return rec, fmt.Errorf("starter code: %w", err)
+		}
+		if len(blocks) != 1 {
+			return rec, errors.New("starter code: exactly one code block is expected")
+		}
+		rec.StarterCode = blocks[0]
+		if rec.Language == "" {
+			rec.Language = info
+		}
+	}
+	rec.Explanation = strings.TrimSpace(strings.Join(sections[mdSectionExplanation], "\n"))
+
+	return rec, nil
+}
+
+// splitSections отделяет текст вопроса от разделов третьего уровня
+func splitSections(lines []string) ([]string, map[string][]string, error) {
+	sections := make(map[string][]string)
+	var body []string
+	current := ""
+	fence := ""
+	for _, line := range lines {
+		fence = nextFence(fence, line)
+		if fence == "" && strings.HasPrefix(line, "### ") {
+			current = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(line, "### ")))
+			switch current {
+			case mdSectionOptions, mdSectionAnswer, mdSectionStarterCode, mdSectionExplanation:
+			default:
+				return nil, nil, fmt.Errorf("unknown section %q", current)
+			}
+			if _, ok := sections[current]; ok {
+				return nil, nil, fmt.Errorf("duplicate section %q", current)
+			}
+			sections[current] = []string{}
+			continue
+		}
+		if current == "" {
+			body = append(body, line)
+		} else {
+			sections[current] = append(sections[current], line)
+		}
+	}
+	return body, sections, nil
+}
+
+// parseOptions разбирает список вариантов; отмеченные [x] варианты - правильные ответы
+func parseOptions(rec *record, lines []string) error {
+	var checked []string
+	for _, line := range nonBlank(lines) {
+		m := mdListRe.FindStringSubmatch(line)
+		if m == nil {
+			return fmt.Errorf("options: %q is not a list item", line)
+		}
+		option := m[1]
+		if c := mdCheckboxRe.FindStringSubmatch(option); c != nil {
+			option = c[2]
+			if c[1] != " " {
+				checked = append(checked, option)
+			}
+		}
+		rec.Options = append(rec.Options, option)
+	}
+
+	switch rec.Type {
+	case models.QuestionTypeSingleChoice:
+		if len(checked) != 1 {
+			return errors.New("options: mark exactly one correct option with [x]")
+		}
+		rec.AnswerKey.Answer = checked[0]
+	case models.QuestionTypeMultipleChoice:
+		rec.AnswerKey.Answers = checked
+	}
+	return nil
+}
+
+// parseAnswer разбирает раздел Answer в зависимости от типа вопроса
+func parseAnswer(rec *record, lines []string) error {
+	items := nonBlank(lines)
+
+	switch rec.Type {
+	case models.QuestionTypeSingleChoice, models.QuestionTypeMultipleChoice:
+		if len(items) > 0 {
+			return fmt.Errorf("answer: not used for %s questions, mark correct options with [x]", rec.Type)
+		}
+	case models.QuestionTypeOrdering:
+		for _, line := range items {
+			m := mdNumberedRe.FindStringSubmatch(line)
+			if m == nil {
+				return fmt.Errorf("answer: %q is not a numbered list item", line)
+			}
+			rec.AnswerKey.Order = append(rec.AnswerKey.Order, m[1])
+		}
+	case models.QuestionTypeNumeric:
+		if len(items) != 1 {
+			return errors.New("answer: a single number is expected")
+		}
+		m := mdNumericRe.FindStringSubmatch(items[0])
+		if m == nil {
+			return fmt.Errorf("answer: %q is not a number", items[0])
+		}
+		value, err := parseNumber(m[1])
+		if err != nil {
+			return fmt.Errorf("answer: %q is not a number", m[1])
+		}
+		rec.AnswerKey.Value = &value
+		if m[2] != "" {
+			if rec.AnswerKey.Tolerance, err = parseNumber(m[2]); err != nil {
+				return fmt.Errorf("answer: tolerance %q is not a number", m[2])
+			}
+		}
+	case models.QuestionTypeFreeText:
+		for _, line := range items {
+			m := mdListRe.FindStringSubmatch(line)
+			if m == nil {
+				return fmt.Errorf("answer: %q is not a list item", line)
+			}
+			rec.AnswerKey.Patterns = append(rec.AnswerKey.Patterns, unquoteCode(m[1]))
+		}
+	case models.QuestionTypeCode:
+		solutions, _, err := codeBlocks(lines)
+		if err != nil {
+			return fmt.Errorf("answer: %w", err)
+		}
+		rec.AnswerKey.Solutions = solutions
+	}
+	return nil
+}
+
+// codeBlocks возвращает содержимое блоков кода и язык первого из них.
+// Текст вне блоков считается ошибкой.
+func codeBlocks(lines []string) ([]string, string, error) {
+	var blocks []string
+	var current []string
+	info := ""
+	fence := ""
+	for _, line := range lines {
+		next := nextFence(fence, line)
+		switch {
+		case fence == "" && next != "":
+			if len(blocks) == 0 {
+				info = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "`"))
+			}
+			current = nil
+		case fence != "" && next == "":
+			blocks = append(blocks, strings.Join(current, "\n"))
+		case fence != "":
+			current = append(current, line)
+		case strings.TrimSpace(line) != "":
+			return nil, "", fmt.Errorf("%q is outside of a code block", line)
+		}
+		fence = next
+	}
+	if fence != "" {
+		return nil, "", errors.New("code block is not closed")
+	}
+	return blocks, info, nil
+}
+
+// unquoteCode убирает обратные кавычки вокруг значения
+func unquoteCode(s string) string {
+	if len(s) >= 2 && strings.HasPrefix(s, "`") && strings.HasSuffix(s, "`") {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+func nonBlank(lines []string) []string {
+	var result []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+func writeMarkdown(w io.Writer, questions []*models.Question) error {
+	var b strings.Builder
+	for i, q := range questions {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		writeMarkdownQuestion(&b, newRecord(q))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdownQuestion(b *strings.Builder, rec record) {
+	fmt.Fprintf(b, "## %s\n\n", singleLine(rec.Title))
+
+	meta := func(key, value string) {
+		if value != "" {
+			fmt.Fprintf(b, "- %s: %s\n", key, value)
+		}
+	}
+	meta(mdMetaKey, rec.Key)
+	meta(mdMetaType, rec.Type)
+	meta(mdMetaDifficulty, rec.Difficulty)
+	meta(mdMetaTechnologies, strings.Join(rec.Technologies, ", "))
+	meta(mdMetaCompanies, strings.Join(rec.CompanyTags, ", "))
+	meta(mdMetaLanguage, rec.Language)
+	if rec.AnswerKey.CaseSensitive {
+		meta(mdMetaCaseSensitive, "true")
+	}
+
+	fmt.Fprintf(b, "\n%s\n", strings.TrimSpace(rec.Content))
+
+	if len(rec.Options) > 0 {
+		b.WriteString("\n### Options\n\n")
+		correct := make(map[string]bool)
+		correct[rec.AnswerKey.Answer] = rec.Type == models.QuestionTypeSingleChoice
+		for _, a := range rec.AnswerKey.Answers {
+			correct[a] = true
+		}
+		for _, option := range rec.Options {
+			switch {
+			case rec.Type == models.QuestionTypeOrdering:
+				fmt.Fprintf(b, "- %s\n", singleLine(option))
+			case correct[option]:
+				fmt.Fprintf(b, "- [x] %s\n", singleLine(option))
+			default:
+				fmt.Fprintf(b, "- [ ] %s\n", singleLine(option))
+			}
+		}
+	}
+
+	switch rec.Type {
+	case models.QuestionTypeOrdering:
+		b.WriteString("\n### Answer\n\n")
+		for i, item := range rec.AnswerKey.Order {
+			fmt.Fprintf(b, "%d. %s\n", i+1, singleLine(item))
+		}
+	case models.QuestionTypeNumeric:
+		if rec.AnswerKey.Value != nil {
+			b.WriteString("\n### Answer\n\n")
+			b.WriteString(formatNumber(*rec.AnswerKey.Value))
+			if rec.AnswerKey.Tolerance != 0 {
+				b.WriteString(" ± " + formatNumber(rec.AnswerKey.Tolerance))
+			}
+			b.WriteString("\n")
+		}
+	case models.QuestionTypeFreeText:
+		b.WriteString("\n### Answer\n\n")
+		for _, pattern := range rec.AnswerKey.Patterns {
+			fmt.Fprintf(b, "- `%s`\n", pattern)
+		}
+	case models.QuestionTypeCode:
+		if rec.StarterCode != "" {
+			b.WriteString("\n### Starter code\n\n")
+			writeCodeBlock(b, rec.Language, rec.StarterCode)
+		}
+		b.WriteString("\n### Answer\n")
+		for _, solution := range rec.AnswerKey.Solutions {
+			b.WriteString("\n")
+			writeCodeBlock(b, rec.Language, solution)
+		}
+	}
+
+	if rec.Explanation != "" {
+		fmt.Fprintf(b, "\n### Explanation\n\n%s\n", strings.TrimSpace(rec.Explanation))
+	}
+}
+
+// writeCodeBlock пишет блок кода, ограничитель которого длиннее любой
+// последовательности обратных кавычек в начале строк кода
+func writeCodeBlock(b *strings.Builder, language, code string) {
+	fence := "```"
+	for _, line := range strings.Split(code, "\n") {
+		if marker := fenceMarker(line); len(marker) >= len(fence) {
+			fence = marker + "`"
+		}
+	}
+	fmt.Fprintf(b, "%s%s\n%s\n%s\n", fence, language, strings.TrimRight(code, "\n"), fence)
+}
+
+// singleLine заменяет переводы строк пробелами: элемент списка Markdown занимает одну строку
+func singleLine(s string) string {
+	return strings.NewReplacer("\r\n", " ", "\n", " ").Replace(s)
+}
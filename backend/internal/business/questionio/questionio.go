@@ -0,0 +1,107 @@
+// Package questionio читает и записывает вопросы в файлах импорта и экспорта: JSON, YAML,
+// CSV и Markdown. Ошибка в отдельном вопросе не мешает прочитать остальные, она возвращается
+// в QuestionImportRow.Err. Содержимое вопросов здесь не проверяется, это делает сервис.
+package questionio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"it_rabotyagi/internal/business/models"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownFormat возвращается для неподдерживаемого формата файла
+var ErrUnknownFormat = errors.New("unknown format")
+
+// IsKnownFormat проверяет, что формат поддерживается
+func IsKnownFormat(format string) bool {
+	switch format {
+	case models.QuestionFormatJSON, models.QuestionFormatYAML, models.QuestionFormatCSV, models.QuestionFormatMarkdown:
+		return true
+	}
+	return false
+}
+
+// Read читает вопросы из файла. Ошибка означает, что файл не удалось разобрать целиком.
+func Read(format string, data []byte) ([]models.QuestionImportRow, error) {
+	switch format {
+	case models.QuestionFormatJSON:
+		return readJSON(data)
+	case models.QuestionFormatYAML:
+		return readYAML(data)
+	case models.QuestionFormatCSV:
+		return readCSV(data)
+	case models.QuestionFormatMarkdown:
+		return readMarkdown(data)
+	}
+	return nil, fmt.Errorf("%w %q", ErrUnknownFormat, format)
+}
+
+// Write записывает вопросы вместе с ключами ответов в формате, который понимает Read
+func Write(format string, w io.Writer, questions []*models.Question) error {
+	switch format {
+	case models.QuestionFormatJSON:
+		return writeJSON(w, questions)
+	case models.QuestionFormatYAML:
+		return writeYAML(w, questions)
+	case models.QuestionFormatCSV:
+		return writeCSV(w, questions)
+	case models.QuestionFormatMarkdown:
+		return writeMarkdown(w, questions)
+	}
+	return fmt.Errorf("%w %q", ErrUnknownFormat, format)
+}
+
+// ContentType возвращает MIME-тип файла в формате format
+func ContentType(format string) string {
+	switch format {
+	case models.QuestionFormatJSON:
+		return "application/json"
+	case models.QuestionFormatYAML:
+		return "application/yaml"
+	case models.QuestionFormatCSV:
+		return "text/csv; charset=utf-8"
+	case models.QuestionFormatMarkdown:
+		return "text/markdown; charset=utf-8"
+	}
+	return "application/octet-stream"
+}
+
+// Extension возвращает расширение файла в формате format
+func Extension(format string) string {
+	if format == models.QuestionFormatMarkdown {
+		return "md"
+	}
+	return format
+}
+
+// newRow собирает результат разбора вопроса номер n
+func newRow(n int, rec record, err error) models.QuestionImportRow {
+	if err != nil {
+		return models.QuestionImportRow{Row: n, Err: err}
+	}
+	return models.QuestionImportRow{Row: n, Question: rec.question()}
+}
+
+// parseNumber разбирает число, допуская запятую в качестве десятичного разделителя,
+// как в таблицах с русской локалью
+func parseNumber(s string) (float64, error) {
+	return strconv.ParseFloat(strings.Replace(strings.TrimSpace(s), ",", ".", 1), 64)
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// splitList разбивает строку по разделителю, убирая пробелы и пустые значения
+func splitList(s, sep string) []string {
+	var result []string
+	for _, v := range strings.Split(s, sep) {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,202 @@
+package questionio
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"it_rabotyagi/internal/business/models"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// record - вопрос в файлах JSON и YAML. Поля совпадают с QuestionRequest в API,
+// key - ключ для повторного импорта.
+type record struct {
+	Key          string    `json:"key,omitempty" yaml:"key,omitempty"`
+	Title        string    `json:"title" yaml:"title"`
+	Content      string    `json:"content" yaml:"content"`
+	Difficulty   string    `json:"difficulty" yaml:"difficulty"`
+	Type         string    `json:"type" yaml:"type"`
+	Options      []string  `json:"options,omitempty" yaml:"options,omitempty"`
+	Language     string    `json:"language,omitempty" yaml:"language,omitempty"`
+	StarterCode  string    `json:"starterCode,omitempty" yaml:"starterCode,omitempty"`
+	AnswerKey    answerKey `json:"answerKey" yaml:"answerKey"`
+	Explanation  string    `json:"explanation,omitempty" yaml:"explanation,omitempty"`
+	Technologies []string  `json:"technologies" yaml:"technologies"`
+	CompanyTags  []string  `json:"companyTags,omitempty" yaml:"companyTags,omitempty"`
+}
+
+// answerKey - ключ ответа в файле, заполняются поля, относящиеся к типу вопроса
+type answerKey struct {
+	Answer        string   `json:"answer,omitempty" yaml:"answer,omitempty"`
+	Answers       []string `json:"answers,omitempty" yaml:"answers,omitempty"`
+	Order         []string `json:"order,omitempty" yaml:"order,omitempty"`
+	Value         *float64 `json:"value,omitempty" yaml:"value,omitempty"`
+	Tolerance     float64  `json:"tolerance,omitempty" yaml:"tolerance,omitempty"`
+	Patterns      []string `json:"patterns,omitempty" yaml:"patterns,omitempty"`
+	CaseSensitive bool     `json:"caseSensitive,omitempty" yaml:"caseSensitive,omitempty"`
+	Solutions     []string `json:"solutions,omitempty" yaml:"solutions,omitempty"`
+}
+
+// question преобразует запись в вопрос. Тип по умолчанию - вопрос с одним ответом.
+func (rec record) question() *models.Question {
+	q := &models.Question{
+		ExternalKey: optionalText(rec.Key),
+		Title:       rec.Title,
+		Content:     rec.Content,
+		Difficulty:  strings.TrimSpace(rec.Difficulty),
+		Type:        strings.TrimSpace(rec.Type),
+		Options:     rec.Options,
+		Language:    optionalText(rec.Language),
+		StarterCode: optionalText(rec.StarterCode),
+		AnswerKey: &models.AnswerKey{
+			Answer:        rec.AnswerKey.Answer,
+			Answers:       rec.AnswerKey.Answers,
+			Order:         rec.AnswerKey.Order,
+			Value:         rec.AnswerKey.Value,
+			Tolerance:     rec.AnswerKey.Tolerance,
+			Patterns:      rec.AnswerKey.Patterns,
+			CaseSensitive: rec.AnswerKey.CaseSensitive,
+			Solutions:     rec.AnswerKey.Solutions,
+		},
+		Explanation:  optionalText(rec.Explanation),
+		Technologies: rec.Technologies,
+		CompanyTags:  rec.CompanyTags,
+	}
+	if q.Type == "" {
+		q.Type = models.QuestionTypeSingleChoice
+	}
+	return q
+}
+
+// newRecord преобразует вопрос в запись файла
+func newRecord(q *models.Question) record {
+	rec := record{
+		Key:          derefText(q.ExternalKey),
+		Title:        q.Title,
+		Content:      q.Content,
+		Difficulty:   q.Difficulty,
+		Type:         q.Type,
+		Options:      q.Options,
+		Language:     derefText(q.Language),
+		StarterCode:  derefText(q.StarterCode),
+		Explanation:  derefText(q.Explanation),
+		Technologies: q.Technologies,
+		CompanyTags:  q.CompanyTags,
+	}
+	if len(rec.Options) == 0 {
+		rec.Options = nil
+	}
+	if q.AnswerKey != nil {
+		rec.AnswerKey = answerKey{
+			Answer:        q.AnswerKey.Answer,
+			Answers:       q.AnswerKey.Answers,
+			Order:         q.AnswerKey.Order,
+			Value:         q.AnswerKey.Value,
+			Tolerance:     q.AnswerKey.Tolerance,
+			Patterns:      q.AnswerKey.Patterns,
+			CaseSensitive: q.AnswerKey.CaseSensitive,
+			Solutions:     q.AnswerKey.Solutions,
+		}
+	}
+	if rec.Technologies == nil {
+		rec.Technologies = []string{}
+	}
+	return rec
+}
+
+func newRecords(questions []*models.Question) []record {
+	records := make([]record, 0, len(questions))
+	for _, q := range questions {
+		records = append(records, newRecord(q))
+	}
+	return records
+}
+
+// readJSON читает массив вопросов. Каждый элемент разбирается отдельно,
+// неизвестные поля считаются ошибкой, чтобы опечатки в названиях не терялись молча.
+func readJSON(data []byte) ([]models.QuestionImportRow, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("file must contain a JSON array of questions: %w", err)
+	}
+
+	rows := make([]models.QuestionImportRow, 0, len(items))
+	for i, item := range items {
+		var rec record
+		dec := json.NewDecoder(bytes.NewReader(item))
+		dec.DisallowUnknownFields()
+		err := dec.Decode(&rec)
+		rows = append(rows, newRow(i+1, rec, err))
+	}
+	return rows, nil
+}
+
+// readYAML читает список вопросов, так же как readJSON
+func readYAML(data []byte) ([]models.QuestionImportRow, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	list := doc.Content[0]
+	if list.Kind != yaml.SequenceNode {
+		return nil, errors.New("file must contain a YAML list of questions")
+	}
+
+	rows := make([]models.QuestionImportRow, 0, len(list.Content))
+	for i, item := range list.Content {
+		var rec record
+		err := decodeYAMLStrict(item, &rec)
+		rows = append(rows, newRow(i+1, rec, err))
+	}
+	return rows, nil
+}
+
+// decodeYAMLStrict разбирает узел, считая неизвестные поля ошибкой.
+// yaml.Node.Decode такой проверки не умеет, поэтому узел разбирается заново декодером.
+func decodeYAMLStrict(node *yaml.Node, v interface{}) error {
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	return dec.Decode(v)
+}
+
+func writeJSON(w io.Writer, questions []*models.Question) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(newRecords(questions))
+}
+
+func writeYAML(w io.Writer, questions []*models.Question) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(newRecords(questions)); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// optionalText возвращает nil для пустой строки
+func optionalText(s string) *string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	return &s
+}
+
+func derefText(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
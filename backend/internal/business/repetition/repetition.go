@@ -0,0 +1,79 @@
+// Package repetition планирует интервальное повторение вопросов по алгоритму SM-2.
+// Каждый ответ оценивается качеством припоминания от 0 до 5: неверный ответ сбрасывает
+// серию и возвращает вопрос на следующий день, верный увеличивает интервал
+// с учетом коэффициента легкости вопроса.
+package repetition
+
+import (
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"math"
+	"time"
+)
+
+// Параметры алгоритма
+const (
+	// InitialEaseFactor - коэффициент легкости нового вопроса
+	InitialEaseFactor = 2.5
+	minEaseFactor     = 1.3
+	// maxIntervalDays ограничивает интервал, чтобы выученный вопрос все же иногда возвращался
+	maxIntervalDays = 365
+	// passQuality - наименьшее качество, при котором ответ считается вспомненным
+	passQuality = 3
+)
+
+// qualities переводит оценку пользователя в качество припоминания SM-2
+var qualities = map[string]int{
+	models.RecallAgain: 1,
+	models.RecallHard:  3,
+	models.RecallGood:  4,
+	models.RecallEasy:  5,
+}
+
+// Quality возвращает качество припоминания для ответа. Неверный ответ всегда считается
+// забытым; для верного учитывается оценка пользователя, по умолчанию "good".
+func Quality(isCorrect bool, recall string) (int, error) {
+	if recall == "" {
+		recall = models.RecallGood
+	}
+	q, ok := qualities[recall]
+	if !ok {
+		return 0, fmt.Errorf("unknown recall %q", recall)
+	}
+	if !isCorrect {
+		return 0, nil
+	}
+	return q, nil
+}
+
+// Next возвращает расписание после ответа качества quality в момент now. prev - расписание
+// до ответа; nil для первого ответа на вопрос.
+func Next(prev *models.ReviewSchedule, quality int, now time.Time) models.ReviewSchedule {
+	s := models.ReviewSchedule{EaseFactor: InitialEaseFactor}
+	if prev != nil {
+		s = *prev
+	}
+
+	if quality < passQuality {
+		s.Repetitions = 0
+		s.Lapses++
+		s.IntervalDays = 1
+	} else {
+		s.Repetitions++
+		switch s.Repetitions {
+		case 1:
+			s.IntervalDays = 1
+		case 2:
+			s.IntervalDays = 6
+		default:
+			s.IntervalDays = int(math.Round(float64(s.IntervalDays) * s.EaseFactor))
+		}
+		s.IntervalDays = min(s.IntervalDays, maxIntervalDays)
+	}
+
+	d := float64(5 - quality)
+	// Округление до сотых не дает копиться погрешности при многократном пересчете
+	s.EaseFactor = max(math.Round((s.EaseFactor+0.1-d*(0.08+d*0.02))*100)/100, minEaseFactor)
+	s.DueAt = now.AddDate(0, 0, s.IntervalDays)
+	return s
+}
@@ -0,0 +1,348 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"sort"
+	"time"
+	// Встраиваем базу часовых поясов, чтобы расписания работали и в минимальных образах
+	_ "time/tzdata"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Ограничения на запрос свободных слотов
+const (
+	maxSlotsRange   = 31 * 24 * time.Hour
+	minSlotDuration = 15 * time.Minute
+	maxSlotDuration = 4 * time.Hour
+)
+
+var (
+	// ErrInvalidSchedule возвращается, если расписание ментора заполнено некорректно
+	ErrInvalidSchedule = errors.New("invalid schedule")
+	// ErrInvalidSlotsRange возвращается при некорректном интервале или длительности слотов
+	ErrInvalidSlotsRange = errors.New("invalid slots range")
+	// ErrExceptionNotFound возвращается, если исключение из расписания не найдено
+	ErrExceptionNotFound = errors.New("availability exception not found")
+)
+
+// interval - полуоткрытый интервал времени [start, end)
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+func (i interval) overlaps(o interval) bool {
+	return i.start.Before(o.end) && o.start.Before(i.end)
+}
+
+// AvailabilityService отвечает за календарь доступности менторов и генерацию слотов
+type AvailabilityService struct {
+	repo        *repositories.AvailabilityRepository
+	bookingRepo *repositories.BookingRepository
+	mentorRepo  *repositories.MentorRepository
+}
+
+func NewAvailabilityService(repo *repositories.AvailabilityRepository, bookingRepo *repositories.BookingRepository, mentorRepo *repositories.MentorRepository) *AvailabilityService {
+	return &AvailabilityService{
+		repo:        repo,
+		bookingRepo: bookingRepo,
+		mentorRepo:  mentorRepo,
+	}
+}
+
+// GetMyAvailability возвращает расписание ментора и предстоящие исключения
+func (s *AvailabilityService) GetMyAvailability(ctx context.Context, userID int) (*models.Availability, error) {
+	mentorID, err := s.mentorIDByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.getAvailability(ctx, mentorID)
+}
+
+// UpdateMySchedule заменяет часовой пояс и еженедельные окна ментора
+func (s *AvailabilityService) UpdateMySchedule(ctx context.Context, userID int, timezone string, rules []models.AvailabilityRule) (*models.Availability, error) {
+	mentorID, err := s.mentorIDByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidSchedule, timezone)
+	}
+	if err := validateRules(rules); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchedule, err)
+	}
+
+	if err := s.repo.ReplaceSchedule(ctx, mentorID, timezone, rules); err != nil {
+		return nil, err
+	}
+
+	return s.getAvailability(ctx, mentorID)
+}
+
+// AddException добавляет ментору разовое исключение из расписания
+func (s *AvailabilityService) AddException(ctx context.Context, userID int, e *models.AvailabilityException) error {
+	mentorID, err := s.mentorIDByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !e.StartsAt.Before(e.EndsAt) {
+		return fmt.Errorf("%w: exception must end after it starts", ErrInvalidSchedule)
+	}
+
+	e.MentorID = mentorID
+	return s.repo.CreateException(ctx, e)
+}
+
+// DeleteException удаляет исключение из расписания ментора
+func (s *AvailabilityService) DeleteException(ctx context.Context, userID, id int) error {
+	mentorID, err := s.mentorIDByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.DeleteException(ctx, mentorID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrExceptionNotFound
+	}
+	return nil
+}
+
+// GetSlots возвращает свободные слоты ментора длительностью duration в интервале [from, to)
+// и часовой пояс ментора
+func (s *AvailabilityService) GetSlots(ctx context.Context, mentorID int, from, to time.Time, duration time.Duration) ([]models.Slot, string, error) {
+	if !from.Before(to) || to.Sub(from) > maxSlotsRange {
+		return nil, "", fmt.Errorf("%w: range must be positive and at most 31 days", ErrInvalidSlotsRange)
+	}
+	if duration < minSlotDuration || duration > maxSlotDuration {
+		return nil, "", fmt.Errorf("%w: duration must be between 15 and 240 minutes", ErrInvalidSlotsRange)
+	}
+
+	// Прошедшее время забронировать нельзя
+	if now := time.Now(); from.Before(now) {
+		from = now
+	}
+
+	timezone, windows, blocked, err := s.loadCalendar(ctx, mentorID, from, to, 0)
+	if err != nil {
+		return nil, "", err
+	}
+
+	slots := []models.Slot{}
+	for _, w := range windows {
+		for start := w.start; !start.Add(duration).After(w.end); start = start.Add(duration) {
+			slot := interval{start: start, end: start.Add(duration)}
+			if slot.start.Before(from) || slot.end.After(to) || overlapsAny(slot, blocked) {
+				continue
+			}
+			slots = append(slots, models.Slot{StartsAt: slot.start, EndsAt: slot.end})
+		}
+	}
+
+	return slots, timezone, nil
+}
+
+// IsWithinAvailability проверяет, что интервал [start, end) целиком попадает в окно доступности
+// ментора и не пересекается с периодами недоступности и активными бронированиями, кроме
+// переносимого бронирования ignoreBookingID (0 - нет такого).
+// Гонки между одновременными бронированиями дополнительно исключает ограничение в базе данных.
+func (s *AvailabilityService) IsWithinAvailability(ctx context.Context, mentorID int, start, end time.Time, ignoreBookingID int) (bool, error) {
+	_, windows, blocked, err := s.loadCalendar(ctx, mentorID, start, end, ignoreBookingID)
+	if err != nil {
+		return false, err
+	}
+
+	if overlapsAny(interval{start: start, end: end}, blocked) {
+		return false, nil
+	}
+	for _, w := range windows {
+		if !w.start.After(start) && !w.end.Before(end) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getAvailability собирает расписание ментора вместе с предстоящими исключениями
+func (s *AvailabilityService) getAvailability(ctx context.Context, mentorID int) (*models.Availability, error) {
+	timezone, err := s.repo.GetTimezone(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.repo.GetRules(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	// Прошедшие исключения ментору уже не интересны
+	exceptions, err := s.repo.GetExceptions(ctx, mentorID, time.Now(), time.Now().AddDate(10, 0, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Availability{
+		MentorID:   mentorID,
+		Timezone:   timezone,
+		Rules:      rules,
+		Exceptions: exceptions,
+	}, nil
+}
+
+// loadCalendar строит объединенные окна доступности ментора и занятые интервалы
+// (периоды недоступности и активные бронирования, кроме ignoreBookingID) вокруг интервала [from, to)
+func (s *AvailabilityService) loadCalendar(ctx context.Context, mentorID int, from, to time.Time, ignoreBookingID int) (string, []interval, []interval, error) {
+	timezone, err := s.repo.GetTimezone(ctx, mentorID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil, nil, ErrMentorNotFound
+		}
+		return "", nil, nil, err
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	rules, err := s.repo.GetRules(ctx, mentorID)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	exceptions, err := s.repo.GetExceptions(ctx, mentorID, from, to)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	bookings, err := s.bookingRepo.GetActiveBookingsInRange(ctx, mentorID, from, to)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	var windows, blocked []interval
+
+	// Разворачиваем еженедельные правила в конкретные окна, начиная с предыдущего дня,
+	// чтобы учесть разницу часовых поясов
+	fromLocal := from.In(loc)
+	day := time.Date(fromLocal.Year(), fromLocal.Month(), fromLocal.Day()-1, 0, 0, 0, 0, loc)
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, rule := range rules {
+			if rule.Weekday != day.Weekday() {
+				continue
+			}
+			start, err := atClock(day, rule.StartTime)
+			if err != nil {
+				return "", nil, nil, err
+			}
+			end, err := atClock(day, rule.EndTime)
+			if err != nil {
+				return "", nil, nil, err
+			}
+			windows = append(windows, interval{start: start, end: end})
+		}
+	}
+
+	for _, e := range exceptions {
+		if e.IsAvailable {
+			windows = append(windows, interval{start: e.StartsAt, end: e.EndsAt})
+		} else {
+			blocked = append(blocked, interval{start: e.StartsAt, end: e.EndsAt})
+		}
+	}
+	for _, b := range bookings {
+		if b.ID == ignoreBookingID {
+			continue
+		}
+		blocked = append(blocked, interval{start: b.StartsAt, end: b.EndsAt})
+	}
+
+	return timezone, mergeIntervals(windows), blocked, nil
+}
+
+// mentorIDByUser находит профиль ментора пользователя
+func (s *AvailabilityService) mentorIDByUser(ctx context.Context, userID int) (int, error) {
+	mentorID, err := s.mentorRepo.GetMentorIDByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if mentorID == nil {
+		return 0, ErrNotMentor
+	}
+	return *mentorID, nil
+}
+
+// validateRules проверяет, что окна заданы в формате ЧЧ:ММ и не пересекаются в пределах дня
+func validateRules(rules []models.AvailabilityRule) error {
+	byDay := make(map[time.Weekday][]interval)
+	base := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, rule := range rules {
+		if rule.Weekday < time.Sunday || rule.Weekday > time.Saturday {
+			return fmt.Errorf("weekday %d is out of range", rule.Weekday)
+		}
+		start, err := atClock(base, rule.StartTime)
+		if err != nil {
+			return err
+		}
+		end, err := atClock(base, rule.EndTime)
+		if err != nil {
+			return err
+		}
+		if !start.Before(end) {
+			return fmt.Errorf("window %s-%s must end after it starts", rule.StartTime, rule.EndTime)
+		}
+
+		window := interval{start: start, end: end}
+		if overlapsAny(window, byDay[rule.Weekday]) {
+			return fmt.Errorf("windows on %s overlap", rule.Weekday)
+		}
+		byDay[rule.Weekday] = append(byDay[rule.Weekday], window)
+	}
+	return nil
+}
+
+// atClock возвращает момент времени clock (ЧЧ:ММ) в день day
+func atClock(day time.Time, clock string) (time.Time, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected HH:MM", clock)
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, day.Location()), nil
+}
+
+// mergeIntervals сортирует интервалы и объединяет пересекающиеся и смежные
+func mergeIntervals(intervals []interval) []interval {
+	if len(intervals) == 0 {
+		return nil
+	}
+
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].start.Before(intervals[j].start) })
+
+	merged := []interval{intervals[0]}
+	for _, cur := range intervals[1:] {
+		last := &merged[len(merged)-1]
+		if !cur.start.After(last.end) {
+			if cur.end.After(last.end) {
+				last.end = cur.end
+			}
+			continue
+		}
+		merged = append(merged, cur)
+	}
+	return merged
+}
+
+// overlapsAny проверяет, пересекается ли интервал хотя бы с одним из списка
+func overlapsAny(i interval, list []interval) bool {
+	for _, o := range list {
+		if i.overlaps(o) {
+			return true
+		}
+	}
+	return false
+}
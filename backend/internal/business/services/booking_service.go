@@ -0,0 +1,322 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// pgExclusionViolation - код ошибки PostgreSQL при нарушении EXCLUDE ограничения
+const pgExclusionViolation = "23P01"
+
+var (
+	// ErrBookingNotFound возвращается, если бронирование не найдено или недоступно пользователю
+	ErrBookingNotFound = errors.New("booking not found")
+	// ErrBookingForbidden возвращается, если действие с бронированием недоступно пользователю
+	ErrBookingForbidden = errors.New("booking action is not allowed for this user")
+	// ErrInvalidBooking возвращается, если запрос на бронирование заполнен некорректно
+	ErrInvalidBooking = errors.New("invalid booking")
+	// ErrSlotUnavailable возвращается, если время не входит в расписание ментора или уже занято
+	ErrSlotUnavailable = errors.New("slot is not available")
+	// ErrInvalidBookingTransition возвращается при недопустимой смене статуса бронирования
+	ErrInvalidBookingTransition = errors.New("invalid booking status transition")
+)
+
+// BookingService отвечает за бронирование занятий и жизненный цикл бронирований
+type BookingService struct {
+	repo                *repositories.BookingRepository
+	mentorRepo          *repositories.MentorRepository
+	availabilityService *AvailabilityService
+	notificationService *NotificationService
+	paymentService      *PaymentService
+}
+
+func NewBookingService(repo *repositories.BookingRepository, mentorRepo *repositories.MentorRepository, availabilityService *AvailabilityService, notificationService *NotificationService, paymentService *PaymentService) *BookingService {
+	return &BookingService{
+		repo:                repo,
+		mentorRepo:          mentorRepo,
+		availabilityService: availabilityService,
+		notificationService: notificationService,
+		paymentService:      paymentService,
+	}
+}
+
+// Book создает запрос на занятие с ментором в статусе requested
+func (s *BookingService) Book(ctx context.Context, menteeID, mentorID int, startsAt time.Time, duration time.Duration, comment *string) (*models.Booking, error) {
+	if duration < minSlotDuration || duration > maxSlotDuration {
+		return nil, fmt.Errorf("%w: duration must be between 15 and 240 minutes", ErrInvalidBooking)
+	}
+	if !startsAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: booking must start in the future", ErrInvalidBooking)
+	}
+
+	mentor, err := s.mentorRepo.GetMentorByID(ctx, mentorID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMentorNotFound
+		}
+		return nil, err
+	}
+	if mentor.UserID == menteeID {
+		return nil, fmt.Errorf("%w: mentors cannot book themselves", ErrInvalidBooking)
+	}
+
+	endsAt := startsAt.Add(duration)
+	ok, err := s.availabilityService.IsWithinAvailability(ctx, mentorID, startsAt, endsAt, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrSlotUnavailable
+	}
+
+	booking := &models.Booking{
+		MentorID: mentorID,
+		MenteeID: menteeID,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Comment:  comment,
+	}
+	if err := s.repo.CreateBooking(ctx, booking); err != nil {
+		// Слот успели занять параллельным запросом
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgExclusionViolation {
+			return nil, ErrSlotUnavailable
+		}
+		return nil, err
+	}
+
+	created, err := s.repo.GetBookingByID(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, created.MentorUserID, created, models.NotificationTypeBookingRequested,
+		"Новый запрос на занятие",
+		fmt.Sprintf("%s хочет занятие %s.", created.MenteeName, formatBookingTime(created)))
+
+	return created, nil
+}
+
+// Get возвращает бронирование, если пользователь является его участником
+func (s *BookingService) Get(ctx context.Context, userID, id int) (*models.Booking, error) {
+	booking, err := s.repo.GetBookingByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBookingNotFound
+		}
+		return nil, err
+	}
+	// Чужие бронирования не раскрываем
+	if booking.MenteeID != userID && booking.MentorUserID != userID {
+		return nil, ErrBookingNotFound
+	}
+	return booking, nil
+}
+
+// ListMenteeBookings возвращает страницу бронирований пользователя как ученика
+func (s *BookingService) ListMenteeBookings(ctx context.Context, userID int, status *string, limit, offset int) ([]*models.Booking, int, error) {
+	return s.repo.GetMenteeBookings(ctx, userID, status, limit, offset)
+}
+
+// ListMentorBookings возвращает страницу бронирований пользователя как ментора
+func (s *BookingService) ListMentorBookings(ctx context.Context, userID int, status *string, limit, offset int) ([]*models.Booking, int, error) {
+	mentorID, err := s.mentorRepo.GetMentorIDByUserID(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if mentorID == nil {
+		return nil, 0, ErrNotMentor
+	}
+	return s.repo.GetMentorBookings(ctx, *mentorID, status, limit, offset)
+}
+
+// Confirm подтверждает запрос на занятие. Доступно только ментору.
+func (s *BookingService) Confirm(ctx context.Context, userID, id int) (*models.Booking, error) {
+	booking, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if booking.MentorUserID != userID {
+		return nil, ErrBookingForbidden
+	}
+	// Подтвердить можно только будущее занятие, иначе оно попало бы в календарь и оплату задним числом
+	if !time.Now().Before(booking.StartsAt) {
+		return nil, fmt.Errorf("%w: booking has already started", ErrInvalidBookingTransition)
+	}
+
+	updated, err := s.transition(ctx, booking, models.BookingConfirmed, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, updated.MenteeID, updated, models.NotificationTypeBookingAccepted,
+		"Занятие подтверждено",
+		fmt.Sprintf("%s подтвердил(а) занятие %s.", updated.MentorName, formatBookingTime(updated)))
+
+	return updated, nil
+}
+
+// Cancel отменяет занятие до его начала. Доступно обоим участникам.
+func (s *BookingService) Cancel(ctx context.Context, userID, id int, reason *string) (*models.Booking, error) {
+	booking, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(booking.StartsAt) {
+		return nil, fmt.Errorf("%w: booking has already started", ErrInvalidBookingTransition)
+	}
+
+	updated, err := s.transition(ctx, booking, models.BookingCancelled, &userID, reason)
+	if err != nil {
+		return nil, err
+	}
+	s.paymentService.SettleBooking(ctx, updated)
+
+	// Уведомляем второго участника
+	recipientID, actorName := updated.MentorUserID, updated.MenteeName
+	if userID == updated.MentorUserID {
+		recipientID, actorName = updated.MenteeID, updated.MentorName
+	}
+	body := fmt.Sprintf("%s отменил(а) занятие %s.", actorName, formatBookingTime(updated))
+	if reason != nil && *reason != "" {
+		body += " Причина: " + *reason
+	}
+	s.notify(ctx, recipientID, updated, models.NotificationTypeBookingCancelled, "Занятие отменено", body)
+
+	return updated, nil
+}
+
+// Reschedule переносит занятие на новое время той же длительности. Доступно обоим участникам
+// до начала занятия; новое время должно быть свободно в расписании ментора. Статус не меняется,
+// второй участник получает уведомление, а календари - новую версию события.
+func (s *BookingService) Reschedule(ctx context.Context, userID, id int, startsAt time.Time) (*models.Booking, error) {
+	booking, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if booking.Status != models.BookingRequested && booking.Status != models.BookingConfirmed {
+		return nil, fmt.Errorf("%w: %s booking cannot be rescheduled", ErrInvalidBookingTransition, booking.Status)
+	}
+	if !time.Now().Before(booking.StartsAt) {
+		return nil, fmt.Errorf("%w: booking has already started", ErrInvalidBookingTransition)
+	}
+	if !startsAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: booking must start in the future", ErrInvalidBooking)
+	}
+	if startsAt.Equal(booking.StartsAt) {
+		return booking, nil
+	}
+
+	endsAt := startsAt.Add(booking.EndsAt.Sub(booking.StartsAt))
+	ok, err := s.availabilityService.IsWithinAvailability(ctx, booking.MentorID, startsAt, endsAt, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrSlotUnavailable
+	}
+
+	if err := s.repo.Reschedule(ctx, booking.ID, booking.Status, startsAt, endsAt); err != nil {
+		var pgErr *pgconn.PgError
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, fmt.Errorf("%w: booking status has changed", ErrInvalidBookingTransition)
+		case errors.As(err, &pgErr) && pgErr.Code == pgExclusionViolation:
+			return nil, ErrSlotUnavailable
+		}
+		return nil, err
+	}
+
+	updated, err := s.repo.GetBookingByID(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Уведомляем второго участника
+	recipientID, actorName := updated.MentorUserID, updated.MenteeName
+	if userID == updated.MentorUserID {
+		recipientID, actorName = updated.MenteeID, updated.MentorName
+	}
+	s.notify(ctx, recipientID, updated, models.NotificationTypeBookingRescheduled,
+		"Занятие перенесено",
+		fmt.Sprintf("%s перенес(ла) занятие на %s.", actorName, formatBookingTime(updated)))
+
+	return updated, nil
+}
+
+// Complete отмечает занятие проведенным. Доступно только ментору после начала занятия.
+func (s *BookingService) Complete(ctx context.Context, userID, id int) (*models.Booking, error) {
+	return s.finish(ctx, userID, id, models.BookingCompleted)
+}
+
+// MarkNoShow отмечает, что ученик не пришел на занятие. Доступно только ментору после начала занятия.
+func (s *BookingService) MarkNoShow(ctx context.Context, userID, id int) (*models.Booking, error) {
+	return s.finish(ctx, userID, id, models.BookingNoShow)
+}
+
+// finish завершает подтвержденное занятие с итоговым статусом
+func (s *BookingService) finish(ctx context.Context, userID, id int, status string) (*models.Booking, error) {
+	booking, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if booking.MentorUserID != userID {
+		return nil, ErrBookingForbidden
+	}
+	if time.Now().Before(booking.StartsAt) {
+		return nil, fmt.Errorf("%w: booking has not started yet", ErrInvalidBookingTransition)
+	}
+
+	updated, err := s.transition(ctx, booking, status, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.paymentService.SettleBooking(ctx, updated)
+
+	return updated, nil
+}
+
+// transition переводит бронирование в новый статус с проверкой допустимости перехода.
+// Статус меняется условным UPDATE, поэтому конкурентные изменения не перезаписывают друг друга.
+func (s *BookingService) transition(ctx context.Context, booking *models.Booking, to string, cancelledBy *int, reason *string) (*models.Booking, error) {
+	if !models.CanTransitionBooking(booking.Status, to) {
+		return nil, fmt.Errorf("%w: %s -> %s", ErrInvalidBookingTransition, booking.Status, to)
+	}
+
+	err := s.repo.UpdateStatus(ctx, booking.ID, booking.Status, to, cancelledBy, reason)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: booking status has changed", ErrInvalidBookingTransition)
+		}
+		return nil, err
+	}
+
+	return s.repo.GetBookingByID(ctx, booking.ID)
+}
+
+// notify отправляет участнику уведомление о бронировании.
+// Ошибка уведомления не отменяет изменение бронирования, поэтому только логируется.
+func (s *BookingService) notify(ctx context.Context, userID int, booking *models.Booking, notificationType, title, body string) {
+	payload := map[string]interface{}{
+		"bookingId": booking.ID,
+		"status":    booking.Status,
+		"startsAt":  booking.StartsAt,
+	}
+	if err := s.notificationService.Notify(ctx, userID, notificationType, title, body, payload); err != nil {
+		logger.Error("Failed to send booking notification", zap.Int("booking_id", booking.ID), zap.Error(err))
+	}
+}
+
+// formatBookingTime форматирует время занятия для текста уведомления
+func formatBookingTime(b *models.Booking) string {
+	return b.StartsAt.UTC().Format("02.01.2006 15:04") + " UTC"
+}
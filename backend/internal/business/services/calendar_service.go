@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"strings"
+	"time"
+)
+
+// cancelledFeedWindow - сколько отмененные занятия остаются в ленте, чтобы
+// подписанные календари успели получить отмену и удалить событие
+const cancelledFeedWindow = 30 * 24 * time.Hour
+
+// ErrCalendarFeedNotFound возвращается, если токен ленты календаря не найден
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+// CalendarService формирует iCalendar (RFC 5545) файлы и ленты занятий
+type CalendarService struct {
+	feedRepo       *repositories.CalendarFeedRepository
+	bookingRepo    *repositories.BookingRepository
+	bookingService *BookingService
+}
+
+func NewCalendarService(feedRepo *repositories.CalendarFeedRepository, bookingRepo *repositories.BookingRepository, bookingService *BookingService) *CalendarService {
+	return &CalendarService{
+		feedRepo:       feedRepo,
+		bookingRepo:    bookingRepo,
+		bookingService: bookingService,
+	}
+}
+
+// BookingICS возвращает .ics файл с одним занятием для его участника
+func (s *CalendarService) BookingICS(ctx context.Context, userID, bookingID int) ([]byte, error) {
+	booking, err := s.bookingService.Get(ctx, userID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeCalendar("", userID, []*models.Booking{booking}), nil
+}
+
+// Feed возвращает ленту занятий владельца токена
+func (s *CalendarService) Feed(ctx context.Context, token string) ([]byte, error) {
+	userID, err := s.feedRepo.GetUserIDByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if userID == nil {
+		return nil, ErrCalendarFeedNotFound
+	}
+
+	bookings, err := s.bookingRepo.GetCalendarBookings(ctx, *userID, time.Now().Add(-cancelledFeedWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeCalendar("IT Rabotyagi", *userID, bookings), nil
+}
+
+// RotateFeedToken выпускает новый токен ленты пользователя. Старая ссылка перестает работать.
+func (s *CalendarService) RotateFeedToken(ctx context.Context, userID int) (string, time.Time, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", time.Time{}, err
+	}
+	token := hex.EncodeToString(buf)
+
+	createdAt, err := s.feedRepo.ReplaceToken(ctx, userID, token)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, createdAt, nil
+}
+
+// RevokeFeedToken отключает ленту пользователя
+func (s *CalendarService) RevokeFeedToken(ctx context.Context, userID int) error {
+	return s.feedRepo.DeleteToken(ctx, userID)
+}
+
+// encodeCalendar сериализует занятия в VCALENDAR с точки зрения участника viewerID.
+// Непустое name задает название подписываемого календаря.
+func encodeCalendar(name string, viewerID int, bookings []*models.Booking) []byte {
+	w := &icsWriter{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:-//IT Rabotyagi//Bookings//RU")
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if name != "" {
+		w.prop("X-WR-CALNAME", name)
+	}
+
+	for _, b := range bookings {
+		summary := "Занятие с ментором " + b.MentorName
+		if b.MentorUserID == viewerID {
+			summary = "Занятие с учеником " + b.MenteeName
+		}
+
+		w.line("BEGIN:VEVENT")
+		// UID стабилен, поэтому календари обновляют событие, а не дублируют его
+		w.line(fmt.Sprintf("UID:booking-%d@it-rabotyagi", b.ID))
+		w.line("DTSTAMP:" + icsTime(b.UpdatedAt))
+		w.line("LAST-MODIFIED:" + icsTime(b.UpdatedAt))
+		w.line(fmt.Sprintf("SEQUENCE:%d", b.Sequence))
+		w.line("DTSTART:" + icsTime(b.StartsAt))
+		w.line("DTEND:" + icsTime(b.EndsAt))
+		w.prop("SUMMARY", summary)
+		if b.Comment != nil && *b.Comment != "" {
+			w.prop("DESCRIPTION", *b.Comment)
+		}
+		w.line("STATUS:" + icsStatus(b.Status))
+		w.line("END:VEVENT")
+	}
+
+	w.line("END:VCALENDAR")
+	return []byte(w.String())
+}
+
+// icsStatus сопоставляет статус бронирования статусу события iCalendar
+func icsStatus(status string) string {
+	switch status {
+	case models.BookingRequested:
+		return "TENTATIVE"
+	case models.BookingCancelled:
+		return "CANCELLED"
+	}
+	return "CONFIRMED"
+}
+
+// icsTime форматирует время в UTC в формате iCalendar
+func icsTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// icsWriter собирает содержимое iCalendar с переносом длинных строк и CRLF
+type icsWriter struct {
+	strings.Builder
+}
+
+// prop записывает текстовое свойство с экранированием значения
+func (w *icsWriter) prop(name, value string) {
+	w.line(name + ":" + icsEscape(value))
+}
+
+// line записывает строку контента, перенося ее по 75 октетов согласно RFC 5545.
+// Перенос не разрывает многобайтовые символы UTF-8.
+func (w *icsWriter) line(s string) {
+	const limit = 75
+	octets := 0
+	for _, r := range s {
+		size := len(string(r))
+		if octets+size > limit {
+			w.WriteString("\r\n ")
+			octets = 1
+		}
+		w.WriteRune(r)
+		octets += size
+	}
+	w.WriteString("\r\n")
+}
+
+// icsEscape экранирует текстовое значение iCalendar
+func icsEscape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Параметры дашборда ментора
+const (
+	// dashboardPeriods - сколько последних периодов показывают графики выручки и рейтинга
+	dashboardPeriods = 6
+	// dashboardBookingsLimit - сколько ближайших и прошедших занятий показывает дашборд
+	dashboardBookingsLimit = 10
+)
+
+var (
+	// ErrInvalidDashboardPeriod возвращается при неизвестном периоде агрегации
+	ErrInvalidDashboardPeriod = errors.New("invalid dashboard period")
+	// ErrInvalidProgressSharing возвращается при попытке поделиться прогрессом с самим собой
+	ErrInvalidProgressSharing = errors.New("cannot share progress with yourself")
+)
+
+// DashboardService собирает сводку активности ментора и управляет согласиями учеников
+// на просмотр их прогресса
+type DashboardService struct {
+	mentorRepo   *repositories.MentorRepository
+	bookingRepo  *repositories.BookingRepository
+	reviewRepo   *repositories.ReviewRepository
+	progressRepo *repositories.ProgressRepository
+	paymentRepo  *repositories.PaymentRepository
+}
+
+func NewDashboardService(mentorRepo *repositories.MentorRepository, bookingRepo *repositories.BookingRepository, reviewRepo *repositories.ReviewRepository, progressRepo *repositories.ProgressRepository, paymentRepo *repositories.PaymentRepository) *DashboardService {
+	return &DashboardService{
+		mentorRepo:   mentorRepo,
+		bookingRepo:  bookingRepo,
+		reviewRepo:   reviewRepo,
+		progressRepo: progressRepo,
+		paymentRepo:  paymentRepo,
+	}
+}
+
+// MentorDashboard возвращает сводку активности ментора с графиками по периодам period
+func (s *DashboardService) MentorDashboard(ctx context.Context, userID int, period string) (*models.MentorDashboard, error) {
+	if period != models.DashboardPeriodWeek && period != models.DashboardPeriodMonth {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDashboardPeriod, period)
+	}
+
+	mentorID, err := s.mentorRepo.GetMentorIDByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mentorID == nil {
+		return nil, ErrNotMentor
+	}
+	mentor, err := s.mentorRepo.GetMentorByID(ctx, *mentorID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	starts := periodStarts(period, now, dashboardPeriods)
+	dashboard := &models.MentorDashboard{
+		Period:       period,
+		Rating:       mentor.Rating,
+		ReviewsCount: mentor.ReviewsCount,
+	}
+
+	stats, err := s.bookingRepo.CountMentorBookings(ctx, mentor.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if finished := stats.Completed + stats.NoShow; finished > 0 {
+		completion := float64(stats.Completed) / float64(finished)
+		noShow := float64(stats.NoShow) / float64(finished)
+		stats.CompletionRate, stats.NoShowRate = &completion, &noShow
+	}
+	dashboard.Stats = *stats
+
+	if dashboard.Upcoming, err = s.bookingRepo.GetMentorUpcomingBookings(ctx, mentor.ID, now, dashboardBookingsLimit); err != nil {
+		return nil, err
+	}
+	if dashboard.Recent, err = s.bookingRepo.GetMentorPastBookings(ctx, mentor.ID, now, dashboardBookingsLimit); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillRevenue(ctx, dashboard, mentor, period, starts); err != nil {
+		return nil, err
+	}
+	if err := s.fillRatingTrend(ctx, dashboard, mentor.ID, period, starts); err != nil {
+		return nil, err
+	}
+	if err := s.fillMentees(ctx, dashboard, mentor.ID, now); err != nil {
+		return nil, err
+	}
+
+	return dashboard, nil
+}
+
+// ShareProgress разрешает ментору видеть прогресс ученика в курсах
+func (s *DashboardService) ShareProgress(ctx context.Context, userID, mentorID int) error {
+	mentor, err := s.mentorRepo.GetMentorByID(ctx, mentorID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrMentorNotFound
+		}
+		return err
+	}
+	if mentor.UserID == userID {
+		return ErrInvalidProgressSharing
+	}
+	return s.progressRepo.GrantConsent(ctx, userID, mentorID)
+}
+
+// UnshareProgress отзывает у ментора доступ к прогрессу ученика
+func (s *DashboardService) UnshareProgress(ctx context.Context, userID, mentorID int) error {
+	return s.progressRepo.RevokeConsent(ctx, userID, mentorID)
+}
+
+// fillRevenue считает проведенные занятия и фактическую выручку по периодам: суммы,
+// списанные в пользу ментора по журналу оплат, в валюте его прайс-листа
+func (s *DashboardService) fillRevenue(ctx context.Context, d *models.MentorDashboard, mentor *models.Mentor, period string, starts []time.Time) error {
+	completed, err := s.bookingRepo.GetMentorCompletedBookings(ctx, mentor.ID, starts[0])
+	if err != nil {
+		return err
+	}
+
+	d.Revenue = make([]models.RevenuePoint, len(starts))
+	for i, start := range starts {
+		d.Revenue[i].PeriodStart = start
+	}
+	for _, b := range completed {
+		if i := periodIndex(starts, periodStart(period, b.StartsAt)); i >= 0 {
+			d.Revenue[i].Sessions++
+		}
+	}
+
+	priceRange := mentor.PriceRange()
+	if priceRange == nil {
+		return nil
+	}
+	d.Currency = &priceRange.Currency
+
+	points, err := s.paymentRepo.GetMentorRevenueTrend(ctx, mentor.ID, priceRange.Currency, period, starts[0])
+	if err != nil {
+		return err
+	}
+	for _, p := range points {
+		if i := periodIndex(starts, p.PeriodStart); i >= 0 {
+			d.Revenue[i].Revenue = p.Revenue
+		}
+	}
+	return nil
+}
+
+// fillRatingTrend раскладывает среднюю оценку отзывов по всем периодам графика
+func (s *DashboardService) fillRatingTrend(ctx context.Context, d *models.MentorDashboard, mentorID int, period string, starts []time.Time) error {
+	points, err := s.reviewRepo.GetRatingTrend(ctx, mentorID, period, starts[0])
+	if err != nil {
+		return err
+	}
+
+	d.RatingTrend = make([]models.RatingPoint, len(starts))
+	for i, start := range starts {
+		d.RatingTrend[i].PeriodStart = start
+	}
+	for _, p := range points {
+		if i := periodIndex(starts, p.PeriodStart); i >= 0 {
+			d.RatingTrend[i] = p
+			d.RatingTrend[i].PeriodStart = starts[i]
+		}
+	}
+	return nil
+}
+
+// fillMentees загружает учеников ментора и прогресс тех, кто дал согласие на его просмотр
+func (s *DashboardService) fillMentees(ctx context.Context, d *models.MentorDashboard, mentorID int, now time.Time) error {
+	mentees, err := s.progressRepo.GetMentorMentees(ctx, mentorID, now)
+	if err != nil {
+		return err
+	}
+	d.Mentees = mentees
+
+	var shared []int
+	for _, m := range mentees {
+		if m.ProgressShared {
+			shared = append(shared, m.UserID)
+		}
+	}
+	if len(shared) == 0 {
+		return nil
+	}
+
+	courses, err := s.progressRepo.GetCourseProgress(ctx, shared)
+	if err != nil {
+		return err
+	}
+	answers, err := s.progressRepo.GetAnswerCounts(ctx, shared)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range mentees {
+		if !m.ProgressShared {
+			continue
+		}
+		m.Courses = courses[m.UserID]
+		stats := answers[m.UserID]
+		m.Answers = &stats
+	}
+	return nil
+}
+
+// periodStart возвращает начало периода (недели с понедельника или месяца) в UTC, содержащего t
+func periodStart(period string, t time.Time) time.Time {
+	t = t.UTC()
+	if period == models.DashboardPeriodWeek {
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// periodStarts возвращает начала n последних периодов, заканчивая текущим, по возрастанию
+func periodStarts(period string, now time.Time, n int) []time.Time {
+	current := periodStart(period, now)
+	starts := make([]time.Time, n)
+	for i := 0; i < n; i++ {
+		back := n - 1 - i
+		if period == models.DashboardPeriodWeek {
+			starts[i] = current.AddDate(0, 0, -7*back)
+		} else {
+			starts[i] = current.AddDate(0, -back, 0)
+		}
+	}
+	return starts
+}
+
+// periodIndex возвращает индекс периода с началом start или -1
+func periodIndex(starts []time.Time, start time.Time) int {
+	for i, s := range starts {
+		if s.Equal(start) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,403 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Ограничения событий
+const (
+	eventTitleMaxLength       = 200
+	eventDescriptionMaxLength = 5000
+	eventMaxCapacity          = 500
+	eventMinDuration          = 15 * time.Minute
+	eventMaxDuration          = 8 * time.Hour
+)
+
+var (
+	// ErrEventNotFound возвращается, если событие не найдено
+	ErrEventNotFound = errors.New("event not found")
+	// ErrInvalidEvent возвращается, если событие заполнено некорректно
+	ErrInvalidEvent = errors.New("invalid event")
+	// ErrNotEventHost возвращается, если действие доступно только ведущему события
+	ErrNotEventHost = errors.New("user is not the event host")
+	// ErrEventClosed возвращается, если событие отменено или уже началось
+	ErrEventClosed = errors.New("event is closed")
+	// ErrOwnEvent возвращается при попытке ментора зарегистрироваться на свое событие
+	ErrOwnEvent = errors.New("cannot register for own event")
+	// ErrAlreadyRegistered возвращается при повторной регистрации на событие
+	ErrAlreadyRegistered = errors.New("already registered for event")
+	// ErrNotRegistered возвращается, если пользователь не зарегистрирован на событие
+	ErrNotRegistered = errors.New("not registered for event")
+	// ErrEventNotStarted возвращается при отметке посещения до начала события
+	ErrEventNotStarted = errors.New("event has not started yet")
+)
+
+// EventService отвечает за групповые события менторов: регистрацию, лист ожидания,
+// напоминания и отметку посещения
+type EventService struct {
+	repo                *repositories.EventRepository
+	mentorRepo          *repositories.MentorRepository
+	notificationService *NotificationService
+	// reminderLead - за сколько до начала события участникам приходит напоминание
+	reminderLead time.Duration
+}
+
+func NewEventService(repo *repositories.EventRepository, mentorRepo *repositories.MentorRepository, notificationService *NotificationService, reminderLead time.Duration) *EventService {
+	return &EventService{
+		repo:                repo,
+		mentorRepo:          mentorRepo,
+		notificationService: notificationService,
+		reminderLead:        reminderLead,
+	}
+}
+
+// Create создает событие от имени ментора
+func (s *EventService) Create(ctx context.Context, userID int, e *models.Event) (*models.Event, error) {
+	if !models.IsKnownEventType(e.Type) {
+		return nil, fmt.Errorf("%w: unknown type %q", ErrInvalidEvent, e.Type)
+	}
+	if err := validateEvent(e); err != nil {
+		return nil, err
+	}
+
+	mentorID, err := s.mentorRepo.GetMentorIDByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mentorID == nil {
+		return nil, ErrNotMentor
+	}
+	e.MentorID = *mentorID
+
+	if err := s.repo.CreateEvent(ctx, e); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetEventByID(ctx, e.ID, userID)
+}
+
+// Get возвращает событие вместе с регистрацией пользователя viewerID (0 - аноним)
+func (s *EventService) Get(ctx context.Context, viewerID, id int) (*models.Event, error) {
+	e, err := s.repo.GetEventByID(ctx, id, viewerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEventNotFound
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+// ListUpcoming возвращает страницу запланированных событий
+func (s *EventService) ListUpcoming(ctx context.Context, viewerID int, filter models.EventFilter, limit, offset int) ([]*models.Event, int, error) {
+	if filter.Type != nil && !models.IsKnownEventType(*filter.Type) {
+		return nil, 0, fmt.Errorf("%w: unknown type %q", ErrInvalidEvent, *filter.Type)
+	}
+	return s.repo.ListUpcomingEvents(ctx, filter, viewerID, limit, offset)
+}
+
+// ListHosted возвращает страницу событий, которые проводит ментор
+func (s *EventService) ListHosted(ctx context.Context, userID, limit, offset int) ([]*models.Event, int, error) {
+	mentorID, err := s.mentorRepo.GetMentorIDByUserID(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if mentorID == nil {
+		return nil, 0, ErrNotMentor
+	}
+	return s.repo.GetMentorEvents(ctx, *mentorID, limit, offset)
+}
+
+// ListUserEvents возвращает страницу событий, на которые зарегистрирован пользователь
+func (s *EventService) ListUserEvents(ctx context.Context, userID, limit, offset int) ([]*models.Event, int, error) {
+	return s.repo.GetUserEvents(ctx, userID, limit, offset)
+}
+
+// Update изменяет событие ведущим. При увеличении вместимости участники из листа ожидания
+// переводятся на освободившиеся места, при переносе все зарегистрированные получают уведомление.
+func (s *EventService) Update(ctx context.Context, userID, id int, input *models.Event) (*models.Event, error) {
+	current, err := s.getHosted(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != models.EventScheduled || !current.StartsAt.After(time.Now()) {
+		return nil, ErrEventClosed
+	}
+	if err := validateEvent(input); err != nil {
+		return nil, err
+	}
+
+	input.ID = id
+	promoted, err := s.repo.UpdateEvent(ctx, input)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		// Событие отменили параллельно, либо вместимость меньше числа участников
+		latest, getErr := s.Get(ctx, userID, id)
+		if getErr != nil {
+			return nil, getErr
+		}
+		if latest.Status != models.EventScheduled {
+			return nil, ErrEventClosed
+		}
+		return nil, fmt.Errorf("%w: capacity is less than the number of registered participants", ErrInvalidEvent)
+	}
+
+	updated, err := s.repo.GetEventByID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyPromoted(ctx, updated, promoted)
+	if !updated.StartsAt.Equal(current.StartsAt) {
+		body := fmt.Sprintf("Событие «%s» перенесено на %s.", updated.Title, formatEventTime(updated))
+		s.notifyParticipants(ctx, updated, models.NotificationTypeEventRescheduled, "Событие перенесено", body,
+			models.EventRegistrationRegistered, models.EventRegistrationWaitlisted)
+	}
+
+	return updated, nil
+}
+
+// Cancel отменяет событие ведущим и уведомляет всех зарегистрированных
+func (s *EventService) Cancel(ctx context.Context, userID, id int) (*models.Event, error) {
+	if _, err := s.getHosted(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CancelEvent(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEventClosed
+		}
+		return nil, err
+	}
+
+	e, err := s.repo.GetEventByID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("Ментор отменил событие «%s», запланированное на %s.", e.Title, formatEventTime(e))
+	s.notifyParticipants(ctx, e, models.NotificationTypeEventCancelled, "Событие отменено", body,
+		models.EventRegistrationRegistered, models.EventRegistrationWaitlisted)
+
+	return e, nil
+}
+
+// Register регистрирует пользователя на событие или ставит в лист ожидания, если мест нет
+func (s *EventService) Register(ctx context.Context, userID, id int) (*models.Event, error) {
+	e, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.HostUserID == userID {
+		return nil, ErrOwnEvent
+	}
+	if e.ViewerStatus != nil {
+		return nil, ErrAlreadyRegistered
+	}
+	if e.Status != models.EventScheduled || !e.StartsAt.After(time.Now()) {
+		return nil, ErrEventClosed
+	}
+
+	if err := s.repo.Register(ctx, id, userID); err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		// Параллельный запрос успел зарегистрировать пользователя или закрыть событие
+		latest, getErr := s.Get(ctx, userID, id)
+		if getErr != nil {
+			return nil, getErr
+		}
+		if latest.ViewerStatus != nil {
+			return nil, ErrAlreadyRegistered
+		}
+		return nil, ErrEventClosed
+	}
+
+	return s.repo.GetEventByID(ctx, id, userID)
+}
+
+// CancelRegistration отменяет регистрацию пользователя; освободившееся место
+// получает первый из листа ожидания
+func (s *EventService) CancelRegistration(ctx context.Context, userID, id int) error {
+	e, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if e.ViewerStatus == nil {
+		return ErrNotRegistered
+	}
+	if !e.StartsAt.After(time.Now()) {
+		return ErrEventClosed
+	}
+
+	promoted, err := s.repo.CancelRegistration(ctx, id, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotRegistered
+		}
+		return err
+	}
+
+	s.notifyPromoted(ctx, e, promoted)
+	return nil
+}
+
+// ListParticipants возвращает участников и лист ожидания события его ведущему
+func (s *EventService) ListParticipants(ctx context.Context, userID, id int) ([]*models.EventRegistration, error) {
+	if _, err := s.getHosted(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRegistrations(ctx, id)
+}
+
+// MarkAttendance отмечает ведущим, пришел ли участник на начавшееся событие
+func (s *EventService) MarkAttendance(ctx context.Context, userID, id, participantID int, attended bool) (*models.EventRegistration, error) {
+	e, err := s.getHosted(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.Status != models.EventScheduled {
+		return nil, ErrEventClosed
+	}
+	if e.StartsAt.After(time.Now()) {
+		return nil, ErrEventNotStarted
+	}
+
+	reg, err := s.repo.MarkAttendance(ctx, id, participantID, attended)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotRegistered
+		}
+		return nil, err
+	}
+	return reg, nil
+}
+
+// SendReminders рассылает напоминания участникам и ведущим событий, которые начнутся
+// в ближайшие reminderLead. Напоминание по каждому событию отправляется один раз.
+func (s *EventService) SendReminders(ctx context.Context) error {
+	events, err := s.repo.ClaimDueReminders(ctx, time.Now().Add(s.reminderLead))
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		body := fmt.Sprintf("Событие «%s» начнется %s.", e.Title, formatEventTime(e))
+		s.notifyParticipants(ctx, e, models.NotificationTypeEventReminder, "Напоминание о событии", body,
+			models.EventRegistrationRegistered)
+		s.notify(ctx, e.HostUserID, e, models.NotificationTypeEventReminder, "Напоминание о вашем событии",
+			fmt.Sprintf("%s Зарегистрировано участников: %d из %d.", body, e.RegisteredCount, e.Capacity))
+	}
+	return nil
+}
+
+// RunReminders периодически вызывает SendReminders, пока не отменен ctx.
+// Запускается в отдельной горутине при старте приложения.
+func (s *EventService) RunReminders(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.SendReminders(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("Failed to send event reminders", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// getHosted возвращает событие, если пользователь является его ведущим
+func (s *EventService) getHosted(ctx context.Context, userID, id int) (*models.Event, error) {
+	e, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.HostUserID != userID {
+		return nil, ErrNotEventHost
+	}
+	return e, nil
+}
+
+// notifyPromoted уведомляет пользователей, переведенных из листа ожидания в участники
+func (s *EventService) notifyPromoted(ctx context.Context, e *models.Event, userIDs []int) {
+	body := fmt.Sprintf("Освободилось место: вы участник события «%s», которое начнется %s.", e.Title, formatEventTime(e))
+	for _, userID := range userIDs {
+		s.notify(ctx, userID, e, models.NotificationTypeEventPromoted, "Вы в списке участников", body)
+	}
+}
+
+// notifyParticipants уведомляет всех пользователей с регистрацией в одном из статусов statuses
+func (s *EventService) notifyParticipants(ctx context.Context, e *models.Event, notificationType, title, body string, statuses ...string) {
+	userIDs, err := s.repo.GetParticipantIDs(ctx, e.ID, statuses...)
+	if err != nil {
+		logger.Error("Failed to load event participants", zap.Int("event_id", e.ID), zap.Error(err))
+		return
+	}
+	for _, userID := range userIDs {
+		s.notify(ctx, userID, e, notificationType, title, body)
+	}
+}
+
+// notify отправляет пользователю уведомление о событии.
+// Ошибка уведомления не отменяет изменение события, поэтому только логируется.
+func (s *EventService) notify(ctx context.Context, userID int, e *models.Event, notificationType, title, body string) {
+	payload := map[string]interface{}{
+		"eventId":  e.ID,
+		"status":   e.Status,
+		"startsAt": e.StartsAt,
+	}
+	if err := s.notificationService.Notify(ctx, userID, notificationType, title, body, payload); err != nil {
+		logger.Error("Failed to send event notification", zap.Int("event_id", e.ID), zap.Int("user_id", userID), zap.Error(err))
+	}
+}
+
+// validateEvent нормализует и проверяет редактируемые поля события
+func validateEvent(e *models.Event) error {
+	e.Title = strings.TrimSpace(e.Title)
+	if e.Title == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalidEvent)
+	}
+	if utf8.RuneCountInString(e.Title) > eventTitleMaxLength {
+		return fmt.Errorf("%w: title must be at most %d characters", ErrInvalidEvent, eventTitleMaxLength)
+	}
+
+	description, err := normalizeOptionalText(e.Description, eventDescriptionMaxLength)
+	if err != nil {
+		return fmt.Errorf("%w: description %v", ErrInvalidEvent, err)
+	}
+	e.Description = description
+
+	if e.Capacity < 1 || e.Capacity > eventMaxCapacity {
+		return fmt.Errorf("%w: capacity must be between 1 and %d", ErrInvalidEvent, eventMaxCapacity)
+	}
+	if !e.StartsAt.After(time.Now()) {
+		return fmt.Errorf("%w: startsAt must be in the future", ErrInvalidEvent)
+	}
+	duration := e.EndsAt.Sub(e.StartsAt)
+	if duration < eventMinDuration || duration > eventMaxDuration {
+		return fmt.Errorf("%w: duration must be between %s and %s", ErrInvalidEvent, eventMinDuration, eventMaxDuration)
+	}
+	return nil
+}
+
+// formatEventTime форматирует время начала события для текста уведомления
+func formatEventTime(e *models.Event) string {
+	return e.StartsAt.UTC().Format("02.01.2006 15:04") + " UTC"
+}
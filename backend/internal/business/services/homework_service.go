@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// pgForeignKeyViolation - код ошибки PostgreSQL при ссылке на несуществующую запись
+const pgForeignKeyViolation = "23503"
+
+// Ограничения домашнего задания
+const (
+	maxHomeworkTitleLength   = 200
+	maxHomeworkCommentLength = 4000
+	maxHomeworkItems         = 50
+)
+
+var (
+	// ErrHomeworkNotFound возвращается, если задание не найдено или недоступно пользователю
+	ErrHomeworkNotFound = errors.New("homework not found")
+	// ErrHomeworkForbidden возвращается, если пользователь не может выдать или удалить задание
+	ErrHomeworkForbidden = errors.New("homework action is not allowed for this user")
+	// ErrInvalidHomework возвращается, если задание заполнено некорректно
+	ErrInvalidHomework = errors.New("invalid homework")
+)
+
+// HomeworkInput - содержимое нового домашнего задания
+type HomeworkInput struct {
+	Title       string
+	Comment     *string
+	DueAt       *time.Time
+	QuestionIDs []int
+	ModuleIDs   []int
+}
+
+// HomeworkService отвечает за домашние задания, которые ментор выдает ученику по занятию.
+// Выполнение отслеживается по ответам ученика на вопросы (user_question_progress).
+type HomeworkService struct {
+	repo                *repositories.HomeworkRepository
+	bookingService      *BookingService
+	mentorRepo          *repositories.MentorRepository
+	notificationService *NotificationService
+}
+
+func NewHomeworkService(repo *repositories.HomeworkRepository, bookingService *BookingService, mentorRepo *repositories.MentorRepository, notificationService *NotificationService) *HomeworkService {
+	return &HomeworkService{
+		repo:                repo,
+		bookingService:      bookingService,
+		mentorRepo:          mentorRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Assign выдает ученику домашнее задание по занятию. Доступно только ментору занятия.
+func (s *HomeworkService) Assign(ctx context.Context, userID, bookingID int, input HomeworkInput) (*models.HomeworkAssignment, error) {
+	title := strings.TrimSpace(input.Title)
+	if title == "" {
+		return nil, fmt.Errorf("%w: title is required", ErrInvalidHomework)
+	}
+	if utf8.RuneCountInString(title) > maxHomeworkTitleLength {
+		return nil, fmt.Errorf("%w: title must be at most %d characters", ErrInvalidHomework, maxHomeworkTitleLength)
+	}
+	comment, err := normalizeOptionalText(input.Comment, maxHomeworkCommentLength)
+	if err != nil {
+		return nil, fmt.Errorf("%w: comment %v", ErrInvalidHomework, err)
+	}
+	if input.DueAt != nil && !input.DueAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: dueAt must be in the future", ErrInvalidHomework)
+	}
+
+	questionIDs, err := uniqueIDs(input.QuestionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: questionIds %v", ErrInvalidHomework, err)
+	}
+	moduleIDs, err := uniqueIDs(input.ModuleIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: moduleIds %v", ErrInvalidHomework, err)
+	}
+	items := len(questionIDs) + len(moduleIDs)
+	if items == 0 {
+		return nil, fmt.Errorf("%w: at least one question or module is required", ErrInvalidHomework)
+	}
+	if items > maxHomeworkItems {
+		return nil, fmt.Errorf("%w: at most %d questions and modules", ErrInvalidHomework, maxHomeworkItems)
+	}
+
+	booking, err := s.bookingService.Get(ctx, userID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.MentorUserID != userID {
+		return nil, ErrHomeworkForbidden
+	}
+	if booking.Status == models.BookingCancelled {
+		return nil, fmt.Errorf("%w: session is cancelled", ErrHomeworkForbidden)
+	}
+
+	assignment := &models.HomeworkAssignment{
+		BookingID: booking.ID,
+		MentorID:  booking.MentorID,
+		MenteeID:  booking.MenteeID,
+		Title:     title,
+		Comment:   comment,
+		DueAt:     input.DueAt,
+	}
+	if err := s.repo.CreateAssignment(ctx, assignment, questionIDs, moduleIDs); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return nil, fmt.Errorf("%w: unknown question or module", ErrInvalidHomework)
+		}
+		return nil, err
+	}
+
+	created, err := s.repo.GetAssignmentByID(ctx, assignment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"homeworkId": created.ID,
+		"bookingId":  created.BookingID,
+	}
+	err = s.notificationService.Notify(ctx, created.MenteeID, models.NotificationTypeHomeworkAssigned,
+		"Новое домашнее задание",
+		fmt.Sprintf("%s выдал(а) задание «%s».", created.MentorName, created.Title),
+		payload)
+	if err != nil {
+		// Ошибка уведомления не отменяет задание
+		logger.Error("Failed to send homework notification", zap.Int("homework_id", created.ID), zap.Error(err))
+	}
+
+	return created, nil
+}
+
+// Get возвращает задание, если пользователь - его ментор или ученик
+func (s *HomeworkService) Get(ctx context.Context, userID, id int) (*models.HomeworkAssignment, error) {
+	assignment, err := s.repo.GetAssignmentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrHomeworkNotFound
+		}
+		return nil, err
+	}
+	// Чужие задания не раскрываем
+	if !assignment.IsParticipant(userID) {
+		return nil, ErrHomeworkNotFound
+	}
+	return assignment, nil
+}
+
+// ListBookingHomework возвращает задания по занятию для его участника
+func (s *HomeworkService) ListBookingHomework(ctx context.Context, userID, bookingID int) ([]*models.HomeworkAssignment, error) {
+	if _, err := s.bookingService.Get(ctx, userID, bookingID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetBookingAssignments(ctx, bookingID)
+}
+
+// ListMenteeHomework возвращает страницу заданий, выданных пользователю
+func (s *HomeworkService) ListMenteeHomework(ctx context.Context, userID, limit, offset int) ([]*models.HomeworkAssignment, int, error) {
+	return s.repo.GetMenteeAssignments(ctx, userID, limit, offset)
+}
+
+// ListMentorHomework возвращает страницу заданий, выданных текущим ментором
+func (s *HomeworkService) ListMentorHomework(ctx context.Context, userID, limit, offset int) ([]*models.HomeworkAssignment, int, error) {
+	mentorID, err := s.mentorRepo.GetMentorIDByUserID(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if mentorID == nil {
+		return nil, 0, ErrNotMentor
+	}
+	return s.repo.GetMentorAssignments(ctx, *mentorID, limit, offset)
+}
+
+// Delete удаляет задание. Доступно только ментору, который его выдал.
+func (s *HomeworkService) Delete(ctx context.Context, userID, id int) error {
+	assignment, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if assignment.MentorUserID != userID {
+		return ErrHomeworkForbidden
+	}
+	return s.repo.DeleteAssignment(ctx, id)
+}
+
+// uniqueIDs проверяет, что идентификаторы положительны, и убирает повторы с сохранением порядка
+func uniqueIDs(ids []int) ([]int, error) {
+	seen := make(map[int]bool, len(ids))
+	result := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, errors.New("must be positive")
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result, nil
+}
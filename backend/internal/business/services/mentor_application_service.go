@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrApplicationNotFound возвращается, если заявка на менторство не найдена
+	ErrApplicationNotFound = errors.New("mentor application not found")
+	// ErrApplicationPending возвращается, если у пользователя уже есть заявка на рассмотрении
+	ErrApplicationPending = errors.New("mentor application is already pending")
+	// ErrApplicationReviewed возвращается при повторном рассмотрении заявки
+	ErrApplicationReviewed = errors.New("mentor application is already reviewed")
+	// ErrAlreadyMentor возвращается, если пользователь уже является ментором
+	ErrAlreadyMentor = errors.New("user is already a mentor")
+	// ErrInvalidApplication возвращается, если заявка заполнена некорректно
+	ErrInvalidApplication = errors.New("invalid mentor application")
+)
+
+// MentorApplicationService отвечает за подачу заявок на менторство и их модерацию
+type MentorApplicationService struct {
+	repo                *repositories.MentorApplicationRepository
+	mentorRepo          *repositories.MentorRepository
+	notificationService *NotificationService
+}
+
+func NewMentorApplicationService(repo *repositories.MentorApplicationRepository, mentorRepo *repositories.MentorRepository, notificationService *NotificationService) *MentorApplicationService {
+	return &MentorApplicationService{
+		repo:                repo,
+		mentorRepo:          mentorRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Submit подает заявку на менторство от имени пользователя и возвращает сохраненную заявку
+func (s *MentorApplicationService) Submit(ctx context.Context, app *models.MentorApplication) (*models.MentorApplication, error) {
+	app.Specialization = strings.TrimSpace(app.Specialization)
+	if app.Specialization == "" {
+		return nil, fmt.Errorf("%w: specialization is required", ErrInvalidApplication)
+	}
+	if err := validateMentorDetails(app.ExperienceYears, app.Contacts, app.Pricelist); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidApplication, err)
+	}
+	tags, err := normalizeMentorTags(app.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidApplication, err)
+	}
+	app.Tags = tags
+
+	mentorID, err := s.mentorRepo.GetMentorIDByUserID(ctx, app.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if mentorID != nil {
+		return nil, ErrAlreadyMentor
+	}
+
+	pending, err := s.repo.HasPendingApplication(ctx, app.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, ErrApplicationPending
+	}
+
+	if err := s.repo.CreateApplication(ctx, app); err != nil {
+		// Параллельная заявка могла появиться после проверки выше
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			return nil, ErrApplicationPending
+		}
+		return nil, err
+	}
+
+	// Перечитываем заявку, чтобы вернуть ее вместе с именем заявителя
+	return s.repo.GetApplicationByID(ctx, app.ID)
+}
+
+// ListUserApplications возвращает заявки пользователя
+func (s *MentorApplicationService) ListUserApplications(ctx context.Context, userID int) ([]*models.MentorApplication, error) {
+	return s.repo.GetUserApplications(ctx, userID)
+}
+
+// ListQueue возвращает страницу очереди модерации с заявками в указанном статусе
+func (s *MentorApplicationService) ListQueue(ctx context.Context, status string, limit, offset int) ([]*models.MentorApplication, int, error) {
+	return s.repo.GetApplicationsByStatus(ctx, status, limit, offset)
+}
+
+// Approve одобряет заявку, создает профиль ментора и уведомляет заявителя
+func (s *MentorApplicationService) Approve(ctx context.Context, id, reviewerID int, comment *string) (*models.MentorApplication, error) {
+	if err := s.repo.ApproveApplication(ctx, id, reviewerID, comment); err != nil {
+		return nil, s.reviewError(ctx, id, err)
+	}
+
+	app, err := s.repo.GetApplicationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body := "Поздравляем! Ваша заявка на менторство одобрена, профиль ментора опубликован в каталоге."
+	s.notifyApplicant(ctx, app, models.NotificationTypeMentorApplicationApproved, "Заявка на менторство одобрена", body)
+
+	return app, nil
+}
+
+// Reject отклоняет заявку и уведомляет заявителя
+func (s *MentorApplicationService) Reject(ctx context.Context, id, reviewerID int, comment *string) (*models.MentorApplication, error) {
+	if err := s.repo.RejectApplication(ctx, id, reviewerID, comment); err != nil {
+		return nil, s.reviewError(ctx, id, err)
+	}
+
+	app, err := s.repo.GetApplicationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body := "К сожалению, ваша заявка на менторство отклонена."
+	if comment != nil && *comment != "" {
+		body += " Комментарий модератора: " + *comment
+	}
+	s.notifyApplicant(ctx, app, models.NotificationTypeMentorApplicationRejected, "Заявка на менторство отклонена", body)
+
+	return app, nil
+}
+
+// reviewError определяет, почему заявку не удалось рассмотреть
+func (s *MentorApplicationService) reviewError(ctx context.Context, id int, err error) error {
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	// Заявка либо не существует, либо уже рассмотрена другим модератором
+	if _, getErr := s.repo.GetApplicationByID(ctx, id); getErr != nil {
+		if errors.Is(getErr, pgx.ErrNoRows) {
+			return ErrApplicationNotFound
+		}
+		return getErr
+	}
+	return ErrApplicationReviewed
+}
+
+// notifyApplicant отправляет заявителю уведомление о решении по заявке.
+// Ошибка уведомления не отменяет уже принятое решение, поэтому только логируется.
+func (s *MentorApplicationService) notifyApplicant(ctx context.Context, app *models.MentorApplication, notificationType, title, body string) {
+	payload := map[string]interface{}{
+		"applicationId": app.ID,
+		"status":        app.Status,
+	}
+	if err := s.notificationService.Notify(ctx, app.UserID, notificationType, title, body, payload); err != nil {
+		logger.Error("Failed to notify mentor applicant", zap.Int("application_id", app.ID), zap.Error(err))
+	}
+}
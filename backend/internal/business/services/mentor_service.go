@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	// ErrMentorNotFound возвращается, если ментор не найден
+	ErrMentorNotFound = errors.New("mentor not found")
+	// ErrNotMentor возвращается, если у пользователя нет профиля ментора
+	ErrNotMentor = errors.New("user is not a mentor")
+	// ErrInvalidMentorProfile возвращается, если профиль ментора заполнен некорректно
+	ErrInvalidMentorProfile = errors.New("invalid mentor profile")
+	// ErrInvalidMentorFilter возвращается, если условия поиска менторов заданы некорректно
+	ErrInvalidMentorFilter = errors.New("invalid mentor filter")
+)
+
+// Ограничения технологий ментора
+const (
+	maxMentorTags      = 20
+	maxMentorTagLength = 50
+)
+
+// MentorService отвечает за каталог менторов
+type MentorService struct {
+	repo *repositories.MentorRepository
+}
+
+func NewMentorService(repo *repositories.MentorRepository) *MentorService {
+	return &MentorService{repo: repo}
+}
+
+// ListMentors возвращает страницу каталога менторов и общее количество подходящих под фильтр.
+// Технологии сравниваются без учета регистра, границы цены имеют смысл только вместе с валютой.
+func (s *MentorService) ListMentors(ctx context.Context, filter models.MentorFilter, limit, offset int) ([]*models.Mentor, int, error) {
+	if (filter.PriceMin != nil || filter.PriceMax != nil) && (filter.Currency == nil || *filter.Currency == "") {
+		return nil, 0, fmt.Errorf("%w: priceMin and priceMax require currency", ErrInvalidMentorFilter)
+	}
+	filter.Tags = lowerUnique(filter.Tags)
+	return s.repo.ListMentors(ctx, filter, limit, offset)
+}
+
+// GetMentor возвращает полный профиль ментора
+func (s *MentorService) GetMentor(ctx context.Context, id int) (*models.Mentor, error) {
+	mentor, err := s.repo.GetMentorByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMentorNotFound
+		}
+		return nil, err
+	}
+	return mentor, nil
+}
+
+// UpdateOwnProfile обновляет карточку ментора, принадлежащую пользователю
+func (s *MentorService) UpdateOwnProfile(ctx context.Context, userID int, update *models.Mentor) (*models.Mentor, error) {
+	mentorID, err := s.repo.GetMentorIDByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mentorID == nil {
+		return nil, ErrNotMentor
+	}
+
+	update.Specialization = strings.TrimSpace(update.Specialization)
+	if update.Specialization == "" {
+		return nil, fmt.Errorf("%w: specialization is required", ErrInvalidMentorProfile)
+	}
+	if err := validateMentorDetails(update.ExperienceYears, update.Contacts, update.Pricelist); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMentorProfile, err)
+	}
+	languages, err := normalizeLanguages(update.Languages)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMentorProfile, err)
+	}
+	update.Languages = languages
+	tags, err := normalizeMentorTags(update.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMentorProfile, err)
+	}
+	update.Tags = tags
+
+	update.ID = *mentorID
+	if err := s.repo.UpdateMentorProfile(ctx, update); err != nil {
+		return nil, err
+	}
+
+	return s.GetMentor(ctx, *mentorID)
+}
+
+// validateMentorDetails проверяет стаж, контакты и прайс-лист ментора
+// и приводит их к каноничному виду
+func validateMentorDetails(experienceYears *int, contacts []models.MentorContact, pricelist []models.MentorPriceItem) error {
+	if experienceYears != nil && *experienceYears < 0 {
+		return errors.New("experience must not be negative")
+	}
+
+	seen := make(map[string]bool, len(contacts))
+	for i := range contacts {
+		c := &contacts[i]
+		c.Type = strings.ToLower(strings.TrimSpace(c.Type))
+		c.Value = strings.TrimSpace(c.Value)
+		if !models.IsKnownContactType(c.Type) {
+			return fmt.Errorf("unknown contact type %q", c.Type)
+		}
+		if c.Value == "" {
+			return fmt.Errorf("contact %q must have a value", c.Type)
+		}
+		if seen[c.Type] {
+			return fmt.Errorf("contact %q is specified more than once", c.Type)
+		}
+		seen[c.Type] = true
+	}
+
+	for i := range pricelist {
+		item := &pricelist[i]
+		item.Title = strings.TrimSpace(item.Title)
+		item.Currency = strings.ToUpper(strings.TrimSpace(item.Currency))
+		if item.Title == "" {
+			return fmt.Errorf("pricelist item %d must have a title", i+1)
+		}
+		if item.Price < 0 {
+			return fmt.Errorf("pricelist item %q has a negative price", item.Title)
+		}
+		if !models.IsKnownCurrency(item.Currency) {
+			return fmt.Errorf("pricelist item %q has unknown currency %q", item.Title, item.Currency)
+		}
+		// Диапазон цен в карточке строится по одной валюте
+		if item.Currency != pricelist[0].Currency {
+			return errors.New("all pricelist items must use the same currency")
+		}
+		if item.DurationMinutes != nil && *item.DurationMinutes <= 0 {
+			return fmt.Errorf("pricelist item %q must have a positive duration", item.Title)
+		}
+	}
+
+	return nil
+}
+
+// normalizeLanguages приводит коды языков ISO 639-1 к нижнему регистру и убирает повторы
+func normalizeLanguages(languages []string) ([]string, error) {
+	result := make([]string, 0, len(languages))
+	seen := make(map[string]bool, len(languages))
+	for _, lang := range languages {
+		code := strings.ToLower(strings.TrimSpace(lang))
+		if len(code) != 2 || code[0] < 'a' || code[0] > 'z' || code[1] < 'a' || code[1] > 'z' {
+			return nil, fmt.Errorf("invalid language code %q", lang)
+		}
+		if !seen[code] {
+			seen[code] = true
+			result = append(result, code)
+		}
+	}
+	return result, nil
+}
+
+// normalizeMentorTags приводит технологии ментора к нижнему регистру, убирает пустые значения
+// и повторы; в таком виде по ним фильтруется каталог
+func normalizeMentorTags(tags []string) ([]string, error) {
+	result := lowerUnique(tags)
+	if len(result) > maxMentorTags {
+		return nil, fmt.Errorf("at most %d tags are allowed", maxMentorTags)
+	}
+	for _, tag := range result {
+		if utf8.RuneCountInString(tag) > maxMentorTagLength {
+			return nil, fmt.Errorf("tag %q is longer than %d characters", tag, maxMentorTagLength)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Ограничения подтверждений ментора
+const (
+	// verificationTitleMaxLength - максимальная длина утверждения
+	verificationTitleMaxLength = 200
+	// verificationDetailsMaxLength - максимальная длина пояснения к доказательству
+	verificationDetailsMaxLength = 2000
+	// verificationURLMaxLength - максимальная длина ссылки на доказательство
+	verificationURLMaxLength = 2048
+	// verificationMaxPending - сколько подтверждений ментор может одновременно держать на рассмотрении
+	verificationMaxPending = 10
+	// verificationValidity - срок действия подтверждения, если модератор не указал свой
+	verificationValidity = 365 * 24 * time.Hour
+)
+
+var (
+	// ErrVerificationNotFound возвращается, если подтверждение не найдено
+	ErrVerificationNotFound = errors.New("mentor verification not found")
+	// ErrInvalidVerification возвращается, если утверждение или доказательство заполнены некорректно
+	ErrInvalidVerification = errors.New("invalid mentor verification")
+	// ErrVerificationReviewed возвращается при повторном рассмотрении подтверждения
+	ErrVerificationReviewed = errors.New("mentor verification is already reviewed")
+	// ErrVerificationNotActive возвращается при отзыве неподтвержденного или истекшего подтверждения
+	ErrVerificationNotActive = errors.New("mentor verification is not active")
+	// ErrTooManyPendingVerifications возвращается при превышении числа подтверждений на рассмотрении
+	ErrTooManyPendingVerifications = errors.New("too many pending mentor verifications")
+)
+
+// MentorVerificationService отвечает за подачу доказательств опыта ментора и их проверку модераторами
+type MentorVerificationService struct {
+	repo                *repositories.MentorVerificationRepository
+	mentorRepo          *repositories.MentorRepository
+	notificationService *NotificationService
+}
+
+func NewMentorVerificationService(repo *repositories.MentorVerificationRepository, mentorRepo *repositories.MentorRepository, notificationService *NotificationService) *MentorVerificationService {
+	return &MentorVerificationService{
+		repo:                repo,
+		mentorRepo:          mentorRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Submit отправляет утверждение ментора с доказательством на проверку
+func (s *MentorVerificationService) Submit(ctx context.Context, userID int, v *models.MentorVerification) (*models.MentorVerification, error) {
+	if err := validateVerification(v); err != nil {
+		return nil, err
+	}
+
+	mentorID, err := s.mentorID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	v.MentorID = mentorID
+
+	pending, err := s.repo.CountPendingVerifications(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	if pending >= verificationMaxPending {
+		return nil, ErrTooManyPendingVerifications
+	}
+
+	if err := s.repo.CreateVerification(ctx, v); err != nil {
+		return nil, err
+	}
+
+	// Перечитываем подтверждение, чтобы вернуть его вместе с именем ментора
+	return s.repo.GetVerificationByID(ctx, v.ID)
+}
+
+// ListOwn возвращает все подтверждения текущего ментора
+func (s *MentorVerificationService) ListOwn(ctx context.Context, userID int) ([]*models.MentorVerification, error) {
+	mentorID, err := s.mentorID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetMentorVerifications(ctx, mentorID)
+}
+
+// Withdraw удаляет подтверждение текущего ментора, пока оно не рассмотрено
+func (s *MentorVerificationService) Withdraw(ctx context.Context, userID, id int) error {
+	mentorID, err := s.mentorID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeletePendingVerification(ctx, id, mentorID)
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	// Чужие подтверждения не раскрываем, рассмотренные удалять нельзя
+	v, getErr := s.repo.GetVerificationByID(ctx, id)
+	if getErr != nil {
+		if errors.Is(getErr, pgx.ErrNoRows) {
+			return ErrVerificationNotFound
+		}
+		return getErr
+	}
+	if v.MentorID != mentorID {
+		return ErrVerificationNotFound
+	}
+	return ErrVerificationReviewed
+}
+
+// ListQueue возвращает страницу очереди модерации с подтверждениями в указанном статусе
+func (s *MentorVerificationService) ListQueue(ctx context.Context, status string, limit, offset int) ([]*models.MentorVerification, int, error) {
+	return s.repo.GetVerificationsByStatus(ctx, status, limit, offset)
+}
+
+// Verify подтверждает утверждение ментора до expiresAt (по умолчанию на verificationValidity)
+// и уведомляет ментора
+func (s *MentorVerificationService) Verify(ctx context.Context, id, reviewerID int, comment *string, expiresAt *time.Time) (*models.MentorVerification, error) {
+	until := time.Now().Add(verificationValidity)
+	if expiresAt != nil {
+		if !expiresAt.After(time.Now()) {
+			return nil, fmt.Errorf("%w: expiresAt must be in the future", ErrInvalidVerification)
+		}
+		until = *expiresAt
+	}
+
+	if err := s.repo.VerifyVerification(ctx, id, reviewerID, comment, until); err != nil {
+		return nil, s.reviewError(ctx, id, err, ErrVerificationReviewed)
+	}
+
+	v, err := s.repo.GetVerificationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("Модератор подтвердил «%s». Значок появится в вашей карточке ментора.", v.Title)
+	s.notifyMentor(ctx, v, models.NotificationTypeVerificationApproved, "Подтверждение одобрено", body)
+
+	return v, nil
+}
+
+// Reject отклоняет утверждение ментора и уведомляет его
+func (s *MentorVerificationService) Reject(ctx context.Context, id, reviewerID int, comment *string) (*models.MentorVerification, error) {
+	if err := s.repo.RejectVerification(ctx, id, reviewerID, comment); err != nil {
+		return nil, s.reviewError(ctx, id, err, ErrVerificationReviewed)
+	}
+
+	v, err := s.repo.GetVerificationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("Модератор не смог подтвердить «%s».", v.Title)
+	if comment != nil && *comment != "" {
+		body += " Комментарий модератора: " + *comment
+	}
+	s.notifyMentor(ctx, v, models.NotificationTypeVerificationRejected, "Подтверждение отклонено", body)
+
+	return v, nil
+}
+
+// Revoke отзывает действующее подтверждение, снимая значок с карточки ментора
+func (s *MentorVerificationService) Revoke(ctx context.Context, id, reviewerID int, reason string) (*models.MentorVerification, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidVerification)
+	}
+
+	if err := s.repo.RevokeVerification(ctx, id, reviewerID, reason); err != nil {
+		return nil, s.reviewError(ctx, id, err, ErrVerificationNotActive)
+	}
+
+	v, err := s.repo.GetVerificationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("Подтверждение «%s» отозвано модератором. Причина: %s", v.Title, reason)
+	s.notifyMentor(ctx, v, models.NotificationTypeVerificationRevoked, "Подтверждение отозвано", body)
+
+	return v, nil
+}
+
+// mentorID возвращает ID профиля ментора пользователя или ErrNotMentor
+func (s *MentorVerificationService) mentorID(ctx context.Context, userID int) (int, error) {
+	mentorID, err := s.mentorRepo.GetMentorIDByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if mentorID == nil {
+		return 0, ErrNotMentor
+	}
+	return *mentorID, nil
+}
+
+// reviewError определяет, почему подтверждение не удалось рассмотреть.
+// Если подтверждение существует, возвращается stateErr.
+func (s *MentorVerificationService) reviewError(ctx context.Context, id int, err, stateErr error) error {
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	if _, getErr := s.repo.GetVerificationByID(ctx, id); getErr != nil {
+		if errors.Is(getErr, pgx.ErrNoRows) {
+			return ErrVerificationNotFound
+		}
+		return getErr
+	}
+	return stateErr
+}
+
+// notifyMentor отправляет ментору уведомление о решении по подтверждению.
+// Ошибка уведомления не отменяет уже принятое решение, поэтому только логируется.
+func (s *MentorVerificationService) notifyMentor(ctx context.Context, v *models.MentorVerification, notificationType, title, body string) {
+	mentor, err := s.mentorRepo.GetMentorByID(ctx, v.MentorID)
+	if err != nil {
+		logger.Error("Failed to load mentor for verification notification", zap.Int("verification_id", v.ID), zap.Error(err))
+		return
+	}
+
+	payload := map[string]interface{}{
+		"verificationId": v.ID,
+		"type":           v.Type,
+		"status":         v.Status,
+	}
+	if err := s.notificationService.Notify(ctx, mentor.UserID, notificationType, title, body, payload); err != nil {
+		logger.Error("Failed to notify mentor about verification", zap.Int("verification_id", v.ID), zap.Error(err))
+	}
+}
+
+// validateVerification нормализует и проверяет утверждение и ссылку на доказательство
+func validateVerification(v *models.MentorVerification) error {
+	if !models.IsKnownVerificationType(v.Type) {
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidVerification, v.Type)
+	}
+
+	v.Title = strings.TrimSpace(v.Title)
+	if v.Title == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalidVerification)
+	}
+	if utf8.RuneCountInString(v.Title) > verificationTitleMaxLength {
+		return fmt.Errorf("%w: title is longer than %d characters", ErrInvalidVerification, verificationTitleMaxLength)
+	}
+
+	details, err := normalizeOptionalText(v.Details, verificationDetailsMaxLength)
+	if err != nil {
+		return fmt.Errorf("%w: details %v", ErrInvalidVerification, err)
+	}
+	v.Details = details
+
+	v.EvidenceURL = strings.TrimSpace(v.EvidenceURL)
+	if len(v.EvidenceURL) > verificationURLMaxLength {
+		return fmt.Errorf("%w: evidenceUrl is too long", ErrInvalidVerification)
+	}
+	u, err := url.Parse(v.EvidenceURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: evidenceUrl must be an http(s) link", ErrInvalidVerification)
+	}
+
+	// Профиль GitHub подтверждается ссылкой на сам профиль
+	if v.Type == models.VerificationTypeGitHub {
+		host := strings.ToLower(u.Hostname())
+		if (host != "github.com" && host != "www.github.com") || strings.Trim(u.Path, "/") == "" {
+			return fmt.Errorf("%w: github evidence must link to a github.com profile", ErrInvalidVerification)
+		}
+	}
+
+	return nil
+}
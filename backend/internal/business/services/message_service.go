@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// maxMessageLength - максимальная длина сообщения в символах
+const maxMessageLength = 4000
+
+var (
+	// ErrConversationNotFound возвращается, если диалог не найден или пользователь в нем не участвует
+	ErrConversationNotFound = errors.New("conversation not found")
+	// ErrInvalidMessage возвращается, если сообщение заполнено некорректно
+	ErrInvalidMessage = errors.New("invalid message")
+)
+
+// MessagePage представляет страницу истории сообщений
+type MessagePage struct {
+	Items      []*models.Message
+	NextCursor *int
+}
+
+// MessageService отвечает за переписку ментора и ученика и доставку сообщений в реальном времени.
+// Диалог создается базой данных при первом запросе на занятие.
+type MessageService struct {
+	repo *repositories.ConversationRepository
+	hub  *RealtimeHub
+}
+
+func NewMessageService(repo *repositories.ConversationRepository, hub *RealtimeHub) *MessageService {
+	return &MessageService{
+		repo: repo,
+		hub:  hub,
+	}
+}
+
+// ListConversations возвращает страницу диалогов пользователя и общее количество непрочитанных сообщений
+func (s *MessageService) ListConversations(ctx context.Context, userID, limit, offset int) ([]*models.Conversation, int, int, error) {
+	conversations, total, err := s.repo.GetUserConversations(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	unread, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	return conversations, total, unread, nil
+}
+
+// GetConversation возвращает диалог, если пользователь в нем участвует
+func (s *MessageService) GetConversation(ctx context.Context, userID, id int) (*models.Conversation, error) {
+	conversation, err := s.repo.GetConversationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrConversationNotFound
+		}
+		return nil, err
+	}
+	// Чужие диалоги не раскрываем
+	if !conversation.IsParticipant(userID) {
+		return nil, ErrConversationNotFound
+	}
+	return conversation, nil
+}
+
+// ListMessages возвращает страницу истории диалога от новых сообщений к старым
+func (s *MessageService) ListMessages(ctx context.Context, userID, conversationID int, cursor *int, limit int) (*MessagePage, error) {
+	if _, err := s.GetConversation(ctx, userID, conversationID); err != nil {
+		return nil, err
+	}
+
+	// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	items, err := s.repo.GetMessages(ctx, conversationID, cursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &MessagePage{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		next := page.Items[limit-1].ID
+		page.NextCursor = &next
+	}
+	return page, nil
+}
+
+// Send отправляет сообщение в диалог и доставляет его обоим участникам в реальном времени
+func (s *MessageService) Send(ctx context.Context, userID, conversationID int, body string) (*models.Message, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, fmt.Errorf("%w: body is required", ErrInvalidMessage)
+	}
+	if utf8.RuneCountInString(body) > maxMessageLength {
+		return nil, fmt.Errorf("%w: body must be at most %d characters", ErrInvalidMessage, maxMessageLength)
+	}
+
+	conversation, err := s.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &models.Message{
+		ConversationID: conversation.ID,
+		SenderID:       userID,
+		Body:           body,
+	}
+	if err := s.repo.CreateMessage(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Отправителю тоже, чтобы синхронизировать его остальные вкладки и устройства
+	s.publish(ctx, conversation.CounterpartID(userID), RealtimeEvent{Type: RealtimeEventMessage, Message: msg})
+	s.publish(ctx, userID, RealtimeEvent{Type: RealtimeEventMessage, Message: msg})
+
+	return msg, nil
+}
+
+// MarkRead отмечает диалог прочитанным до сообщения upTo или целиком, если upTo не задан.
+// Второй участник получает уведомление о прочтении.
+func (s *MessageService) MarkRead(ctx context.Context, userID, conversationID int, upTo *int) (*models.ReadReceipt, error) {
+	conversation, err := s.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	lastRead, err := s.repo.MarkRead(ctx, conversation.ID, userID, upTo)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &models.ReadReceipt{
+		ConversationID:    conversation.ID,
+		UserID:            userID,
+		LastReadMessageID: lastRead,
+	}
+	s.publish(ctx, conversation.CounterpartID(userID), RealtimeEvent{Type: RealtimeEventRead, Receipt: receipt})
+	s.publish(ctx, userID, RealtimeEvent{Type: RealtimeEventRead, Receipt: receipt})
+
+	return receipt, nil
+}
+
+// Subscribe подписывает соединение на события пользователя
+func (s *MessageService) Subscribe(userID int) *Subscription {
+	return s.hub.Subscribe(userID)
+}
+
+// Unsubscribe отменяет подписку соединения
+func (s *MessageService) Unsubscribe(sub *Subscription) {
+	s.hub.Unsubscribe(sub)
+}
+
+// publish дополняет событие счетчиком непрочитанных получателя и отправляет его.
+// Сообщение уже сохранено, поэтому ошибка подсчета только логируется.
+func (s *MessageService) publish(ctx context.Context, userID int, event RealtimeEvent) {
+	unread, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to count unread messages", zap.Int("user_id", userID), zap.Error(err))
+		return
+	}
+	event.UnreadCount = unread
+	s.hub.Publish(userID, event)
+}
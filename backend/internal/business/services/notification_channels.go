@@ -0,0 +1,123 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Названия внешних каналов доставки
+const (
+	ChannelEmail    = "email"
+	ChannelTelegram = "telegram"
+)
+
+// ErrRecipientUnavailable возвращается, если у пользователя не указан адрес для канала
+var ErrRecipientUnavailable = errors.New("recipient address is not set")
+
+// NotificationChannel доставляет уведомление во внешний канал
+type NotificationChannel interface {
+	Name() string
+	Send(ctx context.Context, user *models.User, n *models.Notification) error
+}
+
+// EmailChannel отправляет уведомления по email через SMTP
+type EmailChannel struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewEmailChannel(host, port, username, password, from string) *EmailChannel {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &EmailChannel{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (c *EmailChannel) Name() string {
+	return ChannelEmail
+}
+
+// Send отправляет письмо на email пользователя
+func (c *EmailChannel) Send(_ context.Context, user *models.User, n *models.Notification) error {
+	if user.Email == nil || *user.Email == "" {
+		return ErrRecipientUnavailable
+	}
+
+	var msg strings.Builder
+	msg.WriteString("From: " + c.from + "\r\n")
+	msg.WriteString("To: " + *user.Email + "\r\n")
+	msg.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", n.Title) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(n.Body)
+
+	return smtp.SendMail(c.addr, c.auth, c.from, []string{*user.Email}, []byte(msg.String()))
+}
+
+// TelegramChannel отправляет уведомления через Telegram Bot API
+type TelegramChannel struct {
+	botToken string
+	client   *http.Client
+}
+
+func NewTelegramChannel(botToken string) *TelegramChannel {
+	return &TelegramChannel{
+		botToken: botToken,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (c *TelegramChannel) Name() string {
+	return ChannelTelegram
+}
+
+// Send отправляет сообщение в чат пользователя с ботом
+func (c *TelegramChannel) Send(ctx context.Context, user *models.User, n *models.Notification) error {
+	if user.TelegramID == nil || *user.TelegramID == "" {
+		return ErrRecipientUnavailable
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"chat_id": *user.TelegramID,
+		"text":    n.Title + "\n\n" + n.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", c.botToken)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("telegram api responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrNotificationNotFound возвращается, если уведомление не найдено у пользователя
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// ErrUnknownNotificationType возвращается при попытке настроить неизвестный тип уведомлений
+var ErrUnknownNotificationType = errors.New("unknown notification type")
+
+// deliveryTimeout ограничивает время доставки уведомления во внешние каналы
+const deliveryTimeout = 15 * time.Second
+
+// NotificationPage представляет страницу ленты уведомлений
+type NotificationPage struct {
+	Items       []*models.Notification
+	UnreadCount int
+	NextCursor  *int
+}
+
+// NotificationService создает уведомления и рассылает их по каналам доставки.
+// Другие сервисы используют Notify для отправки событий пользователям.
+type NotificationService struct {
+	repo     *repositories.NotificationRepository
+	userRepo *repositories.UserRepository
+	channels []NotificationChannel
+}
+
+func NewNotificationService(repo *repositories.NotificationRepository, userRepo *repositories.UserRepository, channels ...NotificationChannel) *NotificationService {
+	return &NotificationService{
+		repo:     repo,
+		userRepo: userRepo,
+		channels: channels,
+	}
+}
+
+// Notify сохраняет уведомление в ленте пользователя и асинхронно отправляет его
+// во внешние каналы, включенные пользователем для данного типа событий
+func (s *NotificationService) Notify(ctx context.Context, userID int, notificationType, title, body string, payload map[string]interface{}) error {
+	n := &models.Notification{
+		UserID:  userID,
+		Type:    notificationType,
+		Title:   title,
+		Body:    body,
+		Payload: payload,
+	}
+
+	if err := s.repo.CreateNotification(ctx, n); err != nil {
+		return err
+	}
+
+	if len(s.channels) > 0 {
+		go s.deliver(n)
+	}
+
+	return nil
+}
+
+// deliver рассылает уведомление по внешним каналам согласно настройкам пользователя
+func (s *NotificationService) deliver(n *models.Notification) {
+	ctx, cancel := context.WithTimeout(context.Background(), deliveryTimeout)
+	defer cancel()
+
+	pref, err := s.repo.GetPreference(ctx, n.UserID, n.Type)
+	if err != nil {
+		logger.Error("Failed to load notification preferences", zap.Int("user_id", n.UserID), zap.Error(err))
+		return
+	}
+	if pref == nil || (!pref.EmailEnabled && !pref.TelegramEnabled) {
+		return
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, n.UserID)
+	if err != nil {
+		logger.Error("Failed to load notification recipient", zap.Int("user_id", n.UserID), zap.Error(err))
+		return
+	}
+
+	for _, ch := range s.channels {
+		enabled := (ch.Name() == ChannelEmail && pref.EmailEnabled) ||
+			(ch.Name() == ChannelTelegram && pref.TelegramEnabled)
+		if !enabled {
+			continue
+		}
+
+		if err := ch.Send(ctx, user, n); err != nil {
+			logger.Warn("Failed to deliver notification",
+				zap.String("channel", ch.Name()),
+				zap.Int("notification_id", n.ID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// List возвращает страницу уведомлений пользователя и количество непрочитанных
+func (s *NotificationService) List(ctx context.Context, userID int, cursor *int, limit int, unreadOnly bool) (*NotificationPage, error) {
+	// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	items, err := s.repo.GetUserNotifications(ctx, userID, cursor, limit+1, unreadOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &NotificationPage{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		next := page.Items[limit-1].ID
+		page.NextCursor = &next
+	}
+
+	page.UnreadCount, err = s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// MarkRead отмечает уведомление пользователя прочитанным
+func (s *NotificationService) MarkRead(ctx context.Context, userID, notificationID int) error {
+	found, err := s.repo.MarkRead(ctx, userID, notificationID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrNotificationNotFound
+	}
+	return nil
+}
+
+// MarkAllRead отмечает все уведомления пользователя прочитанными
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID int) error {
+	return s.repo.MarkAllRead(ctx, userID)
+}
+
+// GetPreferences возвращает настройки доставки по всем известным типам уведомлений.
+// Для типов, которые пользователь не настраивал, каналы выключены.
+func (s *NotificationService) GetPreferences(ctx context.Context, userID int) ([]models.NotificationPreference, error) {
+	stored, err := s.repo.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[string]models.NotificationPreference, len(stored))
+	for _, p := range stored {
+		byType[p.Type] = p
+	}
+
+	prefs := make([]models.NotificationPreference, 0, len(models.NotificationTypes))
+	for _, t := range models.NotificationTypes {
+		if p, ok := byType[t]; ok {
+			prefs = append(prefs, p)
+		} else {
+			prefs = append(prefs, models.NotificationPreference{Type: t})
+		}
+	}
+
+	return prefs, nil
+}
+
+// UpdatePreferences сохраняет настройки доставки уведомлений
+func (s *NotificationService) UpdatePreferences(ctx context.Context, userID int, prefs []models.NotificationPreference) error {
+	for _, p := range prefs {
+		if !models.IsKnownNotificationType(p.Type) {
+			return ErrUnknownNotificationType
+		}
+	}
+	return s.repo.UpsertPreferences(ctx, userID, prefs)
+}
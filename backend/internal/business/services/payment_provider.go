@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"strings"
+)
+
+// Типы событий платежного провайдера, которые обрабатывает сервис оплат
+const (
+	// ProviderEventAuthorized - ученик оплатил, деньги удерживаются
+	ProviderEventAuthorized = "payment_intent.authorized"
+	// ProviderEventFailed - оплата не прошла
+	ProviderEventFailed = "payment_intent.failed"
+	// ProviderEventCanceled - платеж отменен на стороне провайдера
+	ProviderEventCanceled = "payment_intent.canceled"
+)
+
+// ErrInvalidWebhook возвращается, если подпись или содержимое webhook некорректны
+var ErrInvalidWebhook = errors.New("invalid payment webhook")
+
+// ProviderIntent - платеж, созданный у провайдера
+type ProviderIntent struct {
+	ID string
+	// ClientSecret передается клиенту для подтверждения оплаты на стороне провайдера
+	ClientSecret string
+}
+
+// PaymentProvider - платежный провайдер с двухстадийной оплатой:
+// сумма удерживается при оплате и списывается (полностью или частично) позже
+type PaymentProvider interface {
+	Name() string
+	// CreateIntent создает платеж на сумму amount в минимальных единицах валюты
+	CreateIntent(ctx context.Context, amount int, currency string, metadata map[string]string) (*ProviderIntent, error)
+	// Capture списывает amount в минимальных единицах валюты из удержанной суммы,
+	// остаток возвращается плательщику
+	Capture(ctx context.Context, intentID string, amount int) error
+	// Cancel отменяет платеж и снимает удержание целиком
+	Cancel(ctx context.Context, intentID string) error
+	// ParseWebhook проверяет подпись webhook и разбирает событие
+	ParseWebhook(payload []byte, signature string) (*models.PaymentEvent, error)
+}
+
+// minorUnits переводит сумму в целых единицах валюты, в которых хранятся оплаты, в минимальные
+// единицы провайдера. У всех поддерживаемых валют (RUB, USD, EUR) их 100 в единице.
+func minorUnits(amount int) int {
+	return amount * 100
+}
+
+// FakePaymentProvider - провайдер для разработки и тестов, работающий внутри процесса.
+// Платежи всегда успешны, события оплаты отправляются вручную на webhook с подписью
+// HMAC-SHA256 тела запроса секретом webhookSecret.
+type FakePaymentProvider struct {
+	webhookSecret []byte
+}
+
+func NewFakePaymentProvider(webhookSecret string) *FakePaymentProvider {
+	return &FakePaymentProvider{webhookSecret: []byte(webhookSecret)}
+}
+
+func (p *FakePaymentProvider) Name() string {
+	return "fake"
+}
+
+// CreateIntent выдает случайный идентификатор платежа
+func (p *FakePaymentProvider) CreateIntent(_ context.Context, amount int, currency string, _ map[string]string) (*ProviderIntent, error) {
+	if amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	id, err := randomHex(12)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randomHex(16)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderIntent{
+		ID:           "fake_pi_" + id,
+		ClientSecret: "fake_pi_" + id + "_secret_" + secret,
+	}, nil
+}
+
+// Capture всегда успешен для платежей этого провайдера
+func (p *FakePaymentProvider) Capture(_ context.Context, intentID string, amount int) error {
+	if !strings.HasPrefix(intentID, "fake_pi_") {
+		return fmt.Errorf("unknown payment intent %s", intentID)
+	}
+	if amount <= 0 {
+		return errors.New("capture amount must be positive")
+	}
+	return nil
+}
+
+// Cancel всегда успешен для платежей этого провайдера
+func (p *FakePaymentProvider) Cancel(_ context.Context, intentID string) error {
+	if !strings.HasPrefix(intentID, "fake_pi_") {
+		return fmt.Errorf("unknown payment intent %s", intentID)
+	}
+	return nil
+}
+
+// fakeWebhookEvent - тело webhook фейкового провайдера
+type fakeWebhookEvent struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	IntentID string `json:"intentId"`
+}
+
+// ParseWebhook проверяет подпись (hex HMAC-SHA256 тела) и разбирает событие
+func (p *FakePaymentProvider) ParseWebhook(payload []byte, signature string) (*models.PaymentEvent, error) {
+	if len(p.webhookSecret) == 0 {
+		return nil, fmt.Errorf("%w: webhook secret is not configured", ErrInvalidWebhook)
+	}
+	mac := hmac.New(sha256.New, p.webhookSecret)
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidWebhook)
+	}
+
+	var event fakeWebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+	}
+	if event.ID == "" || event.Type == "" || event.IntentID == "" {
+		return nil, fmt.Errorf("%w: id, type and intentId are required", ErrInvalidWebhook)
+	}
+
+	return &models.PaymentEvent{
+		Provider:         p.Name(),
+		EventID:          event.ID,
+		Type:             event.Type,
+		ProviderIntentID: event.IntentID,
+		Payload:          payload,
+	}, nil
+}
+
+// randomHex возвращает n случайных байт в hex
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
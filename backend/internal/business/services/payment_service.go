@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrPaymentNotFound возвращается, если у занятия нет оплаты
+	ErrPaymentNotFound = errors.New("payment not found")
+	// ErrPaymentNotRequired возвращается, если у ментора нет цены для такого занятия
+	ErrPaymentNotRequired = errors.New("session has no price")
+	// ErrPaymentExists возвращается, если у занятия уже есть незавершенная или проведенная оплата
+	ErrPaymentExists = errors.New("booking already has a payment")
+	// ErrPaymentNotAllowed возвращается, если оплатить занятие нельзя
+	ErrPaymentNotAllowed = errors.New("payment is not allowed for this booking")
+)
+
+// PaymentService отвечает за оплату занятий. Оплата удерживается провайдером до завершения
+// занятия: при проведении или неявке ученика сумма списывается в пользу ментора, при отмене
+// ученику возвращается часть суммы по политике отмены.
+type PaymentService struct {
+	repo        *repositories.PaymentRepository
+	bookingRepo *repositories.BookingRepository
+	mentorRepo  *repositories.MentorRepository
+	provider    PaymentProvider
+	policy      models.CancellationPolicy
+}
+
+func NewPaymentService(repo *repositories.PaymentRepository, bookingRepo *repositories.BookingRepository, mentorRepo *repositories.MentorRepository, provider PaymentProvider, policy models.CancellationPolicy) *PaymentService {
+	return &PaymentService{
+		repo:        repo,
+		bookingRepo: bookingRepo,
+		mentorRepo:  mentorRepo,
+		provider:    provider,
+		policy:      policy,
+	}
+}
+
+// CreatePayment создает платеж за занятие. Оплачивает ученик до начала занятия,
+// сумма рассчитывается по прайс-листу ментора и длительности занятия.
+func (s *PaymentService) CreatePayment(ctx context.Context, userID, bookingID int) (*models.Payment, error) {
+	booking, err := s.getBooking(ctx, userID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.MenteeID != userID {
+		return nil, ErrPaymentNotAllowed
+	}
+	if booking.Status != models.BookingRequested && booking.Status != models.BookingConfirmed {
+		return nil, fmt.Errorf("%w: booking is %s", ErrPaymentNotAllowed, booking.Status)
+	}
+	if !time.Now().Before(booking.StartsAt) {
+		return nil, fmt.Errorf("%w: booking has already started", ErrPaymentNotAllowed)
+	}
+
+	if existing, err := s.repo.GetLatestBookingPayment(ctx, booking.ID); err == nil {
+		if existing.Status != models.PaymentFailed && existing.Status != models.PaymentCancelled {
+			return nil, ErrPaymentExists
+		}
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	mentor, err := s.mentorRepo.GetMentorByID(ctx, booking.MentorID)
+	if err != nil {
+		return nil, err
+	}
+	amount, ok := mentor.EstimateSessionPrice(int(booking.EndsAt.Sub(booking.StartsAt).Minutes()))
+	priceRange := mentor.PriceRange()
+	if !ok || amount <= 0 || priceRange == nil {
+		return nil, ErrPaymentNotRequired
+	}
+
+	intent, err := s.provider.CreateIntent(ctx, minorUnits(amount), priceRange.Currency, map[string]string{
+		"bookingId": strconv.Itoa(booking.ID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payment := &models.Payment{
+		BookingID:        booking.ID,
+		PayerID:          userID,
+		MentorID:         booking.MentorID,
+		Amount:           amount,
+		Currency:         priceRange.Currency,
+		Provider:         s.provider.Name(),
+		ProviderIntentID: intent.ID,
+		ClientSecret:     &intent.ClientSecret,
+	}
+	if err := s.repo.CreatePayment(ctx, payment); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			// Параллельный запрос успел создать оплату, наш платеж у провайдера больше не нужен
+			s.cancelIntent(ctx, intent.ID)
+			return nil, ErrPaymentExists
+		}
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// GetBookingPayment возвращает последнюю оплату занятия для его участника
+func (s *PaymentService) GetBookingPayment(ctx context.Context, userID, bookingID int) (*models.Payment, error) {
+	booking, err := s.getBooking(ctx, userID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := s.repo.GetLatestBookingPayment(ctx, booking.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, err
+	}
+	// Секрет для подтверждения оплаты нужен только плательщику
+	if payment.PayerID != userID {
+		payment.ClientSecret = nil
+	}
+	return payment, nil
+}
+
+// HandleWebhook обрабатывает событие провайдера. Повторная доставка события ничего не меняет.
+func (s *PaymentService) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	event, err := s.provider.ParseWebhook(payload, signature)
+	if err != nil {
+		return err
+	}
+
+	var to string
+	switch event.Type {
+	case ProviderEventAuthorized:
+		to = models.PaymentHeld
+	case ProviderEventFailed:
+		to = models.PaymentFailed
+	case ProviderEventCanceled:
+		to = models.PaymentCancelled
+	default:
+		// Остальные события провайдера не влияют на оплату
+		return nil
+	}
+
+	payment, err := s.repo.ApplyEvent(ctx, event, models.PaymentPending, to)
+	if err != nil || payment == nil || payment.Status != models.PaymentHeld {
+		return err
+	}
+
+	// Занятие могло завершиться или быть отменено, пока ученик оплачивал
+	booking, err := s.bookingRepo.GetBookingByID(ctx, payment.BookingID)
+	if err != nil {
+		return err
+	}
+	if booking.Status != models.BookingRequested && booking.Status != models.BookingConfirmed {
+		return s.settle(ctx, booking, payment)
+	}
+	return nil
+}
+
+// SettleBooking проводит оплату занятия, перешедшего в итоговый статус.
+// Вызывается после смены статуса бронирования, поэтому ошибки только логируются:
+// неудачная оплата остается в статусе held и видна при сверке журнала.
+func (s *PaymentService) SettleBooking(ctx context.Context, booking *models.Booking) {
+	payment, err := s.repo.GetActiveBookingPayment(ctx, booking.ID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			logger.Error("Failed to load booking payment", zap.Int("booking_id", booking.ID), zap.Error(err))
+		}
+		return
+	}
+
+	if payment.Status == models.PaymentPending {
+		// Занятие не оплачено, платеж больше не нужен
+		if err := s.repo.UpdateStatus(ctx, payment.ID, models.PaymentPending, models.PaymentCancelled); err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				logger.Error("Failed to cancel payment", zap.Int("payment_id", payment.ID), zap.Error(err))
+			}
+			return
+		}
+		s.cancelIntent(ctx, payment.ProviderIntentID)
+		return
+	}
+
+	if err := s.settle(ctx, booking, payment); err != nil {
+		logger.Error("Failed to settle payment", zap.Int("payment_id", payment.ID), zap.Error(err))
+	}
+}
+
+// ListLedger возвращает страницу журнала движения денег
+func (s *PaymentService) ListLedger(ctx context.Context, paymentID *int, limit, offset int) ([]*models.LedgerEntry, int, error) {
+	return s.repo.GetLedger(ctx, paymentID, limit, offset)
+}
+
+// ListUnbalanced возвращает оплаты, расходящиеся с журналом
+func (s *PaymentService) ListUnbalanced(ctx context.Context) ([]*models.Payment, error) {
+	return s.repo.GetUnbalancedPayments(ctx)
+}
+
+// settle списывает удержанную сумму по итогу занятия и возвращает ученику остаток
+func (s *PaymentService) settle(ctx context.Context, booking *models.Booking, payment *models.Payment) error {
+	refundPercent := 0
+	switch booking.Status {
+	case models.BookingCompleted, models.BookingNoShow:
+	case models.BookingCancelled:
+		byMentor := booking.CancelledBy != nil && *booking.CancelledBy == booking.MentorUserID
+		refundPercent = s.policy.RefundPercent(booking.StartsAt, booking.UpdatedAt, byMentor)
+	default:
+		return nil
+	}
+
+	refunded := payment.Amount * refundPercent / 100
+	captured := payment.Amount - refunded
+
+	status := models.PaymentCaptured
+	switch {
+	case captured == 0:
+		status = models.PaymentRefunded
+	case refunded > 0:
+		status = models.PaymentPartiallyRefunded
+	}
+
+	// Провайдер вызывается под блокировкой оплаты, чтобы вебхук и смена статуса
+	// занятия не провели ее дважды
+	charge := func(ctx context.Context) error {
+		if captured == 0 {
+			return s.provider.Cancel(ctx, payment.ProviderIntentID)
+		}
+		return s.provider.Capture(ctx, payment.ProviderIntentID, minorUnits(captured))
+	}
+
+	err := s.repo.Settle(ctx, payment, status, captured, refunded, charge)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Оплату уже провели параллельно
+		return nil
+	}
+	return err
+}
+
+// getBooking возвращает бронирование, если пользователь является его участником
+func (s *PaymentService) getBooking(ctx context.Context, userID, bookingID int) (*models.Booking, error) {
+	booking, err := s.bookingRepo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBookingNotFound
+		}
+		return nil, err
+	}
+	if booking.MenteeID != userID && booking.MentorUserID != userID {
+		return nil, ErrBookingNotFound
+	}
+	return booking, nil
+}
+
+// cancelIntent отменяет платеж у провайдера. Ошибка только логируется:
+// неоплаченный платеж провайдер отменит сам по истечении срока.
+func (s *PaymentService) cancelIntent(ctx context.Context, intentID string) {
+	if err := s.provider.Cancel(ctx, intentID); err != nil {
+		logger.Warn("Failed to cancel payment intent", zap.String("intent_id", intentID), zap.Error(err))
+	}
+}
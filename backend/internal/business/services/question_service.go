@@ -0,0 +1,750 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"it_rabotyagi/internal/business/grading"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/questionio"
+	"it_rabotyagi/internal/business/repetition"
+	"it_rabotyagi/internal/business/textdiff"
+	"it_rabotyagi/internal/data/repositories"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Ограничения ответа на вопрос
+const (
+	maxAnswerLength = 2000
+	// maxAnswerTimeSeconds - верхняя граница времени одной попытки, больше - ошибка клиента
+	maxAnswerTimeSeconds = 24 * 60 * 60
+)
+
+// Ограничения поискового запроса
+const (
+	minSearchQueryLength = 2
+	maxSearchQueryLength = 200
+)
+
+// Ограничения содержимого вопроса
+const (
+	maxQuestionTitleLength   = 300
+	maxQuestionContentLength = 10000
+	maxQuestionTechnologies  = 10
+	maxTechnologyNameLength  = 50
+	maxQuestionCompanyTags   = 20
+	maxCompanyTagLength      = 100
+)
+
+// Ограничения импорта вопросов
+const (
+	maxQuestionImportSize = 5 << 20
+	maxQuestionImportRows = 1000
+	maxExternalKeyLength  = 100
+)
+
+var (
+	// ErrQuestionNotFound возвращается, если вопрос не найден
+	ErrQuestionNotFound = errors.New("question not found")
+	// ErrInvalidAnswer возвращается, если ответ на вопрос заполнен некорректно
+	ErrInvalidAnswer = errors.New("invalid answer")
+	// ErrInvalidQuestion возвращается, если вопрос заполнен некорректно
+	ErrInvalidQuestion = errors.New("invalid question")
+	// ErrInvalidQuestionFilter возвращается, если условия поиска вопросов заданы некорректно
+	ErrInvalidQuestionFilter = errors.New("invalid question filter")
+	// ErrQuestionRevisionNotFound возвращается, если у вопроса нет ревизии с таким номером
+	ErrQuestionRevisionNotFound = errors.New("question revision not found")
+	// ErrInvalidImport возвращается, если файл импорта не удалось разобрать целиком
+	ErrInvalidImport = errors.New("invalid import file")
+	// ErrQuestionForbidden возвращается, если пользователь не может изменить вопрос
+	ErrQuestionForbidden = errors.New("question action is not allowed for this user")
+)
+
+// QuestionService отвечает за банк вопросов: выдачу, проверку ответов и редактирование.
+// Правильный ответ и объяснение не уходят клиенту, пока пользователь не попробовал ответить.
+type QuestionService struct {
+	questionRepo *repositories.QuestionRepository
+	progressRepo *repositories.ProgressRepository
+}
+
+func NewQuestionService(questionRepo *repositories.QuestionRepository, progressRepo *repositories.ProgressRepository) *QuestionService {
+	return &QuestionService{
+		questionRepo: questionRepo,
+		progressRepo: progressRepo,
+	}
+}
+
+// List возвращает страницу вопросов, подходящих под фильтр
+func (s *QuestionService) List(ctx context.Context, filter models.QuestionFilter, limit, offset int) ([]*models.QuestionSummary, int, error) {
+	filter, err := normalizeQuestionFilter(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.questionRepo.GetAllQuestions(ctx, filter, limit, offset)
+}
+
+// Search ищет вопросы по тексту запроса среди подходящих под фильтр
+func (s *QuestionService) Search(ctx context.Context, text string, filter models.QuestionFilter, limit, offset int) ([]*models.QuestionSearchHit, int, error) {
+	text = strings.TrimSpace(text)
+	if length := utf8.RuneCountInString(text); length < minSearchQueryLength || length > maxSearchQueryLength {
+		return nil, 0, fmt.Errorf("%w: q must be between %d and %d characters", ErrInvalidQuestionFilter, minSearchQueryLength, maxSearchQueryLength)
+	}
+
+	filter, err := normalizeQuestionFilter(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.questionRepo.SearchQuestions(ctx, text, filter, limit, offset)
+}
+
+// ReviewQueue возвращает вопросы, которые пользователю пора повторить. Фильтр по прогрессу
+// и порядок не применяются: очередь всегда идет от самых просроченных вопросов.
+func (s *QuestionService) ReviewQueue(ctx context.Context, userID int, filter models.QuestionFilter, limit, offset int) (*models.ReviewQueue, error) {
+	filter, err := normalizeQuestionFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	filter.Status = ""
+	filter.Sort = ""
+	return s.questionRepo.GetReviewQueue(ctx, userID, filter, time.Now(), limit, offset)
+}
+
+// ListTechnologies возвращает технологии с количеством вопросов по каждой
+func (s *QuestionService) ListTechnologies(ctx context.Context) ([]models.Technology, error) {
+	return s.questionRepo.ListTechnologies(ctx)
+}
+
+// Get возвращает вопрос и прогресс пользователя viewerID (0 - аноним) по нему.
+// До первой попытки прогресс равен nil, а правильный ответ и объяснение скрыты,
+// если только пользователь не может редактировать вопрос. Неопубликованный вопрос
+// видят только предложивший его пользователь и модераторы, вместе с ключом ответа.
+func (s *QuestionService) Get(ctx context.Context, viewerID int, viewerRole string, id int) (*models.Question, *models.QuestionProgress, error) {
+	question, err := s.getQuestion(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if question.Status != models.QuestionPublished {
+		if !canViewSubmission(question, viewerID, viewerRole) {
+			return nil, nil, ErrQuestionNotFound
+		}
+		return question, nil, nil
+	}
+
+	var progress *models.QuestionProgress
+	if viewerID != 0 {
+		progress, err = s.progressRepo.GetQuestionProgress(ctx, viewerID, id)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, err
+		}
+	}
+
+	if progress == nil && !canEditQuestion(question, viewerID, viewerRole) {
+		question.AnswerKey = nil
+		question.Explanation = nil
+	}
+
+	return question, progress, nil
+}
+
+// SubmitAnswer проверяет ответ пользователя и сохраняет попытку в прогрессе вместе с новым
+// сроком повторения. recall - насколько легко пользователь вспомнил ответ, пустая строка
+// означает "good"; для неверного ответа не учитывается.
+func (s *QuestionService) SubmitAnswer(ctx context.Context, userID, id int, answer models.SubmittedAnswer, recall string, timeSpentSeconds *int) (*models.AnswerResult, error) {
+	question, err := s.getQuestion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// На неопубликованные вопросы не отвечают, иначе они попали бы в статистику и прогресс
+	if question.Status != models.QuestionPublished {
+		return nil, ErrQuestionNotFound
+	}
+
+	if utf8.RuneCountInString(answer.Text) > maxAnswerLength {
+		return nil, fmt.Errorf("%w: answer is longer than %d characters", ErrInvalidAnswer, maxAnswerLength)
+	}
+	if timeSpentSeconds != nil && (*timeSpentSeconds < 0 || *timeSpentSeconds > maxAnswerTimeSeconds) {
+		return nil, fmt.Errorf("%w: timeSpentSeconds must be between 0 and %d", ErrInvalidAnswer, maxAnswerTimeSeconds)
+	}
+
+	isCorrect, err := grading.Grade(question, answer)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAnswer, err)
+	}
+
+	quality, err := repetition.Quality(isCorrect, recall)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAnswer, err)
+	}
+	var schedule *models.ReviewSchedule
+	previous, err := s.progressRepo.GetQuestionProgress(ctx, userID, id)
+	switch {
+	case err == nil:
+		schedule = &previous.Schedule
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, err
+	}
+
+	progress, err := s.progressRepo.SaveAnswer(ctx, userID, id, question.Revision, formatAnswer(answer), isCorrect, timeSpentSeconds,
+		repetition.Next(schedule, quality, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AnswerResult{
+		Progress:     progress,
+		QuestionType: question.Type,
+		AnswerKey:    question.AnswerKey,
+		Explanation:  question.Explanation,
+	}, nil
+}
+
+// Create создает вопрос от имени автора
+func (s *QuestionService) Create(ctx context.Context, userID int, q *models.Question) (*models.Question, error) {
+	if err := validateQuestion(q); err != nil {
+		return nil, err
+	}
+	q.AuthorID = &userID
+
+	if err := s.questionRepo.CreateQuestion(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return s.questionRepo.GetQuestionByID(ctx, q.ID)
+}
+
+// Update заменяет содержимое вопроса. Автор редактирует свои вопросы, администратор - любые.
+func (s *QuestionService) Update(ctx context.Context, userID int, role string, q *models.Question) (*models.Question, error) {
+	existing, err := s.getQuestion(ctx, q.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !canEditQuestion(existing, userID, role) {
+		return nil, ErrQuestionForbidden
+	}
+	if err := validateQuestion(q); err != nil {
+		return nil, err
+	}
+	// Правка без изменений не создает новую ревизию
+	if len(changedQuestionFields(existing, q)) == 0 {
+		return existing, nil
+	}
+
+	if err := s.questionRepo.UpdateQuestion(ctx, q, userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrQuestionNotFound
+		}
+		return nil, err
+	}
+
+	return s.questionRepo.GetQuestionByID(ctx, q.ID)
+}
+
+// Delete удаляет вопрос вместе с ответами пользователей на него
+func (s *QuestionService) Delete(ctx context.Context, userID int, role string, id int) error {
+	existing, err := s.getQuestion(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !canEditQuestion(existing, userID, role) {
+		return ErrQuestionForbidden
+	}
+
+	return s.questionRepo.DeleteQuestion(ctx, id)
+}
+
+// ListRevisions возвращает историю правок вопроса, начиная с последней ревизии.
+// Ревизии содержат ключи ответов, поэтому история доступна только тем, кто может править вопрос.
+func (s *QuestionService) ListRevisions(ctx context.Context, userID int, role string, id int) ([]*models.QuestionRevision, error) {
+	question, err := s.getQuestion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canEditQuestion(question, userID, role) {
+		return nil, ErrQuestionForbidden
+	}
+
+	return s.questionRepo.GetQuestionRevisions(ctx, id)
+}
+
+// DiffRevisions сравнивает ревизию from с ревизией to, по умолчанию - с текущей
+func (s *QuestionService) DiffRevisions(ctx context.Context, userID int, role string, id, from int, to *int) (*models.QuestionRevisionDiff, error) {
+	question, err := s.getQuestion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canEditQuestion(question, userID, role) {
+		return nil, ErrQuestionForbidden
+	}
+	if to == nil {
+		to = &question.Revision
+	}
+
+	fromRevision, err := s.getRevision(ctx, id, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := s.getRevision(ctx, id, *to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &models.QuestionRevisionDiff{
+		From:          fromRevision,
+		To:            toRevision,
+		ChangedFields: changedQuestionFields(fromRevision.Question, toRevision.Question),
+	}
+	for _, field := range diff.ChangedFields {
+		before, after, ok := questionTextField(field, fromRevision.Question, toRevision.Question)
+		if !ok {
+			continue
+		}
+		diff.TextDiffs = append(diff.TextDiffs, models.QuestionTextDiff{
+			Field: field,
+			Lines: textdiff.Lines(before, after),
+		})
+	}
+
+	return diff, nil
+}
+
+// Rollback возвращает вопросу содержимое ревизии revision. Откат сохраняется новой ревизией,
+// поэтому ответы, проверенные по промежуточным ревизиям, сохраняют свою привязку.
+// Откатывать может только администратор.
+func (s *QuestionService) Rollback(ctx context.Context, userID int, role string, id, revision int) (*models.Question, error) {
+	if role != models.RoleAdmin {
+		return nil, ErrQuestionForbidden
+	}
+	current, err := s.getQuestion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.getRevision(ctx, id, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	q := target.Question
+	q.ID = id
+	// Правила проверки вопросов могли измениться с момента сохранения ревизии
+	if err := validateQuestion(q); err != nil {
+		return nil, err
+	}
+	if len(changedQuestionFields(current, q)) == 0 {
+		return current, nil
+	}
+
+	if err := s.questionRepo.RollbackQuestion(ctx, q, userID, revision); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrQuestionNotFound
+		}
+		return nil, err
+	}
+
+	return s.questionRepo.GetQuestionByID(ctx, id)
+}
+
+// Import импортирует вопросы из файла. Вопрос с ключом, который уже есть в банке, обновляется,
+// если его содержимое изменилось, остальные создаются от имени пользователя (userID 0 - без автора). Если хотя бы один вопрос
+// содержит ошибку или его нельзя изменить, не сохраняется ни один; при dryRun вопросы только проверяются.
+func (s *QuestionService) Import(ctx context.Context, userID int, role, format string, r io.Reader, dryRun bool) (*models.QuestionImportReport, error) {
+	if !questionio.IsKnownFormat(format) {
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidImport, format)
+	}
+	data, err := io.ReadAll(io.LimitReader(r, maxQuestionImportSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxQuestionImportSize {
+		return nil, fmt.Errorf("%w: file must be at most %d bytes", ErrInvalidImport, maxQuestionImportSize)
+	}
+
+	rows, err := questionio.Read(format, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: file contains no questions", ErrInvalidImport)
+	}
+	if len(rows) > maxQuestionImportRows {
+		return nil, fmt.Errorf("%w: file must contain at most %d questions", ErrInvalidImport, maxQuestionImportRows)
+	}
+
+	// Сначала проверяем каждый вопрос и повторы ключей внутри файла
+	results := make([]models.QuestionImportRowResult, len(rows))
+	keyRows := make(map[string]int)
+	var keys []string
+	for i, row := range rows {
+		results[i] = models.QuestionImportRowResult{Row: row.Row}
+		if row.Err != nil {
+			results[i].Error = row.Err.Error()
+			continue
+		}
+		q := row.Question
+		if err := normalizeExternalKey(q); err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].ExternalKey = q.ExternalKey
+		if err := validateQuestion(q); err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		if q.ExternalKey != nil {
+			if first, ok := keyRows[*q.ExternalKey]; ok {
+				results[i].Error = fmt.Sprintf("%v: key %q is already used in row %d", ErrInvalidQuestion, *q.ExternalKey, first)
+				continue
+			}
+			keyRows[*q.ExternalKey] = row.Row
+			keys = append(keys, *q.ExternalKey)
+		}
+	}
+
+	existing := make(map[string]*models.Question)
+	if len(keys) > 0 {
+		found, err := s.questionRepo.GetQuestionsByExternalKeys(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+		for _, q := range found {
+			existing[*q.ExternalKey] = q
+		}
+	}
+
+	report := &models.QuestionImportReport{DryRun: dryRun}
+	var questions []*models.Question
+	for i, row := range rows {
+		if results[i].Error != "" {
+			results[i].Action = models.QuestionImportFailed
+			report.Failed++
+			continue
+		}
+
+		q := row.Question
+		var current *models.Question
+		if q.ExternalKey != nil {
+			current = existing[*q.ExternalKey]
+		}
+		if current != nil {
+			if !canEditQuestion(current, userID, role) {
+				results[i].Action = models.QuestionImportFailed
+				results[i].Error = ErrQuestionForbidden.Error()
+				report.Failed++
+				continue
+			}
+			results[i].QuestionID = &current.ID
+			if len(changedQuestionFields(current, q)) == 0 {
+				results[i].Action = models.QuestionImportUnchanged
+				report.Unchanged++
+				continue
+			}
+			q.ID = current.ID
+			results[i].Action = models.QuestionImportUpdated
+			report.Updated++
+		} else {
+			if userID != 0 {
+				q.AuthorID = &userID
+			}
+			results[i].Action = models.QuestionImportCreated
+			report.Created++
+		}
+		questions = append(questions, q)
+	}
+	report.Rows = results
+
+	if dryRun || report.Failed > 0 {
+		return report, nil
+	}
+
+	if len(questions) > 0 {
+		if err := s.questionRepo.ImportQuestions(ctx, questions, userID); err != nil {
+			return nil, err
+		}
+	}
+	for i, row := range rows {
+		if results[i].Action == models.QuestionImportCreated {
+			results[i].QuestionID = &row.Question.ID
+		}
+	}
+	report.Applied = true
+
+	return report, nil
+}
+
+// Export записывает вопросы, подходящие под фильтр, в формате format вместе с ключами ответов.
+// Администратор выгружает все вопросы, автор - только свои.
+func (s *QuestionService) Export(ctx context.Context, userID int, role, format string, filter models.QuestionFilter, w io.Writer) error {
+	if !questionio.IsKnownFormat(format) {
+		return fmt.Errorf("%w: unknown format %q", ErrInvalidQuestionFilter, format)
+	}
+	filter, err := normalizeQuestionFilter(filter)
+	if err != nil {
+		return err
+	}
+	if role != models.RoleAdmin {
+		filter.AuthorID = userID
+	}
+
+	questions, err := s.questionRepo.GetQuestionsForExport(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return questionio.Write(format, w, questions)
+}
+
+func (s *QuestionService) getQuestion(ctx context.Context, id int) (*models.Question, error) {
+	question, err := s.questionRepo.GetQuestionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrQuestionNotFound
+		}
+		return nil, err
+	}
+	return question, nil
+}
+
+func (s *QuestionService) getRevision(ctx context.Context, id, revision int) (*models.QuestionRevision, error) {
+	rev, err := s.questionRepo.GetQuestionRevision(ctx, id, revision)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrQuestionRevisionNotFound
+		}
+		return nil, err
+	}
+	return rev, nil
+}
+
+// canEditQuestion проверяет, что пользователь - администратор или автор вопроса
+func canEditQuestion(q *models.Question, userID int, role string) bool {
+	if role == models.RoleAdmin {
+		return true
+	}
+	return role == models.RoleAuthor && q.AuthorID != nil && *q.AuthorID == userID
+}
+
+// validateQuestion проверяет и нормализует содержимое вопроса
+func validateQuestion(q *models.Question) error {
+	q.Title = strings.TrimSpace(q.Title)
+	if q.Title == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalidQuestion)
+	}
+	if utf8.RuneCountInString(q.Title) > maxQuestionTitleLength {
+		return fmt.Errorf("%w: title must be at most %d characters", ErrInvalidQuestion, maxQuestionTitleLength)
+	}
+
+	q.Content = strings.TrimSpace(q.Content)
+	if q.Content == "" {
+		return fmt.Errorf("%w: content is required", ErrInvalidQuestion)
+	}
+	if utf8.RuneCountInString(q.Content) > maxQuestionContentLength {
+		return fmt.Errorf("%w: content must be at most %d characters", ErrInvalidQuestion, maxQuestionContentLength)
+	}
+
+	if !models.IsKnownDifficulty(q.Difficulty) {
+		return fmt.Errorf("%w: unknown difficulty %q", ErrInvalidQuestion, q.Difficulty)
+	}
+
+	explanation, err := normalizeOptionalText(q.Explanation, maxQuestionContentLength)
+	if err != nil {
+		return fmt.Errorf("%w: explanation %v", ErrInvalidQuestion, err)
+	}
+	q.Explanation = explanation
+
+	if err := grading.Validate(q); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidQuestion, err)
+	}
+
+	technologies, err := normalizeTags(q.Technologies, maxQuestionTechnologies, maxTechnologyNameLength)
+	if err != nil {
+		return fmt.Errorf("%w: technologies %v", ErrInvalidQuestion, err)
+	}
+	if len(technologies) == 0 {
+		return fmt.Errorf("%w: at least one technology is required", ErrInvalidQuestion)
+	}
+	q.Technologies = technologies
+
+	companyTags, err := normalizeTags(q.CompanyTags, maxQuestionCompanyTags, maxCompanyTagLength)
+	if err != nil {
+		return fmt.Errorf("%w: companyTags %v", ErrInvalidQuestion, err)
+	}
+	q.CompanyTags = companyTags
+
+	return nil
+}
+
+// normalizeExternalKey убирает пробелы вокруг ключа импорта и проверяет его длину
+func normalizeExternalKey(q *models.Question) error {
+	if q.ExternalKey == nil {
+		return nil
+	}
+	key := strings.TrimSpace(*q.ExternalKey)
+	if key == "" {
+		q.ExternalKey = nil
+		return nil
+	}
+	if utf8.RuneCountInString(key) > maxExternalKeyLength {
+		return fmt.Errorf("%w: key must be at most %d characters", ErrInvalidQuestion, maxExternalKeyLength)
+	}
+	q.ExternalKey = &key
+	return nil
+}
+
+// changedQuestionFields возвращает названия полей (как в API), которыми различается
+// содержимое вопросов. Технологии сравниваются без учета порядка и регистра.
+func changedQuestionFields(a, b *models.Question) []string {
+	var changed []string
+	add := func(field string, equal bool) {
+		if !equal {
+			changed = append(changed, field)
+		}
+	}
+
+	add("title", a.Title == b.Title)
+	add("content", a.Content == b.Content)
+	add("difficulty", a.Difficulty == b.Difficulty)
+	add("type", a.Type == b.Type)
+	add("options", slices.Equal(a.Options, b.Options))
+	add("language", derefOrEmpty(a.Language) == derefOrEmpty(b.Language))
+	add("starterCode", derefOrEmpty(a.StarterCode) == derefOrEmpty(b.StarterCode))
+	add("answerKey", sameAnswerKey(a.AnswerKey, b.AnswerKey))
+	add("explanation", derefOrEmpty(a.Explanation) == derefOrEmpty(b.Explanation))
+	add("technologies", slices.Equal(sortedLower(a.Technologies), sortedLower(b.Technologies)))
+	add("companyTags", slices.Equal(a.CompanyTags, b.CompanyTags))
+
+	return changed
+}
+
+// questionTextField возвращает значения текстового поля в обоих вопросах; ok = false,
+// если поле не текстовое и построчная разница для него не нужна
+func questionTextField(field string, a, b *models.Question) (before, after string, ok bool) {
+	switch field {
+	case "title":
+		return a.Title, b.Title, true
+	case "content":
+		return a.Content, b.Content, true
+	case "starterCode":
+		return derefOrEmpty(a.StarterCode), derefOrEmpty(b.StarterCode), true
+	case "explanation":
+		return derefOrEmpty(a.Explanation), derefOrEmpty(b.Explanation), true
+	}
+	return "", "", false
+}
+
+func sameAnswerKey(a, b *models.AnswerKey) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Answer == b.Answer &&
+		slices.Equal(a.Answers, b.Answers) &&
+		slices.Equal(a.Order, b.Order) &&
+		((a.Value == nil && b.Value == nil) || (a.Value != nil && b.Value != nil && *a.Value == *b.Value)) &&
+		a.Tolerance == b.Tolerance &&
+		slices.Equal(a.Patterns, b.Patterns) &&
+		a.CaseSensitive == b.CaseSensitive &&
+		slices.Equal(a.Solutions, b.Solutions)
+}
+
+func sortedLower(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, strings.ToLower(v))
+	}
+	slices.Sort(result)
+	return result
+}
+
+func derefOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// normalizeTags убирает пробелы и повторы (без учета регистра) и проверяет ограничения
+func normalizeTags(tags []string, maxCount, maxLength int) ([]string, error) {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			return nil, errors.New("must not contain empty values")
+		}
+		if utf8.RuneCountInString(tag) > maxLength {
+			return nil, fmt.Errorf("value must be at most %d characters", maxLength)
+		}
+		key := strings.ToLower(tag)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, tag)
+	}
+	if len(result) > maxCount {
+		return nil, fmt.Errorf("must contain at most %d values", maxCount)
+	}
+	return result, nil
+}
+
+// normalizeQuestionFilter проверяет уровни сложности, отбор по прогрессу и порядок, а технологии
+// и теги компаний приводит к нижнему регистру без повторов: они сравниваются без учета регистра
+func normalizeQuestionFilter(filter models.QuestionFilter) (models.QuestionFilter, error) {
+	for _, d := range filter.Difficulties {
+		if !models.IsKnownDifficulty(d) {
+			return filter, fmt.Errorf("%w: unknown difficulty %q", ErrInvalidQuestionFilter, d)
+		}
+	}
+	switch filter.Status {
+	case "":
+	case models.QuestionStatusUnanswered, models.QuestionStatusFailed:
+		if filter.UserID == 0 {
+			return filter, fmt.Errorf("%w: status filter requires authentication", ErrInvalidQuestionFilter)
+		}
+	default:
+		return filter, fmt.Errorf("%w: unknown status %q", ErrInvalidQuestionFilter, filter.Status)
+	}
+	switch filter.Sort {
+	case "", models.QuestionSortNewest, models.QuestionSortHardest, models.QuestionSortMostAttempted:
+	default:
+		return filter, fmt.Errorf("%w: unknown sort %q", ErrInvalidQuestionFilter, filter.Sort)
+	}
+	filter.Technologies = lowerUnique(filter.Technologies)
+	filter.CompanyTags = lowerUnique(filter.CompanyTags)
+	return filter, nil
+}
+
+// lowerUnique приводит значения к нижнему регистру, убирая пустые и повторы
+func lowerUnique(values []string) []string {
+	result := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	return result
+}
+
+// formatAnswer преобразует ответ в текст для сохранения в прогрессе:
+// выбранные варианты - JSON-массивом, число - в десятичной записи
+func formatAnswer(answer models.SubmittedAnswer) string {
+	switch {
+	case answer.Choices != nil:
+		choices, _ := json.Marshal(answer.Choices)
+		return string(choices)
+	case answer.Value != nil:
+		return strconv.FormatFloat(*answer.Value, 'f', -1, 64)
+	default:
+		return strings.TrimSpace(answer.Text)
+	}
+}
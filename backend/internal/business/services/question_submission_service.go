@@ -0,0 +1,325 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// maxDraftSubmissions - сколько вопросов пользователь может одновременно держать в очереди модерации
+const maxDraftSubmissions = 10
+
+var (
+	// ErrSubmissionNotFound возвращается, если предложенный вопрос не найден
+	ErrSubmissionNotFound = errors.New("question submission not found")
+	// ErrSubmissionReviewed возвращается, если вопрос уже опубликован или объединен с другим
+	ErrSubmissionReviewed = errors.New("question submission is already reviewed")
+	// ErrSubmitterNotVerified возвращается, если неподтвержденный пользователь предлагает вопрос
+	ErrSubmitterNotVerified = errors.New("only verified users can submit questions")
+	// ErrTooManySubmissions возвращается, если у пользователя слишком много вопросов в очереди
+	ErrTooManySubmissions = errors.New("too many questions awaiting moderation")
+	// ErrInvalidSubmissionReview возвращается, если решение модератора заполнено некорректно
+	ErrInvalidSubmissionReview = errors.New("invalid question submission review")
+)
+
+// QuestionSubmissionService отвечает за вопросы, которые предлагают пользователи, и их модерацию.
+// Предложенный вопрос хранится в банке вопросов черновиком и становится виден всем после одобрения.
+type QuestionSubmissionService struct {
+	questionRepo        *repositories.QuestionRepository
+	userRepo            *repositories.UserRepository
+	notificationService *NotificationService
+}
+
+func NewQuestionSubmissionService(questionRepo *repositories.QuestionRepository, userRepo *repositories.UserRepository, notificationService *NotificationService) *QuestionSubmissionService {
+	return &QuestionSubmissionService{
+		questionRepo:        questionRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Submit предлагает вопрос от имени пользователя и ставит его в очередь модерации
+func (s *QuestionSubmissionService) Submit(ctx context.Context, userID int, q *models.Question) (*models.QuestionSubmission, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isVerifiedUser(user) {
+		return nil, ErrSubmitterNotVerified
+	}
+
+	if err := validateQuestion(q); err != nil {
+		return nil, err
+	}
+
+	drafts, err := s.questionRepo.CountUserSubmissions(ctx, userID, models.QuestionDraft)
+	if err != nil {
+		return nil, err
+	}
+	if drafts >= maxDraftSubmissions {
+		return nil, ErrTooManySubmissions
+	}
+
+	q.AuthorID = &userID
+	q.ExternalKey = nil
+	q.Status = models.QuestionDraft
+	if err := s.questionRepo.CreateQuestion(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return s.questionRepo.GetSubmissionByID(ctx, q.ID)
+}
+
+// ListUserSubmissions возвращает вопросы, предложенные пользователем, во всех статусах
+func (s *QuestionSubmissionService) ListUserSubmissions(ctx context.Context, userID int) ([]*models.QuestionSubmission, error) {
+	return s.questionRepo.GetUserSubmissions(ctx, userID)
+}
+
+// Update заменяет содержимое своего предложенного вопроса. Черновик остается в очереди,
+// отклоненный вопрос после правки возвращается в нее.
+func (s *QuestionSubmissionService) Update(ctx context.Context, userID int, q *models.Question) (*models.QuestionSubmission, error) {
+	existing, err := s.getSubmission(ctx, q.ID)
+	if err != nil {
+		return nil, err
+	}
+	// Чужие черновики не видны, поэтому и править их нельзя
+	if existing.Question.AuthorID == nil || *existing.Question.AuthorID != userID {
+		return nil, ErrSubmissionNotFound
+	}
+	if existing.Question.Status != models.QuestionDraft && existing.Question.Status != models.QuestionRejected {
+		return nil, ErrSubmissionReviewed
+	}
+
+	return s.update(ctx, userID, existing, q)
+}
+
+// ListQueue возвращает страницу предложенных вопросов в указанном статусе
+func (s *QuestionSubmissionService) ListQueue(ctx context.Context, status string, limit, offset int) ([]*models.QuestionSubmission, int, error) {
+	return s.questionRepo.GetSubmissionsByStatus(ctx, status, limit, offset)
+}
+
+// Edit исправляет вопрос из очереди модерации перед публикацией. Правка сохраняется
+// ревизией от имени модератора, автором вопроса остается предложивший его пользователь.
+func (s *QuestionSubmissionService) Edit(ctx context.Context, moderatorID int, q *models.Question) (*models.QuestionSubmission, error) {
+	existing, err := s.getSubmission(ctx, q.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Question.Status != models.QuestionDraft {
+		return nil, ErrSubmissionReviewed
+	}
+
+	return s.update(ctx, moderatorID, existing, q)
+}
+
+func (s *QuestionSubmissionService) update(ctx context.Context, editorID int, existing *models.QuestionSubmission, q *models.Question) (*models.QuestionSubmission, error) {
+	if err := validateQuestion(q); err != nil {
+		return nil, err
+	}
+	if len(changedQuestionFields(existing.Question, q)) == 0 {
+		// Отклоненный вопрос без исправлений в очередь не возвращается
+		if existing.Question.Status == models.QuestionRejected {
+			return nil, fmt.Errorf("%w: rejected question must be changed before resubmitting", ErrInvalidQuestion)
+		}
+		return existing, nil
+	}
+
+	if err := s.questionRepo.UpdateSubmission(ctx, q, editorID); err != nil {
+		return nil, s.reviewError(ctx, q.ID, err)
+	}
+
+	return s.questionRepo.GetSubmissionByID(ctx, q.ID)
+}
+
+// Approve публикует вопрос из очереди и уведомляет автора
+func (s *QuestionSubmissionService) Approve(ctx context.Context, id, reviewerID int, comment *string) (*models.QuestionSubmission, error) {
+	if err := s.questionRepo.ApproveSubmission(ctx, id, reviewerID, normalizeComment(comment)); err != nil {
+		return nil, s.reviewError(ctx, id, err)
+	}
+
+	submission, err := s.questionRepo.GetSubmissionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("Ваш вопрос «%s» прошел модерацию и опубликован в банке вопросов.", submission.Question.Title)
+	s.notifyAuthor(ctx, submission, models.NotificationTypeQuestionSubmissionApproved, "Вопрос опубликован", body)
+
+	return submission, nil
+}
+
+// Reject отклоняет вопрос из очереди с обязательной причиной и уведомляет автора
+func (s *QuestionSubmissionService) Reject(ctx context.Context, id, reviewerID int, reason string) (*models.QuestionSubmission, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidSubmissionReview)
+	}
+
+	if err := s.questionRepo.RejectSubmission(ctx, id, reviewerID, reason); err != nil {
+		return nil, s.reviewError(ctx, id, err)
+	}
+
+	submission, err := s.questionRepo.GetSubmissionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("Ваш вопрос «%s» отклонен модератором. Причина: %s. Вопрос можно исправить и отправить снова.",
+		submission.Question.Title, reason)
+	s.notifyAuthor(ctx, submission, models.NotificationTypeQuestionSubmissionRejected, "Вопрос отклонен", body)
+
+	return submission, nil
+}
+
+// Merge помечает вопрос из очереди дубликатом опубликованного вопроса duplicateOf и уведомляет автора.
+// Теги компаний дубликата, которых нет у опубликованного вопроса, добавляются к нему:
+// это новые сведения о том, где вопрос задают на собеседованиях.
+func (s *QuestionSubmissionService) Merge(ctx context.Context, id, reviewerID, duplicateOf int, comment *string) (*models.QuestionSubmission, error) {
+	if duplicateOf == id {
+		return nil, fmt.Errorf("%w: question cannot be a duplicate of itself", ErrInvalidSubmissionReview)
+	}
+
+	submission, err := s.getSubmission(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if submission.Question.Status != models.QuestionDraft {
+		return nil, ErrSubmissionReviewed
+	}
+
+	original, err := s.questionRepo.GetQuestionByID(ctx, duplicateOf)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	if original == nil || original.Status != models.QuestionPublished {
+		return nil, fmt.Errorf("%w: duplicateOf must be a published question", ErrInvalidSubmissionReview)
+	}
+
+	companyTags := mergeTags(original.CompanyTags, submission.Question.CompanyTags, maxQuestionCompanyTags)
+	if len(companyTags) > len(original.CompanyTags) {
+		original.CompanyTags = companyTags
+	} else {
+		original = nil
+	}
+
+	if err := s.questionRepo.MergeSubmission(ctx, id, reviewerID, normalizeComment(comment), duplicateOf, original); err != nil {
+		return nil, s.reviewError(ctx, id, err)
+	}
+
+	submission, err = s.questionRepo.GetSubmissionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("Ваш вопрос «%s» уже есть в банке вопросов и объединен с опубликованным. Спасибо за помощь!",
+		submission.Question.Title)
+	s.notifyAuthor(ctx, submission, models.NotificationTypeQuestionSubmissionMerged, "Вопрос объединен с опубликованным", body)
+
+	return submission, nil
+}
+
+func (s *QuestionSubmissionService) getSubmission(ctx context.Context, id int) (*models.QuestionSubmission, error) {
+	submission, err := s.questionRepo.GetSubmissionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSubmissionNotFound
+		}
+		return nil, err
+	}
+	return submission, nil
+}
+
+// reviewError определяет, почему вопрос не удалось изменить или рассмотреть
+func (s *QuestionSubmissionService) reviewError(ctx context.Context, id int, err error) error {
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	// Вопрос либо не существует, либо уже рассмотрен другим модератором
+	if _, getErr := s.getSubmission(ctx, id); getErr != nil {
+		return getErr
+	}
+	return ErrSubmissionReviewed
+}
+
+// notifyAuthor отправляет автору уведомление о решении по предложенному вопросу.
+// Ошибка уведомления не отменяет уже принятое решение, поэтому только логируется.
+func (s *QuestionSubmissionService) notifyAuthor(ctx context.Context, submission *models.QuestionSubmission, notificationType, title, body string) {
+	q := submission.Question
+	if q.AuthorID == nil {
+		return
+	}
+	payload := map[string]interface{}{
+		"questionId": q.ID,
+		"status":     q.Status,
+	}
+	if submission.DuplicateOf != nil {
+		payload["duplicateOf"] = *submission.DuplicateOf
+	}
+	if err := s.notificationService.Notify(ctx, *q.AuthorID, notificationType, title, body, payload); err != nil {
+		logger.Error("Failed to notify question author", zap.Int("question_id", q.ID), zap.Error(err))
+	}
+}
+
+// isVerifiedUser проверяет, что личность пользователя подтверждена: аккаунт привязан
+// к Telegram, Google или GitHub либо роль выдана после проверки (ментор, автор, модератор,
+// администратор). Подтверждения email в сервисе нет, поэтому одного email недостаточно.
+func isVerifiedUser(user *models.User) bool {
+	if user.Role != models.RoleUser {
+		return true
+	}
+	for _, id := range []*string{user.TelegramID, user.GoogleID, user.GithubID} {
+		if id != nil && *id != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// canViewSubmission проверяет, что неопубликованный вопрос может видеть пользователь:
+// тот, кто его предложил, модератор или администратор
+func canViewSubmission(q *models.Question, userID int, role string) bool {
+	if role == models.RoleModerator || role == models.RoleAdmin {
+		return true
+	}
+	return userID != 0 && q.AuthorID != nil && *q.AuthorID == userID
+}
+
+// mergeTags дополняет теги base тегами extra, которых в base нет без учета регистра,
+// пока тегов не больше limit
+func mergeTags(base, extra []string, limit int) []string {
+	result := append([]string(nil), base...)
+	seen := make(map[string]bool, len(base)+len(extra))
+	for _, tag := range base {
+		seen[strings.ToLower(tag)] = true
+	}
+	for _, tag := range extra {
+		if len(result) >= limit {
+			break
+		}
+		if key := strings.ToLower(tag); !seen[key] {
+			seen[key] = true
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// normalizeComment убирает пробелы вокруг комментария модератора, пустой комментарий - nil
+func normalizeComment(comment *string) *string {
+	if comment == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*comment)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/logger"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// subscriptionBuffer - сколько событий может ждать отправки одному подписчику
+const subscriptionBuffer = 32
+
+// Типы событий реального времени
+const (
+	RealtimeEventMessage = "message"
+	RealtimeEventRead    = "read"
+)
+
+// RealtimeEvent - событие, доставляемое пользователю в реальном времени
+type RealtimeEvent struct {
+	Type string
+	// Message заполнено для события message
+	Message *models.Message
+	// Receipt заполнено для события read
+	Receipt *models.ReadReceipt
+	// UnreadCount - общее количество непрочитанных сообщений получателя после события
+	UnreadCount int
+}
+
+// Subscription - подписка одного соединения на события пользователя.
+// Канал Events закрывается при отписке или если подписчик не успевает читать события.
+type Subscription struct {
+	userID int
+	events chan RealtimeEvent
+}
+
+// Events возвращает канал событий подписки
+func (s *Subscription) Events() <-chan RealtimeEvent {
+	return s.events
+}
+
+// RealtimeHub рассылает события подключенным пользователям в пределах одного экземпляра приложения.
+// У пользователя может быть несколько подписок (вкладки, устройства).
+type RealtimeHub struct {
+	mu   sync.Mutex
+	subs map[int]map[*Subscription]struct{}
+}
+
+func NewRealtimeHub() *RealtimeHub {
+	return &RealtimeHub{
+		subs: make(map[int]map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe регистрирует новую подписку на события пользователя
+func (h *RealtimeHub) Subscribe(userID int) *Subscription {
+	sub := &Subscription{
+		userID: userID,
+		events: make(chan RealtimeEvent, subscriptionBuffer),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[userID] == nil {
+		h.subs[userID] = make(map[*Subscription]struct{})
+	}
+	h.subs[userID][sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe удаляет подписку и закрывает ее канал. Повторный вызов безопасен.
+func (h *RealtimeHub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub)
+}
+
+// Publish отправляет событие всем подпискам пользователя без блокировки.
+// Переполненная подписка закрывается: клиент переподключится и догрузит историю через REST.
+func (h *RealtimeHub) Publish(userID int, event RealtimeEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs[userID] {
+		select {
+		case sub.events <- event:
+		default:
+			logger.Warn("Realtime subscriber is too slow, dropping connection", zap.Int("user_id", userID))
+			h.remove(sub)
+		}
+	}
+}
+
+// remove удаляет подписку. Вызывается под блокировкой.
+func (h *RealtimeHub) remove(sub *Subscription) {
+	subs, ok := h.subs[sub.userID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	close(sub.events)
+	if len(subs) == 0 {
+		delete(h.subs, sub.userID)
+	}
+}
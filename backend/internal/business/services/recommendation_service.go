@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"sort"
+)
+
+// maxWeakTechnologies - сколько слабых технологий учитывается при подборе менторов
+const maxWeakTechnologies = 5
+
+// weaknessPrior - априорное число ответов (половина верных, половина нет), которым сглаживается
+// доля ошибок, чтобы по одному-двум ответам технология не получала крайнюю оценку 1
+const weaknessPrior = 2.0
+
+// MentorRecommendations - результат подбора менторов под слабые технологии пользователя
+type MentorRecommendations struct {
+	Weaknesses []models.TechnologyWeakness
+	Items      []*models.MentorRecommendation
+}
+
+// RecommendationService подбирает менторов по истории ответов пользователя на вопросы
+type RecommendationService struct {
+	progressRepo *repositories.ProgressRepository
+	mentorRepo   *repositories.MentorRepository
+}
+
+func NewRecommendationService(progressRepo *repositories.ProgressRepository, mentorRepo *repositories.MentorRepository) *RecommendationService {
+	return &RecommendationService{
+		progressRepo: progressRepo,
+		mentorRepo:   mentorRepo,
+	}
+}
+
+// RecommendMentors находит самые слабые технологии пользователя и менторов, которые их закрывают.
+// Пока пользователь не ошибался ни в одном вопросе, рекомендаций нет.
+func (s *RecommendationService) RecommendMentors(ctx context.Context, userID, limit int) (*MentorRecommendations, error) {
+	stats, err := s.progressRepo.GetTechnologyStats(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MentorRecommendations{Weaknesses: weakestTechnologies(stats, maxWeakTechnologies)}
+	if len(result.Weaknesses) == 0 {
+		return result, nil
+	}
+
+	technologies := make([]string, 0, len(result.Weaknesses))
+	weights := make([]float64, 0, len(result.Weaknesses))
+	for _, w := range result.Weaknesses {
+		technologies = append(technologies, w.Technology)
+		weights = append(weights, w.Score)
+	}
+
+	result.Items, err = s.mentorRepo.GetMentorsCoveringTechnologies(ctx, technologies, weights, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// weakestTechnologies оценивает технологии по сглаженной доле ошибок и возвращает
+// не более n самых слабых. Технологии без ошибок слабыми не считаются.
+func weakestTechnologies(stats []models.TechnologyStat, n int) []models.TechnologyWeakness {
+	var weaknesses []models.TechnologyWeakness
+	for _, st := range stats {
+		if st.Incorrect == 0 {
+			continue
+		}
+		weaknesses = append(weaknesses, models.TechnologyWeakness{
+			TechnologyStat: st,
+			Score:          (float64(st.Incorrect) + weaknessPrior/2) / (float64(st.Answered) + weaknessPrior),
+		})
+	}
+
+	sort.SliceStable(weaknesses, func(i, j int) bool {
+		if weaknesses[i].Score != weaknesses[j].Score {
+			return weaknesses[i].Score > weaknesses[j].Score
+		}
+		return weaknesses[i].Incorrect > weaknesses[j].Incorrect
+	})
+
+	if len(weaknesses) > n {
+		weaknesses = weaknesses[:n]
+	}
+	return weaknesses
+}
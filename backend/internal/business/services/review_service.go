@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// pgUniqueViolation - код ошибки PostgreSQL при нарушении UNIQUE ограничения
+const pgUniqueViolation = "23505"
+
+// Ограничения на длину текстов отзыва
+const (
+	maxReviewTextLength   = 4000
+	maxReviewReplyLength  = 2000
+	maxReviewReasonLength = 1000
+)
+
+var (
+	// ErrReviewNotFound возвращается, если отзыв не найден
+	ErrReviewNotFound = errors.New("review not found")
+	// ErrReviewNotAllowed возвращается, если пользователь не может оставить отзыв или ответить на него
+	ErrReviewNotAllowed = errors.New("review action is not allowed for this user")
+	// ErrReviewExists возвращается при повторном отзыве на то же занятие
+	ErrReviewExists = errors.New("review for this booking already exists")
+	// ErrInvalidReview возвращается, если отзыв, ответ или жалоба заполнены некорректно
+	ErrInvalidReview = errors.New("invalid review")
+	// ErrAlreadyReported возвращается при повторной жалобе пользователя на отзыв
+	ErrAlreadyReported = errors.New("review already reported by this user")
+	// ErrReviewReportNotFound возвращается, если открытая жалоба не найдена
+	ErrReviewReportNotFound = errors.New("review report not found")
+)
+
+// ReviewService отвечает за отзывы о менторах, ответы на них и модерацию жалоб
+type ReviewService struct {
+	repo                *repositories.ReviewRepository
+	bookingService      *BookingService
+	mentorRepo          *repositories.MentorRepository
+	notificationService *NotificationService
+}
+
+func NewReviewService(repo *repositories.ReviewRepository, bookingService *BookingService, mentorRepo *repositories.MentorRepository, notificationService *NotificationService) *ReviewService {
+	return &ReviewService{
+		repo:                repo,
+		bookingService:      bookingService,
+		mentorRepo:          mentorRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Create оставляет отзыв о проведенном занятии. Доступно только ученику, один отзыв на занятие.
+func (s *ReviewService) Create(ctx context.Context, userID, bookingID, rating int, text *string) (*models.Review, error) {
+	if rating < 1 || rating > 5 {
+		return nil, fmt.Errorf("%w: rating must be between 1 and 5", ErrInvalidReview)
+	}
+	text, err := normalizeOptionalText(text, maxReviewTextLength)
+	if err != nil {
+		return nil, fmt.Errorf("%w: text %v", ErrInvalidReview, err)
+	}
+
+	booking, err := s.bookingService.Get(ctx, userID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.MenteeID != userID {
+		return nil, ErrReviewNotAllowed
+	}
+	if booking.Status != models.BookingCompleted {
+		return nil, fmt.Errorf("%w: only completed sessions can be reviewed", ErrReviewNotAllowed)
+	}
+
+	review := &models.Review{
+		BookingID: booking.ID,
+		MentorID:  booking.MentorID,
+		AuthorID:  userID,
+		Rating:    rating,
+		Text:      text,
+	}
+	if err := s.repo.CreateReview(ctx, review); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			return nil, ErrReviewExists
+		}
+		return nil, err
+	}
+
+	created, err := s.repo.GetReviewByID(ctx, review.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"reviewId":  created.ID,
+		"bookingId": created.BookingID,
+		"rating":    created.Rating,
+	}
+	err = s.notificationService.Notify(ctx, booking.MentorUserID, models.NotificationTypeReviewReceived,
+		"Новый отзыв",
+		fmt.Sprintf("%s оценил(а) занятие на %d из 5.", created.AuthorName, created.Rating),
+		payload)
+	if err != nil {
+		// Ошибка уведомления не отменяет отзыв
+		logger.Error("Failed to send review notification", zap.Int("review_id", created.ID), zap.Error(err))
+	}
+
+	return created, nil
+}
+
+// ListMentorReviews возвращает страницу опубликованных отзывов о менторе
+func (s *ReviewService) ListMentorReviews(ctx context.Context, mentorID, limit, offset int) ([]*models.Review, int, error) {
+	if _, err := s.mentorRepo.GetMentorByID(ctx, mentorID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, 0, ErrMentorNotFound
+		}
+		return nil, 0, err
+	}
+	return s.repo.GetMentorReviews(ctx, mentorID, limit, offset)
+}
+
+// Reply сохраняет ответ ментора на отзыв о нем. Повторный ответ заменяет предыдущий.
+func (s *ReviewService) Reply(ctx context.Context, userID, reviewID int, reply string) (*models.Review, error) {
+	text, err := normalizeOptionalText(&reply, maxReviewReplyLength)
+	if err != nil {
+		return nil, fmt.Errorf("%w: reply %v", ErrInvalidReview, err)
+	}
+	if text == nil {
+		return nil, fmt.Errorf("%w: reply is required", ErrInvalidReview)
+	}
+
+	review, err := s.get(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	mentorID, err := s.mentorRepo.GetMentorIDByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mentorID == nil || *mentorID != review.MentorID {
+		return nil, ErrReviewNotAllowed
+	}
+
+	if err := s.repo.SetReply(ctx, review.ID, *text); err != nil {
+		return nil, err
+	}
+	return s.repo.GetReviewByID(ctx, review.ID)
+}
+
+// Report отправляет жалобу на опубликованный отзыв на модерацию
+func (s *ReviewService) Report(ctx context.Context, userID, reviewID int, reason string) (*models.ReviewReport, error) {
+	text, err := normalizeOptionalText(&reason, maxReviewReasonLength)
+	if err != nil {
+		return nil, fmt.Errorf("%w: reason %v", ErrInvalidReview, err)
+	}
+	if text == nil {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidReview)
+	}
+
+	review, err := s.get(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status != models.ReviewPublished {
+		return nil, ErrReviewNotFound
+	}
+
+	report := &models.ReviewReport{
+		ReviewID:   review.ID,
+		ReporterID: userID,
+		Reason:     *text,
+	}
+	if err := s.repo.CreateReport(ctx, report); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			return nil, ErrAlreadyReported
+		}
+		return nil, err
+	}
+	report.Review = review
+
+	return report, nil
+}
+
+// ListReports возвращает страницу жалоб с указанным статусом для модерации
+func (s *ReviewService) ListReports(ctx context.Context, status string, limit, offset int) ([]*models.ReviewReport, int, error) {
+	return s.repo.GetReportsByStatus(ctx, status, limit, offset)
+}
+
+// Hide скрывает отзыв. Отзыв перестает учитываться в рейтинге, открытые жалобы закрываются как решенные.
+func (s *ReviewService) Hide(ctx context.Context, moderatorID, reviewID int) (*models.Review, error) {
+	return s.setStatus(ctx, moderatorID, reviewID, models.ReviewHidden, models.ReviewReportResolved)
+}
+
+// Restore возвращает скрытый отзыв в публикацию
+func (s *ReviewService) Restore(ctx context.Context, moderatorID, reviewID int) (*models.Review, error) {
+	return s.setStatus(ctx, moderatorID, reviewID, models.ReviewPublished, models.ReviewReportDismissed)
+}
+
+// DismissReport отклоняет жалобу, оставляя отзыв опубликованным
+func (s *ReviewService) DismissReport(ctx context.Context, moderatorID, reportID int) error {
+	if err := s.repo.DismissReport(ctx, reportID, moderatorID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrReviewReportNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// setStatus меняет статус отзыва модератором
+func (s *ReviewService) setStatus(ctx context.Context, moderatorID, reviewID int, status, reportStatus string) (*models.Review, error) {
+	if err := s.repo.SetStatus(ctx, reviewID, status, reportStatus, moderatorID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+	return s.repo.GetReviewByID(ctx, reviewID)
+}
+
+// get возвращает отзыв по ID
+func (s *ReviewService) get(ctx context.Context, reviewID int) (*models.Review, error) {
+	review, err := s.repo.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+	return review, nil
+}
@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/repositories"
+	"it_rabotyagi/internal/logger"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// maxNoteFieldLength - максимальная длина каждого раздела заметки в символах
+const maxNoteFieldLength = 4000
+
+var (
+	// ErrNoteNotFound возвращается, если заметка не найдена или недоступна пользователю
+	ErrNoteNotFound = errors.New("session note not found")
+	// ErrNoteForbidden возвращается, если пользователь не может создать или изменить заметку
+	ErrNoteForbidden = errors.New("session note action is not allowed for this user")
+	// ErrInvalidNote возвращается, если заметка заполнена некорректно
+	ErrInvalidNote = errors.New("invalid session note")
+)
+
+// SessionNoteInput - содержимое заметки о занятии
+type SessionNoteInput struct {
+	Visibility   string
+	Summary      string
+	Strengths    *string
+	Improvements *string
+	NextSteps    *string
+}
+
+// SessionNoteService отвечает за заметки ментора о занятиях.
+// Личные заметки видит только автор, общие - оба участника занятия.
+type SessionNoteService struct {
+	repo                *repositories.SessionNoteRepository
+	bookingService      *BookingService
+	notificationService *NotificationService
+}
+
+func NewSessionNoteService(repo *repositories.SessionNoteRepository, bookingService *BookingService, notificationService *NotificationService) *SessionNoteService {
+	return &SessionNoteService{
+		repo:                repo,
+		bookingService:      bookingService,
+		notificationService: notificationService,
+	}
+}
+
+// Create сохраняет заметку ментора о занятии. Об общей заметке ученик получает уведомление.
+func (s *SessionNoteService) Create(ctx context.Context, userID, bookingID int, input SessionNoteInput) (*models.SessionNote, error) {
+	note := &models.SessionNote{BookingID: bookingID, AuthorID: userID}
+	if err := applyNoteInput(note, input); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingService.Get(ctx, userID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.MentorUserID != userID {
+		return nil, ErrNoteForbidden
+	}
+	if booking.Status == models.BookingCancelled {
+		return nil, fmt.Errorf("%w: session is cancelled", ErrNoteForbidden)
+	}
+
+	if err := s.repo.CreateNote(ctx, note); err != nil {
+		return nil, err
+	}
+
+	if note.Visibility == models.NoteVisibilityShared {
+		s.notifyShared(ctx, booking, note)
+	}
+	return note, nil
+}
+
+// List возвращает заметки о занятии, доступные пользователю
+func (s *SessionNoteService) List(ctx context.Context, userID, bookingID int) ([]*models.SessionNote, error) {
+	if _, err := s.bookingService.Get(ctx, userID, bookingID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetBookingNotes(ctx, bookingID, userID)
+}
+
+// Update заменяет содержимое заметки. Изменять заметку может только автор.
+func (s *SessionNoteService) Update(ctx context.Context, userID, noteID int, input SessionNoteInput) (*models.SessionNote, error) {
+	note, booking, err := s.getOwn(ctx, userID, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	wasShared := note.Visibility == models.NoteVisibilityShared
+	if err := applyNoteInput(note, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateNote(ctx, note); err != nil {
+		return nil, err
+	}
+
+	if !wasShared && note.Visibility == models.NoteVisibilityShared {
+		s.notifyShared(ctx, booking, note)
+	}
+	return note, nil
+}
+
+// Delete удаляет заметку. Удалять заметку может только автор.
+func (s *SessionNoteService) Delete(ctx context.Context, userID, noteID int) error {
+	if _, _, err := s.getOwn(ctx, userID, noteID); err != nil {
+		return err
+	}
+	return s.repo.DeleteNote(ctx, noteID)
+}
+
+// getOwn возвращает заметку автора и ее занятие. Второму участнику общей заметки
+// возвращается ErrNoteForbidden, остальным заметка не раскрывается.
+func (s *SessionNoteService) getOwn(ctx context.Context, userID, noteID int) (*models.SessionNote, *models.Booking, error) {
+	note, err := s.repo.GetNoteByID(ctx, noteID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ErrNoteNotFound
+		}
+		return nil, nil, err
+	}
+
+	booking, err := s.bookingService.Get(ctx, userID, note.BookingID)
+	if err != nil {
+		if errors.Is(err, ErrBookingNotFound) {
+			return nil, nil, ErrNoteNotFound
+		}
+		return nil, nil, err
+	}
+
+	if note.AuthorID != userID {
+		if note.Visibility == models.NoteVisibilityShared {
+			return nil, nil, ErrNoteForbidden
+		}
+		return nil, nil, ErrNoteNotFound
+	}
+	return note, booking, nil
+}
+
+// notifyShared сообщает ученику об общей заметке. Ошибка уведомления только логируется.
+func (s *SessionNoteService) notifyShared(ctx context.Context, booking *models.Booking, note *models.SessionNote) {
+	payload := map[string]interface{}{
+		"bookingId": booking.ID,
+		"noteId":    note.ID,
+	}
+	err := s.notificationService.Notify(ctx, booking.MenteeID, models.NotificationTypeSessionNoteShared,
+		"Заметка о занятии",
+		fmt.Sprintf("%s поделился(ась) заметкой о занятии %s.", booking.MentorName, formatBookingTime(booking)),
+		payload)
+	if err != nil {
+		logger.Error("Failed to send session note notification", zap.Int("note_id", note.ID), zap.Error(err))
+	}
+}
+
+// applyNoteInput проверяет содержимое заметки и переносит его в note
+func applyNoteInput(note *models.SessionNote, input SessionNoteInput) error {
+	visibility := input.Visibility
+	if visibility == "" {
+		visibility = models.NoteVisibilityPrivate
+	}
+	if visibility != models.NoteVisibilityPrivate && visibility != models.NoteVisibilityShared {
+		return fmt.Errorf("%w: unknown visibility %q", ErrInvalidNote, visibility)
+	}
+
+	summary := strings.TrimSpace(input.Summary)
+	if summary == "" {
+		return fmt.Errorf("%w: summary is required", ErrInvalidNote)
+	}
+	if utf8.RuneCountInString(summary) > maxNoteFieldLength {
+		return fmt.Errorf("%w: summary must be at most %d characters", ErrInvalidNote, maxNoteFieldLength)
+	}
+
+	strengths, err := normalizeOptionalText(input.Strengths, maxNoteFieldLength)
+	if err != nil {
+		return fmt.Errorf("%w: strengths %v", ErrInvalidNote, err)
+	}
+	improvements, err := normalizeOptionalText(input.Improvements, maxNoteFieldLength)
+	if err != nil {
+		return fmt.Errorf("%w: improvements %v", ErrInvalidNote, err)
+	}
+	nextSteps, err := normalizeOptionalText(input.NextSteps, maxNoteFieldLength)
+	if err != nil {
+		return fmt.Errorf("%w: nextSteps %v", ErrInvalidNote, err)
+	}
+
+	note.Visibility = visibility
+	note.Summary = summary
+	note.Strengths = strengths
+	note.Improvements = improvements
+	note.NextSteps = nextSteps
+	return nil
+}
+
+// normalizeOptionalText обрезает пробелы и проверяет длину необязательного текста.
+// Пустой текст превращается в nil.
+func normalizeOptionalText(text *string, maxLength int) (*string, error) {
+	if text == nil {
+		return nil, nil
+	}
+	trimmed := strings.TrimSpace(*text)
+	if trimmed == "" {
+		return nil, nil
+	}
+	if utf8.RuneCountInString(trimmed) > maxLength {
+		return nil, fmt.Errorf("must be at most %d characters", maxLength)
+	}
+	return &trimmed, nil
+}
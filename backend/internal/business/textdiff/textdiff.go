@@ -0,0 +1,97 @@
+// Package textdiff строит построчную разницу двух текстов по наибольшей общей подпоследовательности строк
+package textdiff
+
+import (
+	"it_rabotyagi/internal/business/models"
+	"strings"
+)
+
+// maxTableSize ограничивает таблицу подпоследовательностей. Если измененная часть текстов больше,
+// она показывается целиком удаленной и добавленной.
+const maxTableSize = 4_000_000
+
+// Lines возвращает строки, общие для a и b, удаленные из a и добавленные в b, в порядке текста
+func Lines(a, b string) []models.DiffLine {
+	x := splitLines(a)
+	y := splitLines(b)
+
+	// Общие начало и конец не участвуют в поиске подпоследовательности
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	var result []models.DiffLine
+	for _, line := range x[:prefix] {
+		result = append(result, models.DiffLine{Op: models.DiffEqual, Text: line})
+	}
+	result = append(result, middle(x[prefix:len(x)-suffix], y[prefix:len(y)-suffix])...)
+	for _, line := range x[len(x)-suffix:] {
+		result = append(result, models.DiffLine{Op: models.DiffEqual, Text: line})
+	}
+	return result
+}
+
+// middle сравнивает измененную часть текстов
+func middle(x, y []string) []models.DiffLine {
+	var result []models.DiffLine
+	if len(x)*len(y) > maxTableSize {
+		for _, line := range x {
+			result = append(result, models.DiffLine{Op: models.DiffDelete, Text: line})
+		}
+		for _, line := range y {
+			result = append(result, models.DiffLine{Op: models.DiffInsert, Text: line})
+		}
+		return result
+	}
+
+	// lcs[i][j] - длина наибольшей общей подпоследовательности x[i:] и y[j:]
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			result = append(result, models.DiffLine{Op: models.DiffEqual, Text: x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, models.DiffLine{Op: models.DiffDelete, Text: x[i]})
+			i++
+		default:
+			result = append(result, models.DiffLine{Op: models.DiffInsert, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		result = append(result, models.DiffLine{Op: models.DiffDelete, Text: x[i]})
+	}
+	for ; j < len(y); j++ {
+		result = append(result, models.DiffLine{Op: models.DiffInsert, Text: y[j]})
+	}
+	return result
+}
+
+// splitLines делит текст на строки; пустой текст не содержит строк
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
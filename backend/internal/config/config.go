@@ -3,13 +3,17 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Auth     AuthConfig
-	Logger   LoggerConfig
+	Server        ServerConfig
+	Database      DatabaseConfig
+	Auth          AuthConfig
+	Logger        LoggerConfig
+	Notifications NotificationsConfig
+	Payments      PaymentsConfig
+	Events        EventsConfig
 }
 
 type ServerConfig struct {
@@ -26,6 +30,31 @@ type DatabaseConfig struct {
 	URL string
 }
 
+type NotificationsConfig struct {
+	SMTPHost         string
+	SMTPPort         string
+	SMTPUsername     string
+	SMTPPassword     string
+	SMTPFrom         string
+	TelegramBotToken string
+}
+
+type PaymentsConfig struct {
+	Provider      string
+	WebhookSecret string
+	// Политика возврата при отмене занятия учеником
+	FullRefundHours      int
+	PartialRefundHours   int
+	PartialRefundPercent int
+}
+
+type EventsConfig struct {
+	// За сколько минут до начала события участникам приходит напоминание
+	ReminderLeadMinutes int
+	// Как часто проверять, не пора ли отправить напоминания, в секундах
+	ReminderIntervalSeconds int
+}
+
 type AuthConfig struct {
 	Secret          string
 	TokenDuration   int // в минутах
@@ -50,6 +79,25 @@ func Load() (*Config, error) {
 		Logger: LoggerConfig{
 			Level: getEnv("LOGGER_LEVEL", "info"),
 		},
+		Notifications: NotificationsConfig{
+			SMTPHost:         getEnv("SMTP_HOST", ""),
+			SMTPPort:         getEnv("SMTP_PORT", "587"),
+			SMTPUsername:     getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:     getEnv("SMTP_PASSWORD", ""),
+			SMTPFrom:         getEnv("SMTP_FROM", ""),
+			TelegramBotToken: getEnv("TELEGRAM_BOT_TOKEN", ""),
+		},
+		Payments: PaymentsConfig{
+			Provider:             getEnv("PAYMENTS_PROVIDER", "fake"),
+			WebhookSecret:        getEnv("PAYMENTS_WEBHOOK_SECRET", ""),
+			FullRefundHours:      getEnvInt("CANCELLATION_FULL_REFUND_HOURS", 24),
+			PartialRefundHours:   getEnvInt("CANCELLATION_PARTIAL_REFUND_HOURS", 2),
+			PartialRefundPercent: getEnvInt("CANCELLATION_PARTIAL_REFUND_PERCENT", 50),
+		},
+		Events: EventsConfig{
+			ReminderLeadMinutes:     getEnvInt("EVENT_REMINDER_LEAD_MINUTES", 24*60),
+			ReminderIntervalSeconds: getEnvInt("EVENT_REMINDER_INTERVAL_SECONDS", 60),
+		},
 	}
 
 	if cfg.Database.URL == "" {
@@ -60,6 +108,14 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("JWT_SECRET is required")
 	}
 
+	if cfg.Payments.PartialRefundPercent < 0 || cfg.Payments.PartialRefundPercent > 100 {
+		return nil, fmt.Errorf("CANCELLATION_PARTIAL_REFUND_PERCENT must be between 0 and 100")
+	}
+
+	if cfg.Events.ReminderIntervalSeconds <= 0 {
+		return nil, fmt.Errorf("EVENT_REMINDER_INTERVAL_SECONDS must be positive")
+	}
+
 	return cfg, nil
 }
 
@@ -69,3 +125,10 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvInt(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+	"time"
+)
+
+type AvailabilityRepository struct {
+	db *database.DB
+}
+
+func NewAvailabilityRepository(db *database.DB) *AvailabilityRepository {
+	return &AvailabilityRepository{db: db}
+}
+
+// GetTimezone получает часовой пояс, в котором ментор ведет расписание
+func (r *AvailabilityRepository) GetTimezone(ctx context.Context, mentorID int) (string, error) {
+	query := `SELECT timezone FROM mentors WHERE id = $1`
+
+	var timezone string
+	err := r.db.Pool.QueryRow(ctx, query, mentorID).Scan(&timezone)
+	return timezone, err
+}
+
+// GetRules получает еженедельные окна доступности ментора
+func (r *AvailabilityRepository) GetRules(ctx context.Context, mentorID int) ([]models.AvailabilityRule, error) {
+	query := `SELECT id, mentor_id, weekday, to_char(start_time, 'HH24:MI'), to_char(end_time, 'HH24:MI')
+              FROM mentor_availability_rules
+              WHERE mentor_id = $1
+              ORDER BY weekday, start_time`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []models.AvailabilityRule
+	for rows.Next() {
+		var rule models.AvailabilityRule
+		var weekday int
+		if err := rows.Scan(&rule.ID, &rule.MentorID, &weekday, &rule.StartTime, &rule.EndTime); err != nil {
+			return nil, err
+		}
+		rule.Weekday = time.Weekday(weekday)
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// ReplaceSchedule заменяет часовой пояс и все еженедельные окна ментора
+func (r *AvailabilityRepository) ReplaceSchedule(ctx context.Context, mentorID int, timezone string, rules []models.AvailabilityRule) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, `UPDATE mentors SET timezone = $2, updated_at = now() WHERE id = $1`, mentorID, timezone)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM mentor_availability_rules WHERE mentor_id = $1`, mentorID)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		_, err = tx.Exec(ctx, `INSERT INTO mentor_availability_rules (mentor_id, weekday, start_time, end_time)
+              VALUES ($1, $2, $3::time, $4::time)`, mentorID, int(rule.Weekday), rule.StartTime, rule.EndTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetExceptions получает исключения из расписания, пересекающиеся с интервалом [from, to)
+func (r *AvailabilityRepository) GetExceptions(ctx context.Context, mentorID int, from, to time.Time) ([]models.AvailabilityException, error) {
+	query := `SELECT id, mentor_id, starts_at, ends_at, is_available, reason, created_at
+              FROM mentor_availability_exceptions
+              WHERE mentor_id = $1 AND starts_at < $3 AND ends_at > $2
+              ORDER BY starts_at`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var exceptions []models.AvailabilityException
+	for rows.Next() {
+		var e models.AvailabilityException
+		if err := rows.Scan(&e.ID, &e.MentorID, &e.StartsAt, &e.EndsAt, &e.IsAvailable, &e.Reason, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		exceptions = append(exceptions, e)
+	}
+
+	return exceptions, rows.Err()
+}
+
+// CreateException сохраняет исключение из расписания и заполняет его ID и дату создания
+func (r *AvailabilityRepository) CreateException(ctx context.Context, e *models.AvailabilityException) error {
+	query := `INSERT INTO mentor_availability_exceptions (mentor_id, starts_at, ends_at, is_available, reason)
+              VALUES ($1, $2, $3, $4, $5)
+              RETURNING id, created_at`
+
+	return r.db.Pool.QueryRow(ctx, query, e.MentorID, e.StartsAt, e.EndsAt, e.IsAvailable, e.Reason).
+		Scan(&e.ID, &e.CreatedAt)
+}
+
+// DeleteException удаляет исключение ментора. Возвращает false, если исключение не найдено.
+func (r *AvailabilityRepository) DeleteException(ctx context.Context, mentorID, id int) (bool, error) {
+	tag, err := r.db.Pool.Exec(ctx, `DELETE FROM mentor_availability_exceptions WHERE id = $1 AND mentor_id = $2`, id, mentorID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,264 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type BookingRepository struct {
+	db *database.DB
+}
+
+func NewBookingRepository(db *database.DB) *BookingRepository {
+	return &BookingRepository{db: db}
+}
+
+// bookingColumns - общий список колонок для выборки бронирования вместе с именами участников
+const bookingColumns = `b.id, b.mentor_id, COALESCE(mu.name, mu.username) AS mentor_name, m.user_id,
+              b.mentee_id, COALESCE(u.name, u.username) AS mentee_name, b.starts_at, b.ends_at,
+              b.status, b.comment, b.cancel_reason, b.cancelled_by, b.sequence, b.created_at, b.updated_at`
+
+// bookingJoins - соединения, необходимые для bookingColumns
+const bookingJoins = `FROM bookings b
+              JOIN mentors m ON m.id = b.mentor_id
+              JOIN users mu ON mu.id = m.user_id
+              JOIN users u ON u.id = b.mentee_id`
+
+// CreateBooking сохраняет новое бронирование и заполняет его ID, статус и даты.
+// Пересечение с активным бронированием ментора отклоняется ограничением bookings_no_overlap.
+func (r *BookingRepository) CreateBooking(ctx context.Context, b *models.Booking) error {
+	query := `INSERT INTO bookings (mentor_id, mentee_id, starts_at, ends_at, comment)
+              VALUES ($1, $2, $3, $4, $5)
+              RETURNING id, status, created_at, updated_at`
+
+	return r.db.Pool.QueryRow(ctx, query, b.MentorID, b.MenteeID, b.StartsAt, b.EndsAt, b.Comment).
+		Scan(&b.ID, &b.Status, &b.CreatedAt, &b.UpdatedAt)
+}
+
+// GetBookingByID получает бронирование по ID
+func (r *BookingRepository) GetBookingByID(ctx context.Context, id int) (*models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
+              ` + bookingJoins + `
+              WHERE b.id = $1`
+
+	return scanBooking(r.db.Pool.QueryRow(ctx, query, id))
+}
+
+// GetActiveBookingsInRange получает бронирования ментора в статусах requested и confirmed,
+// пересекающиеся с интервалом [from, to)
+func (r *BookingRepository) GetActiveBookingsInRange(ctx context.Context, mentorID int, from, to time.Time) ([]*models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
+              ` + bookingJoins + `
+              WHERE b.mentor_id = $1 AND b.status IN ('requested', 'confirmed')
+                AND b.starts_at < $3 AND b.ends_at > $2
+              ORDER BY b.starts_at`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectBookings(rows)
+}
+
+// GetMenteeBookings получает страницу бронирований ученика и их общее количество
+func (r *BookingRepository) GetMenteeBookings(ctx context.Context, menteeID int, status *string, limit, offset int) ([]*models.Booking, int, error) {
+	return r.listBookings(ctx, "b.mentee_id", menteeID, status, limit, offset)
+}
+
+// GetMentorBookings получает страницу бронирований ментора и их общее количество
+func (r *BookingRepository) GetMentorBookings(ctx context.Context, mentorID int, status *string, limit, offset int) ([]*models.Booking, int, error) {
+	return r.listBookings(ctx, "b.mentor_id", mentorID, status, limit, offset)
+}
+
+// listBookings получает бронирования по владельцу (ученику или ментору), начиная с самых поздних
+func (r *BookingRepository) listBookings(ctx context.Context, ownerColumn string, ownerID int, status *string, limit, offset int) ([]*models.Booking, int, error) {
+	args := []interface{}{ownerID}
+	where := " WHERE " + ownerColumn + " = $1"
+	if status != nil && *status != "" {
+		args = append(args, *status)
+		where += fmt.Sprintf(" AND b.status = $%d", len(args))
+	}
+
+	var total int
+	if err := r.db.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM bookings b`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	query := `SELECT ` + bookingColumns + `
+              ` + bookingJoins + where + fmt.Sprintf(`
+              ORDER BY b.starts_at DESC, b.id DESC
+              LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	bookings, err := collectBookings(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bookings, total, nil
+}
+
+// GetCalendarBookings получает занятия пользователя (как ученика и как ментора) для ленты
+// календаря: подтвержденные и проведенные, а также отмененные после cancelledSince,
+// чтобы подписанные календари успели удалить их
+func (r *BookingRepository) GetCalendarBookings(ctx context.Context, userID int, cancelledSince time.Time) ([]*models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
+              ` + bookingJoins + `
+              WHERE (b.mentee_id = $1 OR m.user_id = $1)
+                AND (b.status IN ('confirmed', 'completed')
+                     OR (b.status = 'cancelled' AND b.updated_at >= $2))
+              ORDER BY b.starts_at`
+
+	rows, err := r.db.Pool.Query(ctx, query, userID, cancelledSince)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectBookings(rows)
+}
+
+// GetMentorUpcomingBookings получает ближайшие активные (requested, confirmed) занятия ментора
+func (r *BookingRepository) GetMentorUpcomingBookings(ctx context.Context, mentorID int, now time.Time, limit int) ([]*models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
+              ` + bookingJoins + `
+              WHERE b.mentor_id = $1 AND b.status IN ('requested', 'confirmed') AND b.ends_at > $2
+              ORDER BY b.starts_at, b.id
+              LIMIT $3`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectBookings(rows)
+}
+
+// GetMentorPastBookings получает последние начавшиеся занятия ментора, начиная с самых поздних
+func (r *BookingRepository) GetMentorPastBookings(ctx context.Context, mentorID int, now time.Time, limit int) ([]*models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
+              ` + bookingJoins + `
+              WHERE b.mentor_id = $1 AND b.starts_at <= $2
+              ORDER BY b.starts_at DESC, b.id DESC
+              LIMIT $3`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectBookings(rows)
+}
+
+// GetMentorCompletedBookings получает проведенные занятия ментора, начавшиеся не раньше since
+func (r *BookingRepository) GetMentorCompletedBookings(ctx context.Context, mentorID int, since time.Time) ([]*models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
+              ` + bookingJoins + `
+              WHERE b.mentor_id = $1 AND b.status = 'completed' AND b.starts_at >= $2
+              ORDER BY b.starts_at`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectBookings(rows)
+}
+
+// CountMentorBookings считает занятия ментора по статусам и количество предстоящих
+func (r *BookingRepository) CountMentorBookings(ctx context.Context, mentorID int, now time.Time) (*models.SessionStats, error) {
+	query := `SELECT COUNT(*),
+                     COUNT(*) FILTER (WHERE status IN ('requested', 'confirmed') AND ends_at > $2),
+                     COUNT(*) FILTER (WHERE status = 'completed'),
+                     COUNT(*) FILTER (WHERE status = 'no_show'),
+                     COUNT(*) FILTER (WHERE status = 'cancelled')
+              FROM bookings
+              WHERE mentor_id = $1`
+
+	stats := &models.SessionStats{}
+	err := r.db.Pool.QueryRow(ctx, query, mentorID, now).
+		Scan(&stats.Total, &stats.Upcoming, &stats.Completed, &stats.NoShow, &stats.Cancelled)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// UpdateStatus переводит бронирование из статуса from в статус to.
+// Возвращает pgx.ErrNoRows, если бронирование уже находится в другом статусе.
+func (r *BookingRepository) UpdateStatus(ctx context.Context, id int, from, to string, cancelledBy *int, cancelReason *string) error {
+	query := `UPDATE bookings
+              SET status = $3, cancelled_by = $4, cancel_reason = $5, updated_at = now()
+              WHERE id = $1 AND status = $2
+              RETURNING id`
+
+	var bookingID int
+	return r.db.Pool.QueryRow(ctx, query, id, from, to, cancelledBy, cancelReason).Scan(&bookingID)
+}
+
+// Reschedule переносит активное бронирование на новое время, если его статус все еще status.
+// Триггер увеличивает sequence, поэтому календари заменяют событие.
+func (r *BookingRepository) Reschedule(ctx context.Context, id int, status string, startsAt, endsAt time.Time) error {
+	query := `UPDATE bookings
+              SET starts_at = $3, ends_at = $4, updated_at = now()
+              WHERE id = $1 AND status = $2
+              RETURNING id`
+
+	var bookingID int
+	return r.db.Pool.QueryRow(ctx, query, id, status, startsAt, endsAt).Scan(&bookingID)
+}
+
+// collectBookings читает все бронирования из результата запроса
+func collectBookings(rows pgx.Rows) ([]*models.Booking, error) {
+	var bookings []*models.Booking
+	for rows.Next() {
+		b, err := scanBooking(rows)
+		if err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, b)
+	}
+	return bookings, rows.Err()
+}
+
+// scanBooking читает бронирование из строки результата, выбранной с колонками bookingColumns
+func scanBooking(row pgx.Row) (*models.Booking, error) {
+	b := &models.Booking{}
+	err := row.Scan(
+		&b.ID,
+		&b.MentorID,
+		&b.MentorName,
+		&b.MentorUserID,
+		&b.MenteeID,
+		&b.MenteeName,
+		&b.StartsAt,
+		&b.EndsAt,
+		&b.Status,
+		&b.Comment,
+		&b.CancelReason,
+		&b.CancelledBy,
+		&b.Sequence,
+		&b.CreatedAt,
+		&b.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
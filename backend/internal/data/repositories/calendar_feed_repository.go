@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"it_rabotyagi/internal/data/database"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type CalendarFeedRepository struct {
+	db *database.DB
+}
+
+func NewCalendarFeedRepository(db *database.DB) *CalendarFeedRepository {
+	return &CalendarFeedRepository{db: db}
+}
+
+// ReplaceToken сохраняет новый токен ленты пользователя и возвращает время его создания.
+// Предыдущий токен пользователя перестает действовать.
+func (r *CalendarFeedRepository) ReplaceToken(ctx context.Context, userID int, token string) (time.Time, error) {
+	query := `INSERT INTO calendar_feed_tokens (user_id, token_hash)
+              VALUES ($1, $2)
+              ON CONFLICT (user_id) DO UPDATE
+              SET token_hash = EXCLUDED.token_hash, created_at = now()
+              RETURNING created_at`
+
+	var createdAt time.Time
+	err := r.db.Pool.QueryRow(ctx, query, userID, HashToken(token)).Scan(&createdAt)
+	return createdAt, err
+}
+
+// DeleteToken удаляет токен ленты пользователя
+func (r *CalendarFeedRepository) DeleteToken(ctx context.Context, userID int) error {
+	_, err := r.db.Pool.Exec(ctx, `DELETE FROM calendar_feed_tokens WHERE user_id = $1`, userID)
+	return err
+}
+
+// GetUserIDByToken получает владельца токена ленты. Возвращает nil, если токен не найден.
+func (r *CalendarFeedRepository) GetUserIDByToken(ctx context.Context, token string) (*int, error) {
+	query := `SELECT user_id FROM calendar_feed_tokens WHERE token_hash = $1`
+
+	var userID int
+	err := r.db.Pool.QueryRow(ctx, query, HashToken(token)).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &userID, nil
+}
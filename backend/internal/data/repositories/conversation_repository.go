@@ -0,0 +1,222 @@
+package repositories
+
+import (
+	"context"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+	"time"
+)
+
+type ConversationRepository struct {
+	db *database.DB
+}
+
+func NewConversationRepository(db *database.DB) *ConversationRepository {
+	return &ConversationRepository{db: db}
+}
+
+// conversationColumns - общий список колонок для выборки диалога с именами участников
+const conversationColumns = `c.id, c.mentor_id, m.user_id, COALESCE(mu.name, mu.username) AS mentor_name,
+              c.mentee_id, COALESCE(u.name, u.username) AS mentee_name,
+              c.mentor_last_read_id, c.mentee_last_read_id, c.last_message_at, c.created_at`
+
+// conversationJoins - соединения, необходимые для conversationColumns
+const conversationJoins = `FROM conversations c
+              JOIN mentors m ON m.id = c.mentor_id
+              JOIN users mu ON mu.id = m.user_id
+              JOIN users u ON u.id = c.mentee_id`
+
+// unreadCondition - условие непрочитанного сообщения msg для пользователя $1 в диалоге c
+const unreadCondition = `msg.sender_id <> $1
+                AND msg.id > CASE WHEN c.mentee_id = $1 THEN c.mentee_last_read_id ELSE c.mentor_last_read_id END`
+
+// GetConversationByID получает диалог по ID
+func (r *ConversationRepository) GetConversationByID(ctx context.Context, id int) (*models.Conversation, error) {
+	query := `SELECT ` + conversationColumns + `
+              ` + conversationJoins + `
+              WHERE c.id = $1`
+
+	c := &models.Conversation{}
+	err := r.db.Pool.QueryRow(ctx, query, id).Scan(conversationFields(c)...)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetUserConversations получает страницу диалогов пользователя, начиная с последней активности,
+// вместе с последним сообщением и количеством непрочитанных сообщений в каждом
+func (r *ConversationRepository) GetUserConversations(ctx context.Context, userID, limit, offset int) ([]*models.Conversation, int, error) {
+	var total int
+	countQuery := `SELECT COUNT(*)
+              FROM conversations c
+              JOIN mentors m ON m.id = c.mentor_id
+              WHERE c.mentee_id = $1 OR m.user_id = $1`
+	if err := r.db.Pool.QueryRow(ctx, countQuery, userID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + conversationColumns + `,
+                     lm.id, lm.sender_id, lm.body, lm.created_at,
+                     (SELECT COUNT(*) FROM messages msg
+                      WHERE msg.conversation_id = c.id AND ` + unreadCondition + `) AS unread_count
+              ` + conversationJoins + `
+              LEFT JOIN LATERAL (
+                  SELECT id, sender_id, body, created_at
+                  FROM messages
+                  WHERE conversation_id = c.id
+                  ORDER BY id DESC
+                  LIMIT 1
+              ) lm ON true
+              WHERE c.mentee_id = $1 OR m.user_id = $1
+              ORDER BY COALESCE(c.last_message_at, c.created_at) DESC, c.id DESC
+              LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Pool.Query(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var conversations []*models.Conversation
+	for rows.Next() {
+		c := &models.Conversation{}
+		var (
+			lastID        *int
+			lastSenderID  *int
+			lastBody      *string
+			lastCreatedAt *time.Time
+		)
+		fields := append(conversationFields(c), &lastID, &lastSenderID, &lastBody, &lastCreatedAt, &c.UnreadCount)
+		if err := rows.Scan(fields...); err != nil {
+			return nil, 0, err
+		}
+		if lastID != nil {
+			c.LastMessage = &models.Message{
+				ID:             *lastID,
+				ConversationID: c.ID,
+				SenderID:       *lastSenderID,
+				Body:           *lastBody,
+				CreatedAt:      *lastCreatedAt,
+			}
+		}
+		conversations = append(conversations, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return conversations, total, nil
+}
+
+// CountUnread возвращает количество непрочитанных сообщений пользователя во всех диалогах
+func (r *ConversationRepository) CountUnread(ctx context.Context, userID int) (int, error) {
+	query := `SELECT COUNT(*)
+              FROM conversations c
+              JOIN mentors m ON m.id = c.mentor_id
+              JOIN messages msg ON msg.conversation_id = c.id
+              WHERE (c.mentee_id = $1 OR m.user_id = $1)
+                AND ` + unreadCondition
+
+	var count int
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(&count)
+	return count, err
+}
+
+// CreateMessage сохраняет сообщение и обновляет время последней активности диалога.
+// Собственные сообщения сразу считаются прочитанными отправителем.
+func (r *ConversationRepository) CreateMessage(ctx context.Context, msg *models.Message) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = tx.QueryRow(ctx, `INSERT INTO messages (conversation_id, sender_id, body)
+              VALUES ($1, $2, $3)
+              RETURNING id, created_at`,
+		msg.ConversationID, msg.SenderID, msg.Body,
+	).Scan(&msg.ID, &msg.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE conversations
+              SET last_message_at = $3,
+                  mentee_last_read_id = CASE WHEN mentee_id = $2 THEN GREATEST(mentee_last_read_id, $4) ELSE mentee_last_read_id END,
+                  mentor_last_read_id = CASE WHEN mentee_id <> $2 THEN GREATEST(mentor_last_read_id, $4) ELSE mentor_last_read_id END
+              WHERE id = $1`,
+		msg.ConversationID, msg.SenderID, msg.CreatedAt, msg.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetMessages получает сообщения диалога от новых к старым.
+// Если cursor задан, возвращаются сообщения с ID меньше курсора.
+func (r *ConversationRepository) GetMessages(ctx context.Context, conversationID int, cursor *int, limit int) ([]*models.Message, error) {
+	query := `SELECT id, conversation_id, sender_id, body, created_at
+              FROM messages
+              WHERE conversation_id = $1
+                AND ($2::int IS NULL OR id < $2)
+              ORDER BY id DESC
+              LIMIT $3`
+
+	rows, err := r.db.Pool.Query(ctx, query, conversationID, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*models.Message
+	for rows.Next() {
+		msg := &models.Message{}
+		err := rows.Scan(&msg.ID, &msg.ConversationID, &msg.SenderID, &msg.Body, &msg.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+
+	return messages, rows.Err()
+}
+
+// MarkRead сдвигает отметку прочтения участника до сообщения upTo, но не дальше последнего
+// сообщения диалога. Отметка не сдвигается назад. Возвращает итоговый ID последнего прочитанного.
+func (r *ConversationRepository) MarkRead(ctx context.Context, conversationID, userID int, upTo *int) (int, error) {
+	query := `WITH target AS (
+                  SELECT LEAST(COALESCE($3::int, MAX(id)), MAX(id)) AS id
+                  FROM messages
+                  WHERE conversation_id = $1
+              )
+              UPDATE conversations c
+              SET mentee_last_read_id = CASE WHEN c.mentee_id = $2
+                      THEN GREATEST(c.mentee_last_read_id, COALESCE(t.id, 0)) ELSE c.mentee_last_read_id END,
+                  mentor_last_read_id = CASE WHEN c.mentee_id <> $2
+                      THEN GREATEST(c.mentor_last_read_id, COALESCE(t.id, 0)) ELSE c.mentor_last_read_id END
+              FROM target t
+              WHERE c.id = $1
+              RETURNING CASE WHEN c.mentee_id = $2 THEN c.mentee_last_read_id ELSE c.mentor_last_read_id END`
+
+	var lastRead int
+	err := r.db.Pool.QueryRow(ctx, query, conversationID, userID, upTo).Scan(&lastRead)
+	return lastRead, err
+}
+
+// conversationFields возвращает указатели на поля диалога в порядке conversationColumns
+func conversationFields(c *models.Conversation) []interface{} {
+	return []interface{}{
+		&c.ID,
+		&c.MentorID,
+		&c.MentorUserID,
+		&c.MentorName,
+		&c.MenteeID,
+		&c.MenteeName,
+		&c.MentorLastReadID,
+		&c.MenteeLastReadID,
+		&c.LastMessageAt,
+		&c.CreatedAt,
+	}
+}
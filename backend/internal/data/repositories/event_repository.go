@@ -0,0 +1,455 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type EventRepository struct {
+	db *database.DB
+}
+
+func NewEventRepository(db *database.DB) *EventRepository {
+	return &EventRepository{db: db}
+}
+
+// eventColumns - общий список колонок для выборки события вместе с ведущим,
+// заполненностью и регистрацией пользователя, ID которого передан параметром $1
+const eventColumns = `e.id, e.mentor_id, m.user_id, COALESCE(u.name, u.username) AS host_name,
+              e.type, e.title, e.description, e.starts_at, e.ends_at, e.capacity, e.status,
+              (SELECT COUNT(*) FROM event_registrations r
+               WHERE r.event_id = e.id AND r.status = 'registered') AS registered_count,
+              (SELECT COUNT(*) FROM event_registrations r
+               WHERE r.event_id = e.id AND r.status = 'waitlisted') AS waitlist_count,
+              vr.status AS viewer_status,
+              CASE WHEN vr.status = 'waitlisted' THEN (
+                  SELECT COUNT(*)::int FROM event_registrations w
+                  WHERE w.event_id = e.id AND w.status = 'waitlisted'
+                    AND (w.queued_at, w.id) <= (vr.queued_at, vr.id)
+              ) END AS waitlist_position,
+              e.created_at, e.updated_at`
+
+// eventJoins - соединения, необходимые для eventColumns
+const eventJoins = `FROM events e
+              JOIN mentors m ON m.id = e.mentor_id
+              JOIN users u ON u.id = m.user_id
+              LEFT JOIN event_registrations vr
+                     ON vr.event_id = e.id AND vr.user_id = $1 AND vr.status <> 'cancelled'`
+
+// registrationColumns - общий список колонок для выборки регистрации вместе с участником
+const registrationColumns = `r.id, r.event_id, r.user_id, COALESCE(u.name, u.username) AS user_name,
+              u.avatar_url, r.status, r.attended, r.queued_at, r.created_at`
+
+// CreateEvent сохраняет новое событие и заполняет его ID, статус и даты
+func (r *EventRepository) CreateEvent(ctx context.Context, e *models.Event) error {
+	query := `INSERT INTO events (mentor_id, type, title, description, starts_at, ends_at, capacity)
+              VALUES ($1, $2, $3, $4, $5, $6, $7)
+              RETURNING id, status, created_at, updated_at`
+
+	return r.db.Pool.QueryRow(ctx, query,
+		e.MentorID,
+		e.Type,
+		e.Title,
+		e.Description,
+		e.StartsAt,
+		e.EndsAt,
+		e.Capacity,
+	).Scan(&e.ID, &e.Status, &e.CreatedAt, &e.UpdatedAt)
+}
+
+// GetEventByID получает событие по ID с регистрацией пользователя viewerID (0 - аноним)
+func (r *EventRepository) GetEventByID(ctx context.Context, id, viewerID int) (*models.Event, error) {
+	query := `SELECT ` + eventColumns + `
+              ` + eventJoins + `
+              WHERE e.id = $2`
+
+	return scanEvent(r.db.Pool.QueryRow(ctx, query, viewerID, id))
+}
+
+// ListUpcomingEvents получает страницу запланированных событий, начинающихся не раньше filter.From
+func (r *EventRepository) ListUpcomingEvents(ctx context.Context, filter models.EventFilter, viewerID, limit, offset int) ([]*models.Event, int, error) {
+	args := []interface{}{viewerID, filter.From}
+	conditions := []string{"e.status = 'scheduled'", "e.starts_at >= $2"}
+	if filter.MentorID != nil {
+		args = append(args, *filter.MentorID)
+		conditions = append(conditions, fmt.Sprintf("e.mentor_id = $%d", len(args)))
+	}
+	if filter.Type != nil {
+		args = append(args, *filter.Type)
+		conditions = append(conditions, fmt.Sprintf("e.type = $%d", len(args)))
+	}
+	where := " WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	countQuery := `SELECT COUNT(*) ` + eventJoins + where
+	if err := r.db.Pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	query := `SELECT ` + eventColumns + `
+              ` + eventJoins + where + fmt.Sprintf(`
+              ORDER BY e.starts_at, e.id
+              LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+
+	return r.queryEvents(ctx, query, total, args...)
+}
+
+// GetMentorEvents получает страницу всех событий ментора, начиная с самых поздних
+func (r *EventRepository) GetMentorEvents(ctx context.Context, mentorID, limit, offset int) ([]*models.Event, int, error) {
+	var total int
+	countQuery := `SELECT COUNT(*) FROM events WHERE mentor_id = $1`
+	if err := r.db.Pool.QueryRow(ctx, countQuery, mentorID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + eventColumns + `
+              ` + eventJoins + `
+              WHERE e.mentor_id = $2
+              ORDER BY e.starts_at DESC, e.id DESC
+              LIMIT $3 OFFSET $4`
+
+	return r.queryEvents(ctx, query, total, 0, mentorID, limit, offset)
+}
+
+// GetUserEvents получает страницу событий, на которые зарегистрирован пользователь
+// (в том числе в листе ожидания), начиная с самых поздних
+func (r *EventRepository) GetUserEvents(ctx context.Context, userID, limit, offset int) ([]*models.Event, int, error) {
+	var total int
+	countQuery := `SELECT COUNT(*) FROM event_registrations WHERE user_id = $1 AND status <> 'cancelled'`
+	if err := r.db.Pool.QueryRow(ctx, countQuery, userID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + eventColumns + `
+              ` + eventJoins + `
+              WHERE vr.id IS NOT NULL
+              ORDER BY e.starts_at DESC, e.id DESC
+              LIMIT $2 OFFSET $3`
+
+	return r.queryEvents(ctx, query, total, userID, limit, offset)
+}
+
+// UpdateEvent обновляет запланированное событие и переводит из листа ожидания столько
+// участников, сколько освободилось мест. Время напоминания сбрасывается при переносе.
+// Возвращает ID переведенных пользователей или pgx.ErrNoRows, если событие не найдено,
+// отменено или новая вместимость меньше числа участников.
+func (r *EventRepository) UpdateEvent(ctx context.Context, e *models.Event) ([]int, error) {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := lockEvent(ctx, tx, e.ID); err != nil {
+		return nil, err
+	}
+
+	var id int
+	err = tx.QueryRow(ctx, `UPDATE events
+              SET title = $2, description = $3, starts_at = $4, ends_at = $5, capacity = $6,
+                  reminder_sent_at = CASE WHEN starts_at = $4 THEN reminder_sent_at END,
+                  updated_at = now()
+              WHERE id = $1 AND status = 'scheduled'
+                AND $6 >= (SELECT COUNT(*) FROM event_registrations
+                           WHERE event_id = $1 AND status = 'registered')
+              RETURNING id`,
+		e.ID, e.Title, e.Description, e.StartsAt, e.EndsAt, e.Capacity).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	promoted, err := promoteWaitlist(ctx, tx, e.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return promoted, tx.Commit(ctx)
+}
+
+// CancelEvent отменяет запланированное событие, которое еще не началось.
+// Возвращает pgx.ErrNoRows, если такого события нет.
+func (r *EventRepository) CancelEvent(ctx context.Context, id int) error {
+	var eventID int
+	return r.db.Pool.QueryRow(ctx, `UPDATE events
+              SET status = 'cancelled', updated_at = now()
+              WHERE id = $1 AND status = 'scheduled' AND starts_at > now()
+              RETURNING id`, id).Scan(&eventID)
+}
+
+// Register регистрирует пользователя на событие: участником, если есть свободные места,
+// иначе в лист ожидания. Повторная регистрация после отмены ставит в конец очереди.
+// Возвращает pgx.ErrNoRows, если событие закрыто для регистрации или пользователь
+// уже зарегистрирован.
+func (r *EventRepository) Register(ctx context.Context, eventID, userID int) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Блокировка события упорядочивает конкурентные регистрации, чтобы не превысить вместимость
+	var capacity, registered int
+	err = tx.QueryRow(ctx, `SELECT capacity,
+                  (SELECT COUNT(*) FROM event_registrations
+                   WHERE event_id = $1 AND status = 'registered')
+              FROM events
+              WHERE id = $1 AND status = 'scheduled' AND starts_at > now()
+              FOR UPDATE`, eventID).Scan(&capacity, &registered)
+	if err != nil {
+		return err
+	}
+
+	status := models.EventRegistrationRegistered
+	if registered >= capacity {
+		status = models.EventRegistrationWaitlisted
+	}
+
+	var registrationID int
+	err = tx.QueryRow(ctx, `INSERT INTO event_registrations (event_id, user_id, status)
+              VALUES ($1, $2, $3)
+              ON CONFLICT (event_id, user_id) DO UPDATE
+              SET status = EXCLUDED.status, attended = NULL, queued_at = now(), updated_at = now()
+              WHERE event_registrations.status = 'cancelled'
+              RETURNING id`, eventID, userID, status).Scan(&registrationID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// CancelRegistration отменяет регистрацию пользователя на событие, которое еще не началось.
+// Если освободилось место участника, оно отдается первому из листа ожидания.
+// Возвращает ID переведенных пользователей или pgx.ErrNoRows, если регистрации нет.
+func (r *EventRepository) CancelRegistration(ctx context.Context, eventID, userID int) ([]int, error) {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var status string
+	err = tx.QueryRow(ctx, `SELECT status FROM events
+              WHERE id = $1 AND starts_at > now()
+              FOR UPDATE`, eventID).Scan(&status)
+	if err != nil {
+		return nil, err
+	}
+
+	var registrationID int
+	err = tx.QueryRow(ctx, `UPDATE event_registrations
+              SET status = 'cancelled', updated_at = now()
+              WHERE event_id = $1 AND user_id = $2 AND status <> 'cancelled'
+              RETURNING id`, eventID, userID).Scan(&registrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var promoted []int
+	if status == models.EventScheduled {
+		if promoted, err = promoteWaitlist(ctx, tx, eventID); err != nil {
+			return nil, err
+		}
+	}
+
+	return promoted, tx.Commit(ctx)
+}
+
+// GetRegistrations получает участников и лист ожидания события в порядке очереди
+func (r *EventRepository) GetRegistrations(ctx context.Context, eventID int) ([]*models.EventRegistration, error) {
+	query := `SELECT ` + registrationColumns + `
+              FROM event_registrations r
+              JOIN users u ON u.id = r.user_id
+              WHERE r.event_id = $1 AND r.status <> 'cancelled'
+              ORDER BY r.status = 'waitlisted', r.queued_at, r.id`
+
+	rows, err := r.db.Pool.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*models.EventRegistration
+	for rows.Next() {
+		reg, err := scanRegistration(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, reg)
+	}
+	return items, rows.Err()
+}
+
+// GetParticipantIDs получает ID пользователей с регистрацией в одном из статусов statuses
+func (r *EventRepository) GetParticipantIDs(ctx context.Context, eventID int, statuses ...string) ([]int, error) {
+	query := `SELECT user_id FROM event_registrations
+              WHERE event_id = $1 AND status = ANY($2)
+              ORDER BY queued_at, id`
+
+	rows, err := r.db.Pool.Query(ctx, query, eventID, statuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// MarkAttendance отмечает, пришел ли участник на событие.
+// Возвращает pgx.ErrNoRows, если пользователь не является участником события.
+func (r *EventRepository) MarkAttendance(ctx context.Context, eventID, userID int, attended bool) (*models.EventRegistration, error) {
+	query := `WITH updated AS (
+                  UPDATE event_registrations
+                  SET attended = $3, updated_at = now()
+                  WHERE event_id = $1 AND user_id = $2 AND status = 'registered'
+                  RETURNING *
+              )
+              SELECT ` + registrationColumns + `
+              FROM updated r
+              JOIN users u ON u.id = r.user_id`
+
+	return scanRegistration(r.db.Pool.QueryRow(ctx, query, eventID, userID, attended))
+}
+
+// ClaimDueReminders отмечает отправку напоминаний для запланированных событий,
+// начинающихся до before, и возвращает эти события. Каждое событие возвращается
+// только один раз, даже если напоминания рассылают несколько экземпляров приложения.
+func (r *EventRepository) ClaimDueReminders(ctx context.Context, before time.Time) ([]*models.Event, error) {
+	query := `WITH due AS (
+                  UPDATE events
+                  SET reminder_sent_at = now()
+                  WHERE status = 'scheduled' AND reminder_sent_at IS NULL
+                    AND starts_at > now() AND starts_at <= $2
+                  RETURNING id
+              )
+              SELECT ` + eventColumns + `
+              ` + eventJoins + `
+              WHERE e.id IN (SELECT id FROM due)
+              ORDER BY e.starts_at`
+
+	events, _, err := r.queryEvents(ctx, query, 0, 0, before)
+	return events, err
+}
+
+// queryEvents выполняет запрос событий и возвращает их вместе с переданным total
+func (r *EventRepository) queryEvents(ctx context.Context, query string, total int, args ...interface{}) ([]*models.Event, int, error) {
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var events []*models.Event
+	for rows.Next() {
+		e, err := scanEvent(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
+// lockEvent блокирует строку события до конца транзакции
+func lockEvent(ctx context.Context, tx pgx.Tx, eventID int) error {
+	var id int
+	return tx.QueryRow(ctx, `SELECT id FROM events WHERE id = $1 FOR UPDATE`, eventID).Scan(&id)
+}
+
+// promoteWaitlist переводит первых из листа ожидания в участники на все свободные места.
+// Событие должно быть заблокировано в транзакции tx.
+func promoteWaitlist(ctx context.Context, tx pgx.Tx, eventID int) ([]int, error) {
+	rows, err := tx.Query(ctx, `UPDATE event_registrations
+              SET status = 'registered', updated_at = now()
+              WHERE id IN (
+                  SELECT id FROM event_registrations
+                  WHERE event_id = $1 AND status = 'waitlisted'
+                  ORDER BY queued_at, id
+                  LIMIT GREATEST(
+                      (SELECT capacity FROM events WHERE id = $1) -
+                      (SELECT COUNT(*) FROM event_registrations WHERE event_id = $1 AND status = 'registered'),
+                      0)
+              )
+              RETURNING user_id`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var promoted []int
+	for rows.Next() {
+		var userID int
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		promoted = append(promoted, userID)
+	}
+	return promoted, rows.Err()
+}
+
+// scanEvent читает событие из строки результата, выбранной с колонками eventColumns
+func scanEvent(row pgx.Row) (*models.Event, error) {
+	e := &models.Event{}
+	err := row.Scan(
+		&e.ID,
+		&e.MentorID,
+		&e.HostUserID,
+		&e.HostName,
+		&e.Type,
+		&e.Title,
+		&e.Description,
+		&e.StartsAt,
+		&e.EndsAt,
+		&e.Capacity,
+		&e.Status,
+		&e.RegisteredCount,
+		&e.WaitlistCount,
+		&e.ViewerStatus,
+		&e.WaitlistPosition,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// scanRegistration читает регистрацию из строки результата, выбранной с колонками registrationColumns
+func scanRegistration(row pgx.Row) (*models.EventRegistration, error) {
+	reg := &models.EventRegistration{}
+	err := row.Scan(
+		&reg.ID,
+		&reg.EventID,
+		&reg.UserID,
+		&reg.UserName,
+		&reg.AvatarURL,
+		&reg.Status,
+		&reg.Attended,
+		&reg.QueuedAt,
+		&reg.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reg, nil
+}
@@ -0,0 +1,236 @@
+package repositories
+
+import (
+	"context"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type HomeworkRepository struct {
+	db *database.DB
+}
+
+func NewHomeworkRepository(db *database.DB) *HomeworkRepository {
+	return &HomeworkRepository{db: db}
+}
+
+// homeworkColumns - общий список колонок для выборки задания вместе с именами участников
+const homeworkColumns = `h.id, h.booking_id, h.mentor_id, COALESCE(mu.name, mu.username) AS mentor_name, m.user_id,
+              h.mentee_id, COALESCE(u.name, u.username) AS mentee_name, h.title, h.comment, h.due_at, h.created_at`
+
+// homeworkJoins - соединения, необходимые для homeworkColumns
+const homeworkJoins = `FROM homework_assignments h
+              JOIN mentors m ON m.id = h.mentor_id
+              JOIN users mu ON mu.id = m.user_id
+              JOIN users u ON u.id = h.mentee_id`
+
+// CreateAssignment сохраняет задание вместе с пунктами в одной транзакции и заполняет ID и дату.
+// Несуществующие вопросы и модули отклоняются внешними ключами.
+func (r *HomeworkRepository) CreateAssignment(ctx context.Context, a *models.HomeworkAssignment, questionIDs, moduleIDs []int) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = tx.QueryRow(ctx, `INSERT INTO homework_assignments (booking_id, mentor_id, mentee_id, title, comment, due_at)
+              VALUES ($1, $2, $3, $4, $5, $6)
+              RETURNING id, created_at`,
+		a.BookingID, a.MentorID, a.MenteeID, a.Title, a.Comment, a.DueAt,
+	).Scan(&a.ID, &a.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO homework_items (assignment_id, question_id)
+              SELECT $1, unnest($2::int[])`, a.ID, questionIDs)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `INSERT INTO homework_items (assignment_id, module_id)
+              SELECT $1, unnest($2::int[])`, a.ID, moduleIDs)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetAssignmentByID получает задание по ID вместе с пунктами и прогрессом ученика
+func (r *HomeworkRepository) GetAssignmentByID(ctx context.Context, id int) (*models.HomeworkAssignment, error) {
+	query := `SELECT ` + homeworkColumns + `
+              ` + homeworkJoins + `
+              WHERE h.id = $1`
+
+	a, err := scanHomework(r.db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadItems(ctx, []*models.HomeworkAssignment{a}); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// GetBookingAssignments получает задания по занятию, начиная с самых новых
+func (r *HomeworkRepository) GetBookingAssignments(ctx context.Context, bookingID int) ([]*models.HomeworkAssignment, error) {
+	query := `SELECT ` + homeworkColumns + `
+              ` + homeworkJoins + `
+              WHERE h.booking_id = $1
+              ORDER BY h.created_at DESC, h.id DESC`
+
+	rows, err := r.db.Pool.Query(ctx, query, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments, err := collectHomework(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadItems(ctx, assignments); err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// GetMenteeAssignments получает страницу заданий ученика и их общее количество
+func (r *HomeworkRepository) GetMenteeAssignments(ctx context.Context, menteeID, limit, offset int) ([]*models.HomeworkAssignment, int, error) {
+	return r.listAssignments(ctx, "h.mentee_id", menteeID, limit, offset)
+}
+
+// GetMentorAssignments получает страницу заданий, выданных ментором, и их общее количество
+func (r *HomeworkRepository) GetMentorAssignments(ctx context.Context, mentorID, limit, offset int) ([]*models.HomeworkAssignment, int, error) {
+	return r.listAssignments(ctx, "h.mentor_id", mentorID, limit, offset)
+}
+
+// listAssignments получает задания по владельцу (ученику или ментору), начиная с самых новых
+func (r *HomeworkRepository) listAssignments(ctx context.Context, ownerColumn string, ownerID, limit, offset int) ([]*models.HomeworkAssignment, int, error) {
+	where := " WHERE " + ownerColumn + " = $1"
+
+	var total int
+	if err := r.db.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM homework_assignments h`+where, ownerID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + homeworkColumns + `
+              ` + homeworkJoins + where + `
+              ORDER BY h.created_at DESC, h.id DESC
+              LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Pool.Query(ctx, query, ownerID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	assignments, err := collectHomework(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := r.loadItems(ctx, assignments); err != nil {
+		return nil, 0, err
+	}
+	return assignments, total, nil
+}
+
+// DeleteAssignment удаляет задание вместе с пунктами
+func (r *HomeworkRepository) DeleteAssignment(ctx context.Context, id int) error {
+	_, err := r.db.Pool.Exec(ctx, `DELETE FROM homework_assignments WHERE id = $1`, id)
+	return err
+}
+
+// loadItems загружает пункты заданий и считает по user_question_progress, на сколько вопросов
+// каждого пункта ученик задания уже ответил верно
+func (r *HomeworkRepository) loadItems(ctx context.Context, assignments []*models.HomeworkAssignment) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+
+	byID := make(map[int]*models.HomeworkAssignment, len(assignments))
+	ids := make([]int, 0, len(assignments))
+	for _, a := range assignments {
+		byID[a.ID] = a
+		ids = append(ids, a.ID)
+	}
+
+	query := `SELECT i.assignment_id, i.id, i.question_id, i.module_id, COALESCE(q.title, md.title),
+                     CASE WHEN i.question_id IS NOT NULL THEN 1
+                          ELSE (SELECT COUNT(*) FROM module_questions mq WHERE mq.module_id = i.module_id)
+                     END,
+                     (SELECT COUNT(*) FROM user_question_progress p
+                      WHERE p.user_id = h.mentee_id AND p.is_correct
+                        AND (p.question_id = i.question_id
+                             OR p.question_id IN (SELECT mq.question_id FROM module_questions mq
+                                                  WHERE mq.module_id = i.module_id)))
+              FROM homework_items i
+              JOIN homework_assignments h ON h.id = i.assignment_id
+              LEFT JOIN questions q ON q.id = i.question_id
+              LEFT JOIN modules md ON md.id = i.module_id
+              WHERE i.assignment_id = ANY($1)
+              ORDER BY i.assignment_id, i.id`
+
+	rows, err := r.db.Pool.Query(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var assignmentID int
+		var item models.HomeworkItem
+		err := rows.Scan(
+			&assignmentID,
+			&item.ID,
+			&item.QuestionID,
+			&item.ModuleID,
+			&item.Title,
+			&item.TotalQuestions,
+			&item.CompletedQuestions,
+		)
+		if err != nil {
+			return err
+		}
+		a := byID[assignmentID]
+		a.Items = append(a.Items, item)
+	}
+	return rows.Err()
+}
+
+// collectHomework читает все задания из результата запроса
+func collectHomework(rows pgx.Rows) ([]*models.HomeworkAssignment, error) {
+	var assignments []*models.HomeworkAssignment
+	for rows.Next() {
+		a, err := scanHomework(rows)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, a)
+	}
+	return assignments, rows.Err()
+}
+
+// scanHomework читает задание из строки результата, выбранной с колонками homeworkColumns
+func scanHomework(row pgx.Row) (*models.HomeworkAssignment, error) {
+	a := &models.HomeworkAssignment{}
+	err := row.Scan(
+		&a.ID,
+		&a.BookingID,
+		&a.MentorID,
+		&a.MentorName,
+		&a.MentorUserID,
+		&a.MenteeID,
+		&a.MenteeName,
+		&a.Title,
+		&a.Comment,
+		&a.DueAt,
+		&a.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
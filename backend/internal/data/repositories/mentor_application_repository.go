@@ -0,0 +1,239 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type MentorApplicationRepository struct {
+	db *database.DB
+}
+
+func NewMentorApplicationRepository(db *database.DB) *MentorApplicationRepository {
+	return &MentorApplicationRepository{db: db}
+}
+
+// mentorApplicationColumns - общий список колонок для выборки заявки вместе с именем заявителя
+const mentorApplicationColumns = `a.id, a.user_id, COALESCE(u.name, u.username) AS applicant_name,
+              a.specialization, a.grade, a.experience_years, a.description, a.tags,
+              a.contacts, a.pricelist, a.status, a.reviewer_id, a.review_comment,
+              a.reviewed_at, a.created_at, a.updated_at`
+
+// CreateApplication сохраняет новую заявку и заполняет ее ID, статус и даты
+func (r *MentorApplicationRepository) CreateApplication(ctx context.Context, app *models.MentorApplication) error {
+	contactsJSON, err := marshalNullableJSON(app.Contacts)
+	if err != nil {
+		return err
+	}
+	pricelistJSON, err := marshalNullableJSON(app.Pricelist)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO mentor_applications (user_id, specialization, grade, experience_years, description, tags, contacts, pricelist)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+              RETURNING id, status, created_at, updated_at`
+
+	return r.db.Pool.QueryRow(ctx, query,
+		app.UserID,
+		app.Specialization,
+		app.Grade,
+		app.ExperienceYears,
+		app.Description,
+		app.Tags,
+		contactsJSON,
+		pricelistJSON,
+	).Scan(&app.ID, &app.Status, &app.CreatedAt, &app.UpdatedAt)
+}
+
+// HasPendingApplication проверяет, есть ли у пользователя заявка на рассмотрении
+func (r *MentorApplicationRepository) HasPendingApplication(ctx context.Context, userID int) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM mentor_applications WHERE user_id = $1 AND status = 'pending')`
+
+	var exists bool
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(&exists)
+	return exists, err
+}
+
+// GetApplicationByID получает заявку по ID
+func (r *MentorApplicationRepository) GetApplicationByID(ctx context.Context, id int) (*models.MentorApplication, error) {
+	query := `SELECT ` + mentorApplicationColumns + `
+              FROM mentor_applications a
+              JOIN users u ON u.id = a.user_id
+              WHERE a.id = $1`
+
+	return scanMentorApplication(r.db.Pool.QueryRow(ctx, query, id))
+}
+
+// GetUserApplications получает все заявки пользователя, начиная с последней
+func (r *MentorApplicationRepository) GetUserApplications(ctx context.Context, userID int) ([]*models.MentorApplication, error) {
+	query := `SELECT ` + mentorApplicationColumns + `
+              FROM mentor_applications a
+              JOIN users u ON u.id = a.user_id
+              WHERE a.user_id = $1
+              ORDER BY a.created_at DESC`
+
+	rows, err := r.db.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectMentorApplications(rows)
+}
+
+// GetApplicationsByStatus получает страницу заявок с указанным статусом в порядке подачи
+func (r *MentorApplicationRepository) GetApplicationsByStatus(ctx context.Context, status string, limit, offset int) ([]*models.MentorApplication, int, error) {
+	var total int
+	countQuery := `SELECT COUNT(*) FROM mentor_applications WHERE status = $1`
+	if err := r.db.Pool.QueryRow(ctx, countQuery, status).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + mentorApplicationColumns + `
+              FROM mentor_applications a
+              JOIN users u ON u.id = a.user_id
+              WHERE a.status = $1
+              ORDER BY a.created_at, a.id
+              LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Pool.Query(ctx, query, status, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	apps, err := collectMentorApplications(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return apps, total, nil
+}
+
+// ApproveApplication одобряет заявку на рассмотрении: создает (или обновляет) профиль
+// ментора из данных заявки и выдает пользователю роль ментора.
+// Возвращает pgx.ErrNoRows, если заявка не найдена или уже рассмотрена.
+func (r *MentorApplicationRepository) ApproveApplication(ctx context.Context, id, reviewerID int, comment *string) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var userID int
+	err = tx.QueryRow(ctx, `UPDATE mentor_applications
+              SET status = 'approved', reviewer_id = $2, review_comment = $3, reviewed_at = now(), updated_at = now()
+              WHERE id = $1 AND status = 'pending'
+              RETURNING user_id`, id, reviewerID, comment).Scan(&userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO mentors (user_id, specialization, grade, experience_years, description, tags, contacts, pricelist)
+              SELECT user_id, specialization, grade, experience_years, description, tags, contacts, pricelist
+              FROM mentor_applications
+              WHERE id = $1
+              ON CONFLICT (user_id) DO UPDATE
+              SET specialization = EXCLUDED.specialization,
+                  grade = EXCLUDED.grade,
+                  experience_years = EXCLUDED.experience_years,
+                  description = EXCLUDED.description,
+                  tags = EXCLUDED.tags,
+                  contacts = EXCLUDED.contacts,
+                  pricelist = EXCLUDED.pricelist,
+                  updated_at = now()`, id)
+	if err != nil {
+		return err
+	}
+
+	// Модераторам и администраторам роль не понижаем
+	_, err = tx.Exec(ctx, `UPDATE users SET role = 'mentor', updated_at = now()
+              WHERE id = $1 AND role = 'user'`, userID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RejectApplication отклоняет заявку на рассмотрении.
+// Возвращает pgx.ErrNoRows, если заявка не найдена или уже рассмотрена.
+func (r *MentorApplicationRepository) RejectApplication(ctx context.Context, id, reviewerID int, comment *string) error {
+	var appID int
+	return r.db.Pool.QueryRow(ctx, `UPDATE mentor_applications
+              SET status = 'rejected', reviewer_id = $2, review_comment = $3, reviewed_at = now(), updated_at = now()
+              WHERE id = $1 AND status = 'pending'
+              RETURNING id`, id, reviewerID, comment).Scan(&appID)
+}
+
+// collectMentorApplications читает все заявки из результата запроса
+func collectMentorApplications(rows pgx.Rows) ([]*models.MentorApplication, error) {
+	var apps []*models.MentorApplication
+	for rows.Next() {
+		app, err := scanMentorApplication(rows)
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, app)
+	}
+	return apps, rows.Err()
+}
+
+// scanMentorApplication читает заявку из строки результата, выбранной с колонками mentorApplicationColumns
+func scanMentorApplication(row pgx.Row) (*models.MentorApplication, error) {
+	app := &models.MentorApplication{}
+	var contactsJSON, pricelistJSON []byte
+
+	err := row.Scan(
+		&app.ID,
+		&app.UserID,
+		&app.ApplicantName,
+		&app.Specialization,
+		&app.Grade,
+		&app.ExperienceYears,
+		&app.Description,
+		&app.Tags,
+		&contactsJSON,
+		&pricelistJSON,
+		&app.Status,
+		&app.ReviewerID,
+		&app.ReviewComment,
+		&app.ReviewedAt,
+		&app.CreatedAt,
+		&app.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if contactsJSON != nil {
+		if err := json.Unmarshal(contactsJSON, &app.Contacts); err != nil {
+			return nil, err
+		}
+	}
+	if pricelistJSON != nil {
+		if err := json.Unmarshal(pricelistJSON, &app.Pricelist); err != nil {
+			return nil, err
+		}
+	}
+
+	return app, nil
+}
+
+// marshalNullableJSON сериализует значение в JSON, возвращая nil для пустых значений,
+// чтобы в JSONB колонку записался NULL
+func marshalNullableJSON(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if string(b) == "null" {
+		return nil, nil
+	}
+	return b, nil
+}
@@ -0,0 +1,306 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type MentorRepository struct {
+	db *database.DB
+}
+
+func NewMentorRepository(db *database.DB) *MentorRepository {
+	return &MentorRepository{db: db}
+}
+
+// mentorColumns - общий список колонок для выборки ментора вместе с пользователем
+// и значками действующих подтверждений
+const mentorColumns = `m.id, m.user_id, COALESCE(u.name, u.username) AS full_name, u.avatar_url,
+              m.specialization, m.grade, m.experience_years, m.description, m.tags,
+              m.contacts, m.pricelist, m.languages, m.is_available, m.rating::float8,
+              m.reviews_count, (
+                  SELECT jsonb_agg(jsonb_build_object(
+                             'type', v.type, 'title', v.title,
+                             'verifiedAt', v.reviewed_at, 'expiresAt', v.expires_at
+                         ) ORDER BY v.reviewed_at)
+                  FROM mentor_verifications v
+                  WHERE v.mentor_id = m.id AND v.status = 'verified' AND v.expires_at > now()
+              ) AS badges, m.created_at, m.updated_at`
+
+// ListMentors получает страницу менторов, подходящих под фильтр, и их общее количество
+func (r *MentorRepository) ListMentors(ctx context.Context, filter models.MentorFilter, limit, offset int) ([]*models.Mentor, int, error) {
+	var conditions []string
+	var args []interface{}
+
+	if filter.Specialization != nil && *filter.Specialization != "" {
+		args = append(args, *filter.Specialization)
+		conditions = append(conditions, fmt.Sprintf("lower(m.specialization) = lower($%d)", len(args)))
+	}
+	if len(filter.Tags) > 0 {
+		args = append(args, filter.Tags)
+		// Операторы массивов обслуживаются GIN индексом по tags
+		if filter.TagsMatchAll {
+			conditions = append(conditions, fmt.Sprintf("m.tags @> $%d", len(args)))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("m.tags && $%d", len(args)))
+		}
+	}
+	if filter.Grade != nil && *filter.Grade != "" {
+		args = append(args, *filter.Grade)
+		conditions = append(conditions, fmt.Sprintf("lower(m.grade) = lower($%d)", len(args)))
+	}
+	if filter.MinExperience != nil {
+		args = append(args, *filter.MinExperience)
+		conditions = append(conditions, fmt.Sprintf("m.experience_years >= $%d", len(args)))
+	}
+	if filter.PriceMin != nil {
+		args = append(args, *filter.PriceMin)
+		conditions = append(conditions, fmt.Sprintf("m.price_max >= $%d", len(args)))
+	}
+	if filter.PriceMax != nil {
+		args = append(args, *filter.PriceMax)
+		conditions = append(conditions, fmt.Sprintf("m.price_min <= $%d", len(args)))
+	}
+	if filter.Currency != nil && *filter.Currency != "" {
+		args = append(args, *filter.Currency)
+		conditions = append(conditions, fmt.Sprintf("m.price_currency = $%d", len(args)))
+	}
+	if filter.Language != nil && *filter.Language != "" {
+		args = append(args, []string{*filter.Language})
+		conditions = append(conditions, fmt.Sprintf("m.languages @> $%d", len(args)))
+	}
+	if filter.Available != nil {
+		args = append(args, *filter.Available)
+		conditions = append(conditions, fmt.Sprintf("m.is_available = $%d", len(args)))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	countQuery := `SELECT COUNT(*) FROM mentors m` + where
+
+	var total int
+	if err := r.db.Pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	query := `SELECT ` + mentorColumns + `
+              FROM mentors m
+              JOIN users u ON u.id = m.user_id` + where + fmt.Sprintf(`
+              ORDER BY %s
+              LIMIT $%d OFFSET $%d`, mentorOrderBy(filter), len(args)-1, len(args))
+
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var mentors []*models.Mentor
+	for rows.Next() {
+		m, err := scanMentor(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		mentors = append(mentors, m)
+	}
+
+	return mentors, total, rows.Err()
+}
+
+// mentorOrderBy строит ORDER BY каталога. Менторы без значения поля сортировки
+// всегда идут в конце, а ID делает порядок стабильным для постраничной навигации.
+func mentorOrderBy(filter models.MentorFilter) string {
+	direction := "ASC"
+	if filter.SortDesc {
+		direction = "DESC"
+	}
+
+	switch filter.Sort {
+	case models.MentorSortRating:
+		return fmt.Sprintf("m.rating %s NULLS LAST, m.reviews_count DESC, m.id", direction)
+	case models.MentorSortPrice:
+		// По возрастанию сравниваем минимальные цены, по убыванию - максимальные
+		if filter.SortDesc {
+			return "m.price_max DESC NULLS LAST, m.id"
+		}
+		return "m.price_min ASC NULLS LAST, m.id"
+	case models.MentorSortExperience:
+		return fmt.Sprintf("m.experience_years %s NULLS LAST, m.id", direction)
+	}
+	return "m.id"
+}
+
+// GetMentorsCoveringTechnologies получает доступных менторов, которые закрывают технологии
+// с весами weights, по убыванию суммарного веса. Совпадение с тегом дает полный вес технологии,
+// упоминание только в специализации - половину. В специализации технология ищется целым словом
+// между пробелами и знаками препинания, чтобы "Go" не находился в "Django", а "C" - в "C++".
+// Ментор excludeUserID в выдачу не попадает.
+func (r *MentorRepository) GetMentorsCoveringTechnologies(ctx context.Context, technologies []string, weights []float64, excludeUserID, limit int) ([]*models.MentorRecommendation, error) {
+	query := `WITH weak AS (
+                  SELECT name, lower(name) AS key, weight,
+                         '(^|[\s,;:/|()])' || regexp_replace(lower(name), '([.^$*+?()\[\]{}|\\-])', '\\\1', 'g')
+                             || '($|[\s,;:/|()])' AS pattern
+                  FROM unnest($1::text[], $2::float8[]) AS w(name, weight)
+              )
+              SELECT ` + mentorColumns + `, s.score, s.matched
+              FROM mentors m
+              JOIN users u ON u.id = m.user_id
+              CROSS JOIN LATERAL (
+                  SELECT COALESCE(SUM(CASE WHEN c.by_tag THEN w.weight ELSE w.weight / 2 END), 0) AS score,
+                         COALESCE(array_agg(w.name ORDER BY w.weight DESC), '{}') AS matched
+                  FROM weak w
+                  CROSS JOIN LATERAL (
+                      SELECT EXISTS (SELECT 1 FROM unnest(m.tags) tag WHERE lower(tag) = w.key) AS by_tag,
+                             lower(m.specialization) ~ w.pattern AS by_specialization
+                  ) c
+                  WHERE c.by_tag OR c.by_specialization
+              ) s
+              WHERE m.is_available AND m.user_id <> $3 AND s.score > 0
+              ORDER BY s.score DESC, m.rating DESC NULLS LAST, m.reviews_count DESC, m.id
+              LIMIT $4`
+
+	rows, err := r.db.Pool.Query(ctx, query, technologies, weights, excludeUserID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recommendations []*models.MentorRecommendation
+	for rows.Next() {
+		rec := &models.MentorRecommendation{}
+		rec.Mentor, err = scanMentor(extraColumns{rows, []interface{}{&rec.Score, &rec.MatchedTechnologies}})
+		if err != nil {
+			return nil, err
+		}
+		recommendations = append(recommendations, rec)
+	}
+
+	return recommendations, rows.Err()
+}
+
+// GetMentorByID получает профиль ментора по ID
+func (r *MentorRepository) GetMentorByID(ctx context.Context, id int) (*models.Mentor, error) {
+	query := `SELECT ` + mentorColumns + `
+              FROM mentors m
+              JOIN users u ON u.id = m.user_id
+              WHERE m.id = $1`
+
+	return scanMentor(r.db.Pool.QueryRow(ctx, query, id))
+}
+
+// GetMentorIDByUserID получает ID профиля ментора по ID пользователя.
+// Возвращает nil, если пользователь не является ментором.
+func (r *MentorRepository) GetMentorIDByUserID(ctx context.Context, userID int) (*int, error) {
+	query := `SELECT id FROM mentors WHERE user_id = $1`
+
+	var mentorID int
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(&mentorID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &mentorID, nil
+}
+
+// UpdateMentorProfile обновляет редактируемые поля профиля ментора
+func (r *MentorRepository) UpdateMentorProfile(ctx context.Context, m *models.Mentor) error {
+	contactsJSON, err := marshalNullableJSON(m.Contacts)
+	if err != nil {
+		return err
+	}
+	pricelistJSON, err := marshalNullableJSON(m.Pricelist)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE mentors
+              SET specialization = $2, grade = $3, experience_years = $4, description = $5,
+                  tags = $6, contacts = $7, pricelist = $8, languages = $9, is_available = $10,
+                  updated_at = now()
+              WHERE id = $1`
+
+	_, err = r.db.Pool.Exec(ctx, query,
+		m.ID,
+		m.Specialization,
+		m.Grade,
+		m.ExperienceYears,
+		m.Description,
+		m.Tags,
+		contactsJSON,
+		pricelistJSON,
+		m.Languages,
+		m.IsAvailable,
+	)
+	return err
+}
+
+// extraColumns дочитывает колонки, выбранные после mentorColumns, в dest
+type extraColumns struct {
+	row  pgx.Row
+	dest []interface{}
+}
+
+func (e extraColumns) Scan(dest ...interface{}) error {
+	return e.row.Scan(append(dest, e.dest...)...)
+}
+
+// scanMentor читает ментора из строки результата, выбранной с колонками mentorColumns
+func scanMentor(row pgx.Row) (*models.Mentor, error) {
+	m := &models.Mentor{}
+	var contactsJSON, pricelistJSON, badgesJSON []byte
+
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.FullName,
+		&m.AvatarURL,
+		&m.Specialization,
+		&m.Grade,
+		&m.ExperienceYears,
+		&m.Description,
+		&m.Tags,
+		&contactsJSON,
+		&pricelistJSON,
+		&m.Languages,
+		&m.IsAvailable,
+		&m.Rating,
+		&m.ReviewsCount,
+		&badgesJSON,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if contactsJSON != nil {
+		if err := json.Unmarshal(contactsJSON, &m.Contacts); err != nil {
+			return nil, err
+		}
+	}
+	if pricelistJSON != nil {
+		if err := json.Unmarshal(pricelistJSON, &m.Pricelist); err != nil {
+			return nil, err
+		}
+	}
+	if badgesJSON != nil {
+		if err := json.Unmarshal(badgesJSON, &m.Badges); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
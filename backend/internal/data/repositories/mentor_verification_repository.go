@@ -0,0 +1,199 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type MentorVerificationRepository struct {
+	db *database.DB
+}
+
+func NewMentorVerificationRepository(db *database.DB) *MentorVerificationRepository {
+	return &MentorVerificationRepository{db: db}
+}
+
+// verificationColumns - общий список колонок для выборки подтверждения вместе с именем ментора
+const verificationColumns = `v.id, v.mentor_id, COALESCE(u.name, u.username) AS mentor_name,
+              v.type, v.title, v.evidence_url, v.details, v.status, v.reviewer_id,
+              v.review_comment, v.reviewed_at, v.expires_at, v.created_at, v.updated_at`
+
+// verificationJoins - соединения, необходимые для verificationColumns
+const verificationJoins = `FROM mentor_verifications v
+              JOIN mentors m ON m.id = v.mentor_id
+              JOIN users u ON u.id = m.user_id`
+
+// verificationStatusFilter возвращает условие отбора подтверждений по статусу и его аргументы.
+// Истекшие подтверждения хранятся со статусом verified, поэтому различаются по expires_at.
+func verificationStatusFilter(status string) (string, []interface{}) {
+	switch status {
+	case models.VerificationVerified:
+		return `v.status = 'verified' AND v.expires_at > now()`, nil
+	case models.VerificationExpired:
+		return `v.status = 'verified' AND v.expires_at <= now()`, nil
+	}
+	return `v.status = $1`, []interface{}{status}
+}
+
+// CreateVerification сохраняет новое подтверждение и заполняет его ID, статус и даты
+func (r *MentorVerificationRepository) CreateVerification(ctx context.Context, v *models.MentorVerification) error {
+	query := `INSERT INTO mentor_verifications (mentor_id, type, title, evidence_url, details)
+              VALUES ($1, $2, $3, $4, $5)
+              RETURNING id, status, created_at, updated_at`
+
+	return r.db.Pool.QueryRow(ctx, query,
+		v.MentorID,
+		v.Type,
+		v.Title,
+		v.EvidenceURL,
+		v.Details,
+	).Scan(&v.ID, &v.Status, &v.CreatedAt, &v.UpdatedAt)
+}
+
+// CountPendingVerifications возвращает количество подтверждений ментора на рассмотрении
+func (r *MentorVerificationRepository) CountPendingVerifications(ctx context.Context, mentorID int) (int, error) {
+	query := `SELECT COUNT(*) FROM mentor_verifications WHERE mentor_id = $1 AND status = 'pending'`
+
+	var count int
+	err := r.db.Pool.QueryRow(ctx, query, mentorID).Scan(&count)
+	return count, err
+}
+
+// GetVerificationByID получает подтверждение по ID
+func (r *MentorVerificationRepository) GetVerificationByID(ctx context.Context, id int) (*models.MentorVerification, error) {
+	query := `SELECT ` + verificationColumns + `
+              ` + verificationJoins + `
+              WHERE v.id = $1`
+
+	return scanVerification(r.db.Pool.QueryRow(ctx, query, id))
+}
+
+// GetMentorVerifications получает все подтверждения ментора, начиная с последнего
+func (r *MentorVerificationRepository) GetMentorVerifications(ctx context.Context, mentorID int) ([]*models.MentorVerification, error) {
+	query := `SELECT ` + verificationColumns + `
+              ` + verificationJoins + `
+              WHERE v.mentor_id = $1
+              ORDER BY v.created_at DESC, v.id DESC`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectVerifications(rows)
+}
+
+// GetVerificationsByStatus получает страницу подтверждений с указанным статусом в порядке подачи
+func (r *MentorVerificationRepository) GetVerificationsByStatus(ctx context.Context, status string, limit, offset int) ([]*models.MentorVerification, int, error) {
+	condition, args := verificationStatusFilter(status)
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM mentor_verifications v WHERE ` + condition
+	if err := r.db.Pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	query := `SELECT ` + verificationColumns + `
+              ` + verificationJoins + `
+              WHERE ` + condition + fmt.Sprintf(`
+              ORDER BY v.created_at, v.id
+              LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	items, err := collectVerifications(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
+
+// VerifyVerification подтверждает утверждение на рассмотрении до expiresAt.
+// Возвращает pgx.ErrNoRows, если подтверждение не найдено или уже рассмотрено.
+func (r *MentorVerificationRepository) VerifyVerification(ctx context.Context, id, reviewerID int, comment *string, expiresAt time.Time) error {
+	var verificationID int
+	return r.db.Pool.QueryRow(ctx, `UPDATE mentor_verifications
+              SET status = 'verified', reviewer_id = $2, review_comment = $3, expires_at = $4,
+                  reviewed_at = now(), updated_at = now()
+              WHERE id = $1 AND status = 'pending'
+              RETURNING id`, id, reviewerID, comment, expiresAt).Scan(&verificationID)
+}
+
+// RejectVerification отклоняет утверждение на рассмотрении.
+// Возвращает pgx.ErrNoRows, если подтверждение не найдено или уже рассмотрено.
+func (r *MentorVerificationRepository) RejectVerification(ctx context.Context, id, reviewerID int, comment *string) error {
+	var verificationID int
+	return r.db.Pool.QueryRow(ctx, `UPDATE mentor_verifications
+              SET status = 'rejected', reviewer_id = $2, review_comment = $3, reviewed_at = now(), updated_at = now()
+              WHERE id = $1 AND status = 'pending'
+              RETURNING id`, id, reviewerID, comment).Scan(&verificationID)
+}
+
+// RevokeVerification отзывает действующее подтверждение.
+// Возвращает pgx.ErrNoRows, если подтверждение не найдено, не подтверждено или уже истекло.
+func (r *MentorVerificationRepository) RevokeVerification(ctx context.Context, id, reviewerID int, reason string) error {
+	var verificationID int
+	return r.db.Pool.QueryRow(ctx, `UPDATE mentor_verifications
+              SET status = 'revoked', reviewer_id = $2, review_comment = $3, reviewed_at = now(), updated_at = now()
+              WHERE id = $1 AND status = 'verified' AND expires_at > now()
+              RETURNING id`, id, reviewerID, reason).Scan(&verificationID)
+}
+
+// DeletePendingVerification удаляет подтверждение ментора, пока оно на рассмотрении.
+// Возвращает pgx.ErrNoRows, если такого подтверждения нет.
+func (r *MentorVerificationRepository) DeletePendingVerification(ctx context.Context, id, mentorID int) error {
+	var verificationID int
+	return r.db.Pool.QueryRow(ctx, `DELETE FROM mentor_verifications
+              WHERE id = $1 AND mentor_id = $2 AND status = 'pending'
+              RETURNING id`, id, mentorID).Scan(&verificationID)
+}
+
+// collectVerifications читает все подтверждения из результата запроса
+func collectVerifications(rows pgx.Rows) ([]*models.MentorVerification, error) {
+	var items []*models.MentorVerification
+	for rows.Next() {
+		v, err := scanVerification(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+	}
+	return items, rows.Err()
+}
+
+// scanVerification читает подтверждение из строки результата, выбранной с колонками verificationColumns
+func scanVerification(row pgx.Row) (*models.MentorVerification, error) {
+	v := &models.MentorVerification{}
+	err := row.Scan(
+		&v.ID,
+		&v.MentorID,
+		&v.MentorName,
+		&v.Type,
+		&v.Title,
+		&v.EvidenceURL,
+		&v.Details,
+		&v.Status,
+		&v.ReviewerID,
+		&v.ReviewComment,
+		&v.ReviewedAt,
+		&v.ExpiresAt,
+		&v.CreatedAt,
+		&v.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,181 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type NotificationRepository struct {
+	db *database.DB
+}
+
+func NewNotificationRepository(db *database.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// CreateNotification сохраняет уведомление и заполняет его ID и дату создания
+func (r *NotificationRepository) CreateNotification(ctx context.Context, n *models.Notification) error {
+	var payloadJSON []byte
+	if n.Payload != nil {
+		var err error
+		payloadJSON, err = json.Marshal(n.Payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	query := `INSERT INTO notifications (user_id, type, title, body, payload)
+              VALUES ($1, $2, $3, $4, $5)
+              RETURNING id, created_at`
+
+	return r.db.Pool.QueryRow(ctx, query, n.UserID, n.Type, n.Title, n.Body, payloadJSON).Scan(&n.ID, &n.CreatedAt)
+}
+
+// GetUserNotifications получает уведомления пользователя от новых к старым.
+// Если cursor задан, возвращаются уведомления с ID меньше курсора.
+func (r *NotificationRepository) GetUserNotifications(ctx context.Context, userID int, cursor *int, limit int, unreadOnly bool) ([]*models.Notification, error) {
+	query := `SELECT id, user_id, type, title, body, payload, read_at, created_at
+              FROM notifications
+              WHERE user_id = $1
+                AND ($2::int IS NULL OR id < $2)
+                AND (NOT $3 OR read_at IS NULL)
+              ORDER BY id DESC
+              LIMIT $4`
+
+	rows, err := r.db.Pool.Query(ctx, query, userID, cursor, unreadOnly, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*models.Notification
+	for rows.Next() {
+		n := &models.Notification{}
+		var payloadJSON []byte
+		err := rows.Scan(
+			&n.ID,
+			&n.UserID,
+			&n.Type,
+			&n.Title,
+			&n.Body,
+			&payloadJSON,
+			&n.ReadAt,
+			&n.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if payloadJSON != nil {
+			if err := json.Unmarshal(payloadJSON, &n.Payload); err != nil {
+				return nil, err
+			}
+		}
+		notifications = append(notifications, n)
+	}
+
+	return notifications, rows.Err()
+}
+
+// CountUnread возвращает количество непрочитанных уведомлений пользователя
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID int) (int, error) {
+	query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`
+
+	var count int
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(&count)
+	return count, err
+}
+
+// MarkRead отмечает уведомление прочитанным.
+// Возвращает false, если уведомление не найдено или принадлежит другому пользователю.
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID, notificationID int) (bool, error) {
+	query := `UPDATE notifications
+              SET read_at = COALESCE(read_at, now())
+              WHERE id = $1 AND user_id = $2`
+
+	tag, err := r.db.Pool.Exec(ctx, query, notificationID, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// MarkAllRead отмечает все уведомления пользователя прочитанными
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID int) error {
+	query := `UPDATE notifications SET read_at = now() WHERE user_id = $1 AND read_at IS NULL`
+
+	_, err := r.db.Pool.Exec(ctx, query, userID)
+	return err
+}
+
+// GetPreferences получает настройки доставки уведомлений пользователя
+func (r *NotificationRepository) GetPreferences(ctx context.Context, userID int) ([]models.NotificationPreference, error) {
+	query := `SELECT type, email_enabled, telegram_enabled
+              FROM notification_preferences
+              WHERE user_id = $1
+              ORDER BY type`
+
+	rows, err := r.db.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prefs []models.NotificationPreference
+	for rows.Next() {
+		var p models.NotificationPreference
+		if err := rows.Scan(&p.Type, &p.EmailEnabled, &p.TelegramEnabled); err != nil {
+			return nil, err
+		}
+		prefs = append(prefs, p)
+	}
+
+	return prefs, rows.Err()
+}
+
+// GetPreference получает настройки доставки для конкретного типа уведомлений.
+// Возвращает nil, если пользователь их не задавал.
+func (r *NotificationRepository) GetPreference(ctx context.Context, userID int, notificationType string) (*models.NotificationPreference, error) {
+	query := `SELECT type, email_enabled, telegram_enabled
+              FROM notification_preferences
+              WHERE user_id = $1 AND type = $2`
+
+	p := &models.NotificationPreference{}
+	err := r.db.Pool.QueryRow(ctx, query, userID, notificationType).Scan(&p.Type, &p.EmailEnabled, &p.TelegramEnabled)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// UpsertPreferences сохраняет настройки доставки уведомлений пользователя
+func (r *NotificationRepository) UpsertPreferences(ctx context.Context, userID int, prefs []models.NotificationPreference) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	query := `INSERT INTO notification_preferences (user_id, type, email_enabled, telegram_enabled)
+              VALUES ($1, $2, $3, $4)
+              ON CONFLICT (user_id, type) DO UPDATE
+              SET email_enabled = EXCLUDED.email_enabled,
+                  telegram_enabled = EXCLUDED.telegram_enabled,
+                  updated_at = now()`
+
+	for _, p := range prefs {
+		if _, err := tx.Exec(ctx, query, userID, p.Type, p.EmailEnabled, p.TelegramEnabled); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,293 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type PaymentRepository struct {
+	db *database.DB
+}
+
+func NewPaymentRepository(db *database.DB) *PaymentRepository {
+	return &PaymentRepository{db: db}
+}
+
+// paymentColumns - общий список колонок для выборки оплаты
+const paymentColumns = `id, booking_id, payer_id, mentor_id, amount, currency, provider, provider_intent_id,
+              client_secret, status, captured_amount, refunded_amount, created_at, updated_at`
+
+// CreatePayment сохраняет оплату и заполняет ее ID, статус и даты.
+// Вторая незавершенная оплата занятия отклоняется индексом payments_booking_active_idx.
+func (r *PaymentRepository) CreatePayment(ctx context.Context, p *models.Payment) error {
+	query := `INSERT INTO payments (booking_id, payer_id, mentor_id, amount, currency, provider, provider_intent_id, client_secret)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+              RETURNING id, status, created_at, updated_at`
+
+	return r.db.Pool.QueryRow(ctx, query,
+		p.BookingID, p.PayerID, p.MentorID, p.Amount, p.Currency, p.Provider, p.ProviderIntentID, p.ClientSecret,
+	).Scan(&p.ID, &p.Status, &p.CreatedAt, &p.UpdatedAt)
+}
+
+// GetPaymentByID получает оплату по ID
+func (r *PaymentRepository) GetPaymentByID(ctx context.Context, id int) (*models.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE id = $1`
+
+	return scanPayment(r.db.Pool.QueryRow(ctx, query, id))
+}
+
+// GetLatestBookingPayment получает последнюю оплату занятия
+func (r *PaymentRepository) GetLatestBookingPayment(ctx context.Context, bookingID int) (*models.Payment, error) {
+	query := `SELECT ` + paymentColumns + `
+              FROM payments
+              WHERE booking_id = $1
+              ORDER BY created_at DESC, id DESC
+              LIMIT 1`
+
+	return scanPayment(r.db.Pool.QueryRow(ctx, query, bookingID))
+}
+
+// GetActiveBookingPayment получает незавершенную (pending или held) оплату занятия
+func (r *PaymentRepository) GetActiveBookingPayment(ctx context.Context, bookingID int) (*models.Payment, error) {
+	query := `SELECT ` + paymentColumns + `
+              FROM payments
+              WHERE booking_id = $1 AND status IN ('pending', 'held')`
+
+	return scanPayment(r.db.Pool.QueryRow(ctx, query, bookingID))
+}
+
+// ApplyEvent сохраняет событие провайдера и переводит оплату с этим платежом из статуса from
+// в статус to в одной транзакции. При переходе в held в журнал добавляется запись hold.
+// Возвращает nil без ошибки, если событие уже обрабатывалось или оплата уже в другом статусе.
+func (r *PaymentRepository) ApplyEvent(ctx context.Context, e *models.PaymentEvent, from, to string) (*models.Payment, error) {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, `INSERT INTO payment_events (provider, event_id, type, provider_intent_id, payload)
+              VALUES ($1, $2, $3, $4, $5)
+              ON CONFLICT (provider, event_id) DO NOTHING`,
+		e.Provider, e.EventID, e.Type, e.ProviderIntentID, e.Payload)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		// Повторная доставка события
+		return nil, tx.Commit(ctx)
+	}
+
+	payment, err := scanPayment(tx.QueryRow(ctx, `UPDATE payments
+              SET status = $4, updated_at = now()
+              WHERE provider = $1 AND provider_intent_id = $2 AND status = $3
+              RETURNING `+paymentColumns,
+		e.Provider, e.ProviderIntentID, from, to))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Событие сохраняем, чтобы не обрабатывать его снова
+			return nil, tx.Commit(ctx)
+		}
+		return nil, err
+	}
+
+	if to == models.PaymentHeld {
+		if err := insertLedgerEntry(ctx, tx, payment, models.LedgerHold, payment.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return payment, tx.Commit(ctx)
+}
+
+// Settle завершает удержанную оплату: списанная сумма captured и возвращенная refunded
+// записываются в оплату и в журнал. Строка оплаты блокируется до вызова charge, поэтому
+// провайдер вызывается только одним из параллельных вызовов; ошибка charge откатывает проведение.
+// Возвращает pgx.ErrNoRows, если оплата уже не в статусе held.
+func (r *PaymentRepository) Settle(ctx context.Context, p *models.Payment, status string, captured, refunded int, charge func(ctx context.Context) error) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var id int
+	err = tx.QueryRow(ctx, `SELECT id FROM payments WHERE id = $1 AND status = 'held' FOR UPDATE`, p.ID).Scan(&id)
+	if err != nil {
+		return err
+	}
+	if err := charge(ctx); err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(ctx, `UPDATE payments
+              SET status = $2, captured_amount = $3, refunded_amount = $4, updated_at = now()
+              WHERE id = $1 AND status = 'held'
+              RETURNING updated_at`,
+		p.ID, status, captured, refunded).Scan(&p.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	p.Status, p.CapturedAmount, p.RefundedAmount = status, captured, refunded
+
+	if captured > 0 {
+		if err := insertLedgerEntry(ctx, tx, p, models.LedgerCapture, captured); err != nil {
+			return err
+		}
+	}
+	if refunded > 0 {
+		if err := insertLedgerEntry(ctx, tx, p, models.LedgerRefund, refunded); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UpdateStatus переводит оплату из статуса from в статус to без движения денег.
+// Возвращает pgx.ErrNoRows, если оплата уже в другом статусе.
+func (r *PaymentRepository) UpdateStatus(ctx context.Context, id int, from, to string) error {
+	query := `UPDATE payments SET status = $3, updated_at = now()
+              WHERE id = $1 AND status = $2
+              RETURNING id`
+
+	return r.db.Pool.QueryRow(ctx, query, id, from, to).Scan(&id)
+}
+
+// GetMentorRevenueTrend получает списанные в пользу ментора суммы в валюте currency по периодам
+// period ('week' или 'month', в UTC) начала занятия, начиная с since. Периоды без списаний не возвращаются.
+func (r *PaymentRepository) GetMentorRevenueTrend(ctx context.Context, mentorID int, currency, period string, since time.Time) ([]models.RevenuePoint, error) {
+	query := `SELECT date_trunc($3, b.starts_at AT TIME ZONE 'UTC') AS period_start, SUM(l.amount)::INT
+              FROM ledger_entries l
+              JOIN payments p ON p.id = l.payment_id
+              JOIN bookings b ON b.id = p.booking_id
+              WHERE p.mentor_id = $1 AND l.currency = $2 AND l.entry_type = 'capture' AND b.starts_at >= $4
+              GROUP BY period_start
+              ORDER BY period_start`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID, currency, period, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []models.RevenuePoint
+	for rows.Next() {
+		var p models.RevenuePoint
+		if err := rows.Scan(&p.PeriodStart, &p.Revenue); err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+
+	return points, rows.Err()
+}
+
+// GetLedger получает страницу записей журнала, начиная с новых, и их общее количество.
+// paymentID ограничивает выборку одной оплатой.
+func (r *PaymentRepository) GetLedger(ctx context.Context, paymentID *int, limit, offset int) ([]*models.LedgerEntry, int, error) {
+	var total int
+	err := r.db.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM ledger_entries
+              WHERE $1::int IS NULL OR payment_id = $1`, paymentID).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT l.id, l.payment_id, p.booking_id, l.entry_type, l.amount, l.currency, l.created_at
+              FROM ledger_entries l
+              JOIN payments p ON p.id = l.payment_id
+              WHERE $1::int IS NULL OR l.payment_id = $1
+              ORDER BY l.created_at DESC, l.id DESC
+              LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Pool.Query(ctx, query, paymentID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var entries []*models.LedgerEntry
+	for rows.Next() {
+		e := &models.LedgerEntry{}
+		if err := rows.Scan(&e.ID, &e.PaymentID, &e.BookingID, &e.EntryType, &e.Amount, &e.Currency, &e.CreatedAt); err != nil {
+			return nil, 0, err
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+// GetUnbalancedPayments получает оплаты, у которых журнал не сходится с суммами в оплате:
+// удержано не столько, сколько оплачено, или списания и возвраты расходятся с записанными
+func (r *PaymentRepository) GetUnbalancedPayments(ctx context.Context) ([]*models.Payment, error) {
+	query := `SELECT ` + paymentColumns + `
+              FROM payments p
+              LEFT JOIN LATERAL (
+                  SELECT COALESCE(SUM(amount) FILTER (WHERE entry_type = 'hold'), 0) AS held,
+                         COALESCE(SUM(amount) FILTER (WHERE entry_type = 'capture'), 0) AS captured,
+                         COALESCE(SUM(amount) FILTER (WHERE entry_type = 'refund'), 0) AS refunded
+                  FROM ledger_entries WHERE payment_id = p.id
+              ) l ON true
+              WHERE l.captured <> p.captured_amount
+                 OR l.refunded <> p.refunded_amount
+                 OR (p.status IN ('held', 'captured', 'partially_refunded', 'refunded') AND l.held <> p.amount)
+                 OR (p.status IN ('captured', 'partially_refunded', 'refunded') AND l.held <> l.captured + l.refunded)
+              ORDER BY p.id`
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []*models.Payment
+	for rows.Next() {
+		p, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
+	return payments, rows.Err()
+}
+
+// insertLedgerEntry добавляет запись в журнал движения денег в рамках транзакции
+func insertLedgerEntry(ctx context.Context, tx pgx.Tx, p *models.Payment, entryType string, amount int) error {
+	_, err := tx.Exec(ctx, `INSERT INTO ledger_entries (payment_id, entry_type, amount, currency)
+              VALUES ($1, $2, $3, $4)`, p.ID, entryType, amount, p.Currency)
+	return err
+}
+
+// scanPayment читает оплату из строки результата, выбранной с колонками paymentColumns
+func scanPayment(row pgx.Row) (*models.Payment, error) {
+	p := &models.Payment{}
+	err := row.Scan(
+		&p.ID,
+		&p.BookingID,
+		&p.PayerID,
+		&p.MentorID,
+		&p.Amount,
+		&p.Currency,
+		&p.Provider,
+		&p.ProviderIntentID,
+		&p.ClientSecret,
+		&p.Status,
+		&p.CapturedAmount,
+		&p.RefundedAmount,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
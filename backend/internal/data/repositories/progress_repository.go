@@ -0,0 +1,239 @@
+package repositories
+
+import (
+	"context"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ProgressRepository struct {
+	db *database.DB
+}
+
+func NewProgressRepository(db *database.DB) *ProgressRepository {
+	return &ProgressRepository{db: db}
+}
+
+// GetTechnologyStats получает статистику ответов пользователя по технологиям вопросов.
+// Вопрос с несколькими технологиями учитывается в каждой из них.
+func (r *ProgressRepository) GetTechnologyStats(ctx context.Context, userID int) ([]models.TechnologyStat, error) {
+	query := `SELECT t.name, COUNT(*) AS answered, COUNT(*) FILTER (WHERE NOT uqp.is_correct) AS incorrect
+              FROM user_question_progress uqp
+              JOIN question_technologies qt ON qt.question_id = uqp.question_id
+              JOIN technologies t ON t.id = qt.technology_id
+              WHERE uqp.user_id = $1
+              GROUP BY t.name
+              ORDER BY t.name`
+
+	rows, err := r.db.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []models.TechnologyStat
+	for rows.Next() {
+		var s models.TechnologyStat
+		if err := rows.Scan(&s.Technology, &s.Answered, &s.Incorrect); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, rows.Err()
+}
+
+// GetMentorMentees получает учеников ментора (всех, кто бронировал занятия, кроме отмененных
+// до начала) со статистикой занятий и признаком согласия на просмотр прогресса
+func (r *ProgressRepository) GetMentorMentees(ctx context.Context, mentorID int, now time.Time) ([]*models.MenteeSummary, error) {
+	query := `SELECT u.id, COALESCE(u.name, u.username), u.avatar_url,
+                     COUNT(*) FILTER (WHERE b.status = 'completed'),
+                     MAX(b.starts_at) FILTER (WHERE b.status IN ('completed', 'no_show')),
+                     MIN(b.starts_at) FILTER (WHERE b.status IN ('requested', 'confirmed') AND b.ends_at > $2),
+                     EXISTS (SELECT 1 FROM mentee_progress_consents c
+                             WHERE c.mentee_id = u.id AND c.mentor_id = $1)
+              FROM bookings b
+              JOIN users u ON u.id = b.mentee_id
+              WHERE b.mentor_id = $1 AND b.status <> 'cancelled'
+              GROUP BY u.id
+              ORDER BY MAX(b.starts_at) DESC, u.id`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mentees []*models.MenteeSummary
+	for rows.Next() {
+		m := &models.MenteeSummary{}
+		err := rows.Scan(
+			&m.UserID,
+			&m.Name,
+			&m.AvatarURL,
+			&m.CompletedSessions,
+			&m.LastSessionAt,
+			&m.NextSessionAt,
+			&m.ProgressShared,
+		)
+		if err != nil {
+			return nil, err
+		}
+		mentees = append(mentees, m)
+	}
+
+	return mentees, rows.Err()
+}
+
+// GetCourseProgress получает прогресс пользователей по курсам, сгруппированный по ID пользователя
+func (r *ProgressRepository) GetCourseProgress(ctx context.Context, userIDs []int) (map[int][]models.CourseProgress, error) {
+	query := `SELECT ucp.user_id, c.id, c.title, ucp.completed_modules, ucp.total_modules,
+                     COALESCE(ucp.module_progress_pct,
+                              CASE WHEN ucp.total_modules > 0
+                                   THEN ucp.completed_modules * 100 / ucp.total_modules ELSE 0 END)
+              FROM user_course_progress ucp
+              JOIN courses c ON c.id = ucp.course_id
+              WHERE ucp.user_id = ANY($1)
+              ORDER BY ucp.user_id, ucp.updated_at DESC`
+
+	rows, err := r.db.Pool.Query(ctx, query, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	progress := make(map[int][]models.CourseProgress)
+	for rows.Next() {
+		var userID int
+		var p models.CourseProgress
+		if err := rows.Scan(&userID, &p.CourseID, &p.Title, &p.CompletedModules, &p.TotalModules, &p.ProgressPct); err != nil {
+			return nil, err
+		}
+		progress[userID] = append(progress[userID], p)
+	}
+
+	return progress, rows.Err()
+}
+
+// GetAnswerCounts получает количество отвеченных вопросов и верных ответов пользователей
+func (r *ProgressRepository) GetAnswerCounts(ctx context.Context, userIDs []int) (map[int]models.AnswerStats, error) {
+	query := `SELECT user_id, COUNT(*), COUNT(*) FILTER (WHERE is_correct)
+              FROM user_question_progress
+              WHERE user_id = ANY($1)
+              GROUP BY user_id`
+
+	rows, err := r.db.Pool.Query(ctx, query, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[int]models.AnswerStats)
+	for rows.Next() {
+		var userID int
+		var stats models.AnswerStats
+		if err := rows.Scan(&userID, &stats.Answered, &stats.Correct); err != nil {
+			return nil, err
+		}
+		counts[userID] = stats
+	}
+
+	return counts, rows.Err()
+}
+
+// GrantConsent разрешает ментору видеть прогресс ученика. Повторный вызов ничего не меняет.
+func (r *ProgressRepository) GrantConsent(ctx context.Context, menteeID, mentorID int) error {
+	query := `INSERT INTO mentee_progress_consents (mentee_id, mentor_id)
+              VALUES ($1, $2)
+              ON CONFLICT (mentee_id, mentor_id) DO NOTHING`
+
+	_, err := r.db.Pool.Exec(ctx, query, menteeID, mentorID)
+	return err
+}
+
+// RevokeConsent отзывает у ментора доступ к прогрессу ученика
+func (r *ProgressRepository) RevokeConsent(ctx context.Context, menteeID, mentorID int) error {
+	query := `DELETE FROM mentee_progress_consents WHERE mentee_id = $1 AND mentor_id = $2`
+
+	_, err := r.db.Pool.Exec(ctx, query, menteeID, mentorID)
+	return err
+}
+
+// progressColumns - колонки прогресса по вопросу в порядке scanQuestionProgress
+const progressColumns = `question_id, is_correct, attempts, last_answer,
+                     EXTRACT(EPOCH FROM time_spent)::int,
+                     (SELECT r.revision FROM question_revisions r WHERE r.id = revision_id), answered_at,
+                     ease_factor, interval_days, repetitions, lapses, due_at`
+
+// GetQuestionProgress получает прогресс пользователя по вопросу
+func (r *ProgressRepository) GetQuestionProgress(ctx context.Context, userID, questionID int) (*models.QuestionProgress, error) {
+	query := `SELECT ` + progressColumns + `
+              FROM user_question_progress
+              WHERE user_id = $1 AND question_id = $2`
+
+	return scanQuestionProgress(r.db.Pool.QueryRow(ctx, query, userID, questionID))
+}
+
+// SaveAnswer сохраняет попытку ответа на вопрос, проверенную по ревизии revision. Первая попытка
+// привязывается к модулю курса, в который входит вопрос, повторные увеличивают счетчик попыток
+// и суммируют затраченное время. Расписание повторения заменяется на schedule.
+func (r *ProgressRepository) SaveAnswer(ctx context.Context, userID, questionID, revision int, answer string, isCorrect bool, timeSpentSeconds *int, schedule models.ReviewSchedule) (*models.QuestionProgress, error) {
+	query := `WITH placement AS (
+                  SELECT m.id, m.course_id
+                  FROM module_questions mq
+                  JOIN modules m ON m.id = mq.module_id
+                  WHERE mq.question_id = $2
+                  ORDER BY m.course_id, m.module_order
+                  LIMIT 1
+              )
+              INSERT INTO user_question_progress
+                  (user_id, course_id, module_id, question_id, is_correct, attempts, time_spent, last_answer,
+                   revision_id, ease_factor, interval_days, repetitions, lapses, due_at)
+              VALUES ($1, (SELECT course_id FROM placement), (SELECT id FROM placement), $2, $3, 1,
+                      make_interval(secs => $4), $5,
+                      (SELECT id FROM question_revisions WHERE question_id = $2 AND revision = $6),
+                      $7, $8, $9, $10, $11)
+              ON CONFLICT (user_id, question_id) DO UPDATE
+              SET is_correct = EXCLUDED.is_correct,
+                  attempts = user_question_progress.attempts + 1,
+                  time_spent = COALESCE(user_question_progress.time_spent + EXCLUDED.time_spent,
+                                        EXCLUDED.time_spent, user_question_progress.time_spent),
+                  last_answer = EXCLUDED.last_answer,
+                  revision_id = EXCLUDED.revision_id,
+                  ease_factor = EXCLUDED.ease_factor,
+                  interval_days = EXCLUDED.interval_days,
+                  repetitions = EXCLUDED.repetitions,
+                  lapses = EXCLUDED.lapses,
+                  due_at = EXCLUDED.due_at,
+                  answered_at = now(),
+                  updated_at = now()
+              RETURNING ` + progressColumns
+
+	return scanQuestionProgress(r.db.Pool.QueryRow(ctx, query, userID, questionID, isCorrect, timeSpentSeconds, answer, revision,
+		schedule.EaseFactor, schedule.IntervalDays, schedule.Repetitions, schedule.Lapses, schedule.DueAt))
+}
+
+func scanQuestionProgress(row pgx.Row) (*models.QuestionProgress, error) {
+	p := &models.QuestionProgress{}
+	err := row.Scan(
+		&p.QuestionID,
+		&p.IsCorrect,
+		&p.Attempts,
+		&p.LastAnswer,
+		&p.TimeSpentSeconds,
+		&p.Revision,
+		&p.AnsweredAt,
+		&p.Schedule.EaseFactor,
+		&p.Schedule.IntervalDays,
+		&p.Schedule.Repetitions,
+		&p.Schedule.Lapses,
+		&p.Schedule.DueAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
@@ -3,7 +3,13 @@ package repositories
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"it_rabotyagi/internal/business/models"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -15,148 +21,841 @@ func NewQuestionRepository(db *pgxpool.Pool) *QuestionRepository {
 	return &QuestionRepository{db: db}
 }
 
-// QuestionListItem представляет краткую информацию о вопросе
-type QuestionListItem struct {
-	ID         int    `json:"id"`
-	Title      string `json:"title"`
-	Technology string `json:"technology"`
+// questionTechnologies - технологии вопроса q в алфавитном порядке
+const questionTechnologies = `ARRAY(SELECT t.name FROM question_technologies qt
+		      JOIN technologies t ON t.id = qt.technology_id
+		      WHERE qt.question_id = q.id
+		      ORDER BY t.name)`
+
+// questionStats - статистика ответов на вопрос q по всем пользователям
+const questionStats = `
+		LEFT JOIN LATERAL (
+		    SELECT COUNT(*) AS answered,
+		           COUNT(*) FILTER (WHERE p.is_correct) AS correct,
+		           COALESCE(SUM(p.attempts), 0) AS attempts
+		    FROM user_question_progress p
+		    WHERE p.question_id = q.id
+		) st ON true`
+
+// GetAllQuestions получает страницу вопросов, подходящих под фильтр, в заданном порядке
+// и их общее количество. Вопрос с несколькими технологиями возвращается один раз со всеми технологиями.
+func (r *QuestionRepository) GetAllQuestions(ctx context.Context, filter models.QuestionFilter, limit, offset int) ([]*models.QuestionSummary, int, error) {
+	b := &questionQuery{}
+	b.applyFilter(filter)
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM questions q`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT q.id, q.title, q.type, COALESCE(q.difficulty, ''), ` + questionTechnologies + `,
+		       COALESCE(q.company_tag, '{}'), q.created_at, st.answered, st.attempts,
+		       st.correct::float8 / NULLIF(st.answered, 0)
+		FROM questions q` + questionStats + b.where() + `
+		ORDER BY ` + questionOrderBy(filter.Sort) + `
+		LIMIT ` + b.arg(limit) + ` OFFSET ` + b.arg(offset)
+
+	rows, err := r.db.Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var questions []*models.QuestionSummary
+	for rows.Next() {
+		q := &models.QuestionSummary{}
+		err := rows.Scan(
+			&q.ID,
+			&q.Title,
+			&q.Type,
+			&q.Difficulty,
+			&q.Technologies,
+			&q.CompanyTags,
+			&q.CreatedAt,
+			&q.AnsweredCount,
+			&q.AttemptsCount,
+			&q.SuccessRate,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		questions = append(questions, q)
+	}
+
+	return questions, total, rows.Err()
 }
 
-// QuestionDetail представляет полную информацию о вопросе
-type QuestionDetail struct {
-	ID            int      `json:"id"`
-	Title         string   `json:"title"`
-	Content       string   `json:"content"`
-	Difficulty    string   `json:"difficulty"`
-	Technology    string   `json:"technology"`
-	Options       []string `json:"options"`
-	CorrectAnswer string   `json:"correct_answer"`
-	Explanation   string   `json:"explanation"`
+// questionOrderBy строит ORDER BY списка вопросов; ID делает порядок стабильным
+// для постраничной навигации
+func questionOrderBy(sort string) string {
+	switch sort {
+	case models.QuestionSortNewest:
+		return "q.created_at DESC, q.id DESC"
+	case models.QuestionSortHardest:
+		return "st.correct::float8 / NULLIF(st.answered, 0) ASC NULLS LAST, st.answered DESC, q.id"
+	case models.QuestionSortMostAttempted:
+		return "st.attempts DESC, q.id"
+	}
+	return "q.id"
 }
 
-// GetAllQuestions получает список всех вопросов с их технологиями
-func (r *QuestionRepository) GetAllQuestions(ctx context.Context, technology *string, limit, offset int) ([]QuestionListItem, int, error) {
-	var questions []QuestionListItem
+// SearchQuestions ищет вопросы по словам запроса в заголовке, тексте и объяснении с учетом
+// русской и английской морфологии. Результаты упорядочены по релевантности, найденные слова
+// в заголовке и фрагменте текста выделены тегом <mark>, остальной текст экранирован как HTML.
+func (r *QuestionRepository) SearchQuestions(ctx context.Context, text string, filter models.QuestionFilter, limit, offset int) ([]*models.QuestionSearchHit, int, error) {
+	b := &questionQuery{}
+	// Запрос разбирается в обеих конфигурациях, совпадение с любой из них засчитывается
+	from := fmt.Sprintf(`
+		FROM questions q,
+		     (SELECT websearch_to_tsquery('russian', %[1]s) || websearch_to_tsquery('english', %[1]s) AS query) sq`,
+		b.arg(text))
+	b.applyFilter(filter)
+	b.addCondition("q.search_vector @@ sq.query")
+
 	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*)`+from+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
 
-	// Базовый запрос с JOIN для получения технологии
-	baseQuery := `
-		SELECT q.id, q.title, t.name as technology
-		FROM questions q
-		JOIN question_technologies qt ON q.id = qt.question_id
-		JOIN technologies t ON qt.technology_id = t.id
-	`
+	query := `SELECT q.id, q.title, q.type, COALESCE(q.difficulty, ''), ` + questionTechnologies + `,
+		       COALESCE(q.company_tag, '{}'), ts_rank_cd(q.search_vector, sq.query),
+		       ts_headline('russian', ` + escapeHTML("q.title") + `, sq.query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true'),
+		       ts_headline('russian', ` + escapeHTML("q.content") + `, sq.query,
+		                   'StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2')` + from + b.where() + `
+		ORDER BY ts_rank_cd(q.search_vector, sq.query) DESC, q.id
+		LIMIT ` + b.arg(limit) + ` OFFSET ` + b.arg(offset)
 
-	countQuery := `
-		SELECT COUNT(DISTINCT q.id)
+	rows, err := r.db.Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var hits []*models.QuestionSearchHit
+	for rows.Next() {
+		h := &models.QuestionSearchHit{}
+		err := rows.Scan(
+			&h.ID,
+			&h.Title,
+			&h.Type,
+			&h.Difficulty,
+			&h.Technologies,
+			&h.CompanyTags,
+			&h.Rank,
+			&h.TitleHighlight,
+			&h.Snippet,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		hits = append(hits, h)
+	}
+
+	return hits, total, rows.Err()
+}
+
+// GetReviewQueue получает страницу опубликованных вопросов из фильтра, которые пользователю
+// пора повторить к моменту now, начиная с самых просроченных
+func (r *QuestionRepository) GetReviewQueue(ctx context.Context, userID int, filter models.QuestionFilter, now time.Time, limit, offset int) (*models.ReviewQueue, error) {
+	b := &questionQuery{}
+	from := `
 		FROM questions q
-		JOIN question_technologies qt ON q.id = qt.question_id
-		JOIN technologies t ON qt.technology_id = t.id
-	`
+		JOIN user_question_progress uqp ON uqp.question_id = q.id AND uqp.user_id = ` + b.arg(userID)
+	b.applyFilter(filter)
+	nowArg := b.arg(now)
 
-	// Добавляем фильтр по технологии, если указан
-	if technology != nil && *technology != "" {
-		baseQuery += " WHERE t.name = $1"
-		countQuery += " WHERE t.name = $1"
+	queue := &models.ReviewQueue{}
+	query := `SELECT COUNT(*) FILTER (WHERE uqp.due_at <= ` + nowArg + `),
+		       MIN(uqp.due_at) FILTER (WHERE uqp.due_at > ` + nowArg + `)` + from + b.where()
+	if err := r.db.QueryRow(ctx, query, b.args...).Scan(&queue.Total, &queue.NextDueAt); err != nil {
+		return nil, err
 	}
 
-	// Добавляем сортировку и пагинацию
-	baseQuery += " ORDER BY q.id"
+	b.addCondition("uqp.due_at <= " + nowArg)
+	query = `SELECT q.id, q.title, q.type, COALESCE(q.difficulty, ''), ` + questionTechnologies + `,
+		       COALESCE(q.company_tag, '{}'), q.created_at, uqp.is_correct, uqp.attempts,
+		       uqp.ease_factor, uqp.interval_days, uqp.repetitions, uqp.lapses, uqp.due_at` + from + b.where() + `
+		ORDER BY uqp.due_at, q.id
+		LIMIT ` + b.arg(limit) + ` OFFSET ` + b.arg(offset)
 
-	if limit > 0 {
-		if technology != nil && *technology != "" {
-			baseQuery += " LIMIT $2 OFFSET $3"
-		} else {
-			baseQuery += " LIMIT $1 OFFSET $2"
-		}
+	rows, err := r.db.Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
 
-	// Получаем общее количество
-	if technology != nil && *technology != "" {
-		err := r.db.QueryRow(ctx, countQuery, *technology).Scan(&total)
+	for rows.Next() {
+		item := &models.ReviewQueueItem{Question: &models.QuestionSummary{}}
+		err := rows.Scan(
+			&item.Question.ID,
+			&item.Question.Title,
+			&item.Question.Type,
+			&item.Question.Difficulty,
+			&item.Question.Technologies,
+			&item.Question.CompanyTags,
+			&item.Question.CreatedAt,
+			&item.IsCorrect,
+			&item.Attempts,
+			&item.Schedule.EaseFactor,
+			&item.Schedule.IntervalDays,
+			&item.Schedule.Repetitions,
+			&item.Schedule.Lapses,
+			&item.Schedule.DueAt,
+		)
 		if err != nil {
-			return nil, 0, err
+			return nil, err
 		}
-	} else {
-		err := r.db.QueryRow(ctx, countQuery).Scan(&total)
-		if err != nil {
-			return nil, 0, err
+		queue.Items = append(queue.Items, item)
+	}
+
+	return queue, rows.Err()
+}
+
+// escapeHTML экранирует спецсимволы HTML в текстовом выражении SQL. Подсветка возвращается
+// клиенту как HTML, поэтому текст вопроса вокруг тегов <mark> не должен содержать разметки.
+func escapeHTML(expr string) string {
+	return "replace(replace(replace(" + expr + ", '&', '&amp;'), '<', '&lt;'), '>', '&gt;')"
+}
+
+// ListTechnologies получает все технологии с количеством опубликованных вопросов по каждой
+func (r *QuestionRepository) ListTechnologies(ctx context.Context) ([]models.Technology, error) {
+	query := `SELECT t.id, t.name, COUNT(q.id)
+		FROM technologies t
+		LEFT JOIN question_technologies qt ON qt.technology_id = t.id
+		LEFT JOIN questions q ON q.id = qt.question_id AND q.status = 'published'
+		GROUP BY t.id
+		ORDER BY t.name`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var technologies []models.Technology
+	for rows.Next() {
+		var t models.Technology
+		if err := rows.Scan(&t.ID, &t.Name, &t.QuestionCount); err != nil {
+			return nil, err
 		}
+		technologies = append(technologies, t)
 	}
 
-	// Получаем вопросы
-	var rows interface{ Close() }
-	var err error
+	return technologies, rows.Err()
+}
+
+// questionQuery собирает условия запроса к вопросам q. Параметры нумеруются по мере
+// добавления, поэтому условия и части запроса можно добавлять в любом порядке.
+type questionQuery struct {
+	args       []interface{}
+	conditions []string
+}
 
-	if technology != nil && *technology != "" {
-		if limit > 0 {
-			rows, err = r.db.Query(ctx, baseQuery, *technology, limit, offset)
+// arg добавляет параметр запроса и возвращает его плейсхолдер
+func (b *questionQuery) arg(value interface{}) string {
+	b.args = append(b.args, value)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// addCondition добавляет условие, которое должно выполняться для вопроса
+func (b *questionQuery) addCondition(condition string) {
+	b.conditions = append(b.conditions, condition)
+}
+
+// where возвращает WHERE из всех добавленных условий или пустую строку
+func (b *questionQuery) where() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(b.conditions, " AND ")
+}
+
+// applyFilter добавляет условия фильтра; подходят только опубликованные вопросы.
+// Названия технологий и тегов компаний в фильтре приходят в нижнем регистре и без повторов.
+func (b *questionQuery) applyFilter(filter models.QuestionFilter) {
+	b.addCondition("q.status = 'published'")
+	if len(filter.Technologies) > 0 {
+		matched := `SELECT COUNT(DISTINCT lower(t.name))
+			FROM question_technologies qt
+			JOIN technologies t ON t.id = qt.technology_id
+			WHERE qt.question_id = q.id AND lower(t.name) = ANY(` + b.arg(filter.Technologies) + `)`
+		if filter.TechnologiesMatchAll {
+			b.addCondition(fmt.Sprintf("(%s) = %d", matched, len(filter.Technologies)))
 		} else {
-			rows, err = r.db.Query(ctx, baseQuery, *technology)
+			b.addCondition(fmt.Sprintf("(%s) > 0", matched))
 		}
-	} else {
-		if limit > 0 {
-			rows, err = r.db.Query(ctx, baseQuery, limit, offset)
+	}
+	if filter.AuthorID != 0 {
+		b.addCondition("q.author_id = " + b.arg(filter.AuthorID))
+	}
+	if len(filter.Difficulties) > 0 {
+		b.addCondition("q.difficulty = ANY(" + b.arg(filter.Difficulties) + ")")
+	}
+	if len(filter.CompanyTags) > 0 {
+		b.addCondition("EXISTS (SELECT 1 FROM unnest(q.company_tag) c WHERE lower(c) = ANY(" + b.arg(filter.CompanyTags) + "))")
+	}
+
+	switch filter.Status {
+	case models.QuestionStatusUnanswered:
+		b.addCondition(`NOT EXISTS (SELECT 1 FROM user_question_progress p
+			WHERE p.question_id = q.id AND p.user_id = ` + b.arg(filter.UserID) + `)`)
+	case models.QuestionStatusFailed:
+		b.addCondition(`EXISTS (SELECT 1 FROM user_question_progress p
+			WHERE p.question_id = q.id AND p.user_id = ` + b.arg(filter.UserID) + ` AND NOT p.is_correct)`)
+	}
+}
+
+// questionColumns - колонки вопроса в порядке scanQuestion
+const questionColumns = `q.id, q.author_id, (SELECT COALESCE(u.name, u.username) FROM users u WHERE u.id = q.author_id),
+		q.status, q.external_key, q.title, q.content, COALESCE(q.difficulty, ''),
+		q.type, COALESCE(q.options, '[]'), q.code_language, q.starter_code, q.answer_key, q.explanation,
+		` + questionTechnologies + `,
+		COALESCE(q.company_tag, '{}'), q.revision, q.created_at, q.updated_at`
+
+// GetQuestionByID получает полную информацию о вопросе по ID
+func (r *QuestionRepository) GetQuestionByID(ctx context.Context, id int) (*models.Question, error) {
+	query := `SELECT ` + questionColumns + ` FROM questions q WHERE q.id = $1`
+
+	return scanQuestion(r.db.QueryRow(ctx, query, id))
+}
+
+// GetQuestionsForExport получает все вопросы, подходящие под фильтр, вместе с ключами ответов
+func (r *QuestionRepository) GetQuestionsForExport(ctx context.Context, filter models.QuestionFilter) ([]*models.Question, error) {
+	b := &questionQuery{}
+	b.applyFilter(filter)
+
+	return r.queryQuestions(ctx, `SELECT `+questionColumns+` FROM questions q`+b.where()+` ORDER BY q.id`, b.args...)
+}
+
+// GetQuestionsByExternalKeys получает вопросы с указанными ключами импорта
+func (r *QuestionRepository) GetQuestionsByExternalKeys(ctx context.Context, keys []string) ([]*models.Question, error) {
+	return r.queryQuestions(ctx, `SELECT `+questionColumns+` FROM questions q WHERE q.external_key = ANY($1)`, keys)
+}
+
+func (r *QuestionRepository) queryQuestions(ctx context.Context, query string, args ...interface{}) ([]*models.Question, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []*models.Question
+	for rows.Next() {
+		q, err := scanQuestion(rows)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, q)
+	}
+
+	return questions, rows.Err()
+}
+
+// CreateQuestion создает вопрос вместе с привязкой к технологиям
+func (r *QuestionRepository) CreateQuestion(ctx context.Context, q *models.Question) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := insertQuestion(ctx, tx, q); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UpdateQuestion полностью заменяет содержимое вопроса и его технологии и сохраняет новую ревизию
+// от имени editorID. Возвращает pgx.ErrNoRows, если вопрос не найден.
+func (r *QuestionRepository) UpdateQuestion(ctx context.Context, q *models.Question, editorID int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := updateQuestion(ctx, tx, q, editorID, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RollbackQuestion заменяет содержимое вопроса содержимым ревизии revision
+// и сохраняет его как новую ревизию: история не переписывается
+func (r *QuestionRepository) RollbackQuestion(ctx context.Context, q *models.Question, editorID, revision int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := updateQuestion(ctx, tx, q, editorID, &revision); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ImportQuestions сохраняет вопросы одной транзакцией: вопросы без ID создаются,
+// остальные обновляются от имени editorID. Если хотя бы один не сохранился, не сохраняется ни один.
+func (r *QuestionRepository) ImportQuestions(ctx context.Context, questions []*models.Question, editorID int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	for _, q := range questions {
+		if q.ID == 0 {
+			err = insertQuestion(ctx, tx, q)
 		} else {
-			rows, err = r.db.Query(ctx, baseQuery)
+			err = updateQuestion(ctx, tx, q, editorID, nil)
+		}
+		if err != nil {
+			return err
 		}
 	}
 
+	return tx.Commit(ctx)
+}
+
+func insertQuestion(ctx context.Context, tx pgx.Tx, q *models.Question) error {
+	optionsJSON, err := json.Marshal(q.Options)
+	if err != nil {
+		return err
+	}
+	answerKeyJSON, err := json.Marshal(q.AnswerKey)
+	if err != nil {
+		return err
+	}
+
+	if q.Status == "" {
+		q.Status = models.QuestionPublished
+	}
+
+	query := `INSERT INTO questions (author_id, status, external_key, title, content, difficulty, type, options,
+		                       code_language, starter_code, answer_key, explanation, company_tag)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id, revision, created_at, updated_at`
+
+	err = tx.QueryRow(ctx, query,
+		q.AuthorID,
+		q.Status,
+		q.ExternalKey,
+		q.Title,
+		q.Content,
+		q.Difficulty,
+		q.Type,
+		optionsJSON,
+		q.Language,
+		q.StarterCode,
+		answerKeyJSON,
+		q.Explanation,
+		q.CompanyTags,
+	).Scan(&q.ID, &q.Revision, &q.CreatedAt, &q.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := setQuestionTechnologies(ctx, tx, q.ID, q.Technologies); err != nil {
+		return err
+	}
+	return insertQuestionRevision(ctx, tx, q.ID, q.AuthorID, nil)
+}
+
+// updateQuestion заменяет содержимое вопроса и сохраняет ревизию; автор и ключ импорта
+// не меняются. rolledBackFrom - номер ревизии, если правка - откат к ней.
+func updateQuestion(ctx context.Context, tx pgx.Tx, q *models.Question, editorID int, rolledBackFrom *int) error {
+	optionsJSON, err := json.Marshal(q.Options)
+	if err != nil {
+		return err
+	}
+	answerKeyJSON, err := json.Marshal(q.AnswerKey)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE questions
+		SET title = $2, content = $3, difficulty = $4, type = $5, options = $6, code_language = $7,
+		    starter_code = $8, answer_key = $9, explanation = $10, company_tag = $11,
+		    revision = revision + 1, updated_at = now()
+		WHERE id = $1
+		RETURNING revision, created_at, updated_at`
+
+	err = tx.QueryRow(ctx, query,
+		q.ID,
+		q.Title,
+		q.Content,
+		q.Difficulty,
+		q.Type,
+		optionsJSON,
+		q.Language,
+		q.StarterCode,
+		answerKeyJSON,
+		q.Explanation,
+		q.CompanyTags,
+	).Scan(&q.Revision, &q.CreatedAt, &q.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM question_technologies WHERE question_id = $1`, q.ID); err != nil {
+		return err
+	}
+	if err := setQuestionTechnologies(ctx, tx, q.ID, q.Technologies); err != nil {
+		return err
+	}
+
+	var editor *int
+	if editorID != 0 {
+		editor = &editorID
+	}
+	return insertQuestionRevision(ctx, tx, q.ID, editor, rolledBackFrom)
+}
+
+// insertQuestionRevision сохраняет текущее содержимое вопроса как его текущую ревизию
+func insertQuestionRevision(ctx context.Context, tx pgx.Tx, questionID int, editorID, rolledBackFrom *int) error {
+	query := `INSERT INTO question_revisions (question_id, revision, editor_id, rolled_back_from, title, content,
+		                                difficulty, type, options, code_language, starter_code, answer_key,
+		                                explanation, technologies, company_tag)
+		SELECT q.id, q.revision, $2, $3, q.title, q.content, q.difficulty, q.type, q.options, q.code_language,
+		       q.starter_code, q.answer_key, q.explanation, ` + questionTechnologies + `, q.company_tag
+		FROM questions q
+		WHERE q.id = $1`
+
+	_, err := tx.Exec(ctx, query, questionID, editorID, rolledBackFrom)
+	return err
+}
+
+// revisionColumns - колонки ревизии в порядке scanQuestionRevision
+const revisionColumns = `r.question_id, r.revision, r.editor_id, r.rolled_back_from, r.title, r.content,
+		COALESCE(r.difficulty, ''), r.type, COALESCE(r.options, '[]'), r.code_language, r.starter_code,
+		r.answer_key, r.explanation, r.technologies, COALESCE(r.company_tag, '{}'), r.created_at`
+
+// GetQuestionRevisions получает все ревизии вопроса, начиная с последней
+func (r *QuestionRepository) GetQuestionRevisions(ctx context.Context, questionID int) ([]*models.QuestionRevision, error) {
+	query := `SELECT ` + revisionColumns + `
+		FROM question_revisions r
+		WHERE r.question_id = $1
+		ORDER BY r.revision DESC`
+
+	rows, err := r.db.Query(ctx, query, questionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*models.QuestionRevision
+	for rows.Next() {
+		rev, err := scanQuestionRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, rev)
+	}
+
+	return revisions, rows.Err()
+}
+
+// GetQuestionRevision получает ревизию вопроса по номеру
+func (r *QuestionRepository) GetQuestionRevision(ctx context.Context, questionID, revision int) (*models.QuestionRevision, error) {
+	query := `SELECT ` + revisionColumns + `
+		FROM question_revisions r
+		WHERE r.question_id = $1 AND r.revision = $2`
+
+	return scanQuestionRevision(r.db.QueryRow(ctx, query, questionID, revision))
+}
+
+// submissionColumns - колонки предложенного вопроса в порядке scanQuestionSubmission
+const submissionColumns = questionColumns + `, q.reviewer_id, q.review_comment, q.reviewed_at, q.duplicate_of`
+
+// isSubmission отбирает вопросы, предложенные пользователями: они либо ждут модерации,
+// либо уже рассмотрены модератором
+const isSubmission = `(q.status <> 'published' OR q.reviewed_at IS NOT NULL)`
+
+// GetSubmissionByID получает предложенный вопрос вместе с решением модератора
+func (r *QuestionRepository) GetSubmissionByID(ctx context.Context, id int) (*models.QuestionSubmission, error) {
+	query := `SELECT ` + submissionColumns + ` FROM questions q WHERE q.id = $1 AND ` + isSubmission
+
+	return scanQuestionSubmission(r.db.QueryRow(ctx, query, id))
+}
+
+// GetUserSubmissions получает вопросы, предложенные пользователем, начиная с последних
+func (r *QuestionRepository) GetUserSubmissions(ctx context.Context, userID int) ([]*models.QuestionSubmission, error) {
+	query := `SELECT ` + submissionColumns + `
+		FROM questions q
+		WHERE q.author_id = $1 AND ` + isSubmission + `
+		ORDER BY q.created_at DESC, q.id DESC`
+
+	return r.querySubmissions(ctx, query, userID)
+}
+
+// GetSubmissionsByStatus получает страницу предложенных вопросов с указанным статусом
+// в порядке подачи и их общее количество
+func (r *QuestionRepository) GetSubmissionsByStatus(ctx context.Context, status string, limit, offset int) ([]*models.QuestionSubmission, int, error) {
+	var total int
+	countQuery := `SELECT COUNT(*) FROM questions q WHERE q.status = $1 AND ` + isSubmission
+	if err := r.db.QueryRow(ctx, countQuery, status).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + submissionColumns + `
+		FROM questions q
+		WHERE q.status = $1 AND ` + isSubmission + `
+		ORDER BY q.created_at, q.id
+		LIMIT $2 OFFSET $3`
+
+	submissions, err := r.querySubmissions(ctx, query, status, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
+	return submissions, total, nil
+}
+
+func (r *QuestionRepository) querySubmissions(ctx context.Context, query string, args ...interface{}) ([]*models.QuestionSubmission, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
 
-	pgxRows := rows.(interface {
-		Next() bool
-		Scan(...interface{}) error
-		Close()
-	})
+	var submissions []*models.QuestionSubmission
+	for rows.Next() {
+		submission, err := scanQuestionSubmission(rows)
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, submission)
+	}
 
-	for pgxRows.Next() {
-		var q QuestionListItem
-		if err := pgxRows.Scan(&q.ID, &q.Title, &q.Technology); err != nil {
-			return nil, 0, err
+	return submissions, rows.Err()
+}
+
+// CountUserSubmissions считает вопросы пользователя в указанном статусе
+func (r *QuestionRepository) CountUserSubmissions(ctx context.Context, userID int, status string) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM questions q WHERE q.author_id = $1 AND q.status = $2 AND ` + isSubmission
+	err := r.db.QueryRow(ctx, query, userID, status).Scan(&count)
+	return count, err
+}
+
+// UpdateSubmission заменяет содержимое предложенного вопроса, пока он не опубликован, и сохраняет
+// ревизию от имени editorID. Отклоненный вопрос возвращается в очередь модерации.
+// Возвращает pgx.ErrNoRows, если вопрос уже опубликован или объединен с другим.
+func (r *QuestionRepository) UpdateSubmission(ctx context.Context, q *models.Question, editorID int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Смена статуса блокирует строку, поэтому одобрить вопрос посреди правки нельзя
+	tag, err := tx.Exec(ctx, `UPDATE questions
+		SET status = 'draft', reviewer_id = NULL, review_comment = NULL, reviewed_at = NULL
+		WHERE id = $1 AND status IN ('draft', 'rejected')`, q.ID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := updateQuestion(ctx, tx, q, editorID, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ApproveSubmission публикует вопрос из очереди модерации.
+// Возвращает pgx.ErrNoRows, если вопроса нет в очереди.
+func (r *QuestionRepository) ApproveSubmission(ctx context.Context, id, reviewerID int, comment *string) error {
+	return r.reviewSubmission(ctx, id, models.QuestionPublished, reviewerID, comment)
+}
+
+// RejectSubmission отклоняет вопрос из очереди модерации.
+// Возвращает pgx.ErrNoRows, если вопроса нет в очереди.
+func (r *QuestionRepository) RejectSubmission(ctx context.Context, id, reviewerID int, reason string) error {
+	return r.reviewSubmission(ctx, id, models.QuestionRejected, reviewerID, &reason)
+}
+
+func (r *QuestionRepository) reviewSubmission(ctx context.Context, id int, status string, reviewerID int, comment *string) error {
+	query := `UPDATE questions
+		SET status = $2, reviewer_id = $3, review_comment = $4, reviewed_at = now(), updated_at = now()
+		WHERE id = $1 AND status = 'draft'`
+
+	tag, err := r.db.Exec(ctx, query, id, status, reviewerID, comment)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// MergeSubmission помечает вопрос из очереди модерации дубликатом опубликованного вопроса original.
+// Если original не nil, содержимое опубликованного вопроса заменяется им в той же транзакции.
+// Возвращает pgx.ErrNoRows, если вопроса нет в очереди.
+func (r *QuestionRepository) MergeSubmission(ctx context.Context, id, reviewerID int, comment *string, duplicateOf int, original *models.Question) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	query := `UPDATE questions
+		SET status = 'merged', duplicate_of = $2, reviewer_id = $3, review_comment = $4,
+		    reviewed_at = now(), updated_at = now()
+		WHERE id = $1 AND status = 'draft'`
+
+	tag, err := tx.Exec(ctx, query, id, duplicateOf, reviewerID, comment)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if original != nil {
+		if err := updateQuestion(ctx, tx, original, reviewerID, nil); err != nil {
+			return err
 		}
-		questions = append(questions, q)
 	}
 
-	return questions, total, nil
+	return tx.Commit(ctx)
 }
 
-// GetQuestionByID получает полную информацию о вопросе по ID
-func (r *QuestionRepository) GetQuestionByID(ctx context.Context, id int) (*QuestionDetail, error) {
-	query := `
-		SELECT q.id, q.title, q.content, q.difficulty, q.options, q.correct_answer, q.explanation, t.name as technology
-		FROM questions q
-		JOIN question_technologies qt ON q.id = qt.question_id
-		JOIN technologies t ON qt.technology_id = t.id
-		WHERE q.id = $1
-		LIMIT 1
-	`
+// DeleteQuestion удаляет вопрос. Привязки к технологиям, модулям и прогресс удаляются каскадно.
+func (r *QuestionRepository) DeleteQuestion(ctx context.Context, id int) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM questions WHERE id = $1`, id)
+	return err
+}
+
+// setQuestionTechnologies привязывает вопрос к технологиям по названию. Название сравнивается
+// без учета регистра, отсутствующая технология создается.
+func setQuestionTechnologies(ctx context.Context, tx pgx.Tx, questionID int, technologies []string) error {
+	for _, name := range technologies {
+		var technologyID int
+		err := tx.QueryRow(ctx, `SELECT id FROM technologies WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`, name).Scan(&technologyID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = tx.QueryRow(ctx, `INSERT INTO technologies (name) VALUES ($1)
+				ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+				RETURNING id`, name).Scan(&technologyID)
+		}
+		if err != nil {
+			return err
+		}
 
-	var q QuestionDetail
-	var optionsJSON []byte
+		query := `INSERT INTO question_technologies (question_id, technology_id)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING`
+		if _, err := tx.Exec(ctx, query, questionID, technologyID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	err := r.db.QueryRow(ctx, query, id).Scan(
+// scanQuestion читает колонки questionColumns, а после них - колонки в extra
+func scanQuestion(row pgx.Row, extra ...interface{}) (*models.Question, error) {
+	q := &models.Question{}
+	var optionsJSON, answerKeyJSON []byte
+
+	dest := []interface{}{
 		&q.ID,
+		&q.AuthorID,
+		&q.AuthorName,
+		&q.Status,
+		&q.ExternalKey,
 		&q.Title,
 		&q.Content,
 		&q.Difficulty,
+		&q.Type,
 		&optionsJSON,
-		&q.CorrectAnswer,
+		&q.Language,
+		&q.StarterCode,
+		&answerKeyJSON,
 		&q.Explanation,
-		&q.Technology,
-	)
+		&q.Technologies,
+		&q.CompanyTags,
+		&q.Revision,
+		&q.CreatedAt,
+		&q.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	// Парсим JSON с вариантами ответов
+	if err := json.Unmarshal(optionsJSON, &q.Options); err != nil {
+		return nil, err
+	}
+	if answerKeyJSON != nil {
+		if err := json.Unmarshal(answerKeyJSON, &q.AnswerKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return q, nil
+}
 
+func scanQuestionRevision(row pgx.Row) (*models.QuestionRevision, error) {
+	rev := &models.QuestionRevision{}
+	q := &models.Question{}
+	var optionsJSON, answerKeyJSON []byte
+
+	err := row.Scan(
+		&rev.QuestionID,
+		&rev.Revision,
+		&rev.EditorID,
+		&rev.RolledBackFrom,
+		&q.Title,
+		&q.Content,
+		&q.Difficulty,
+		&q.Type,
+		&optionsJSON,
+		&q.Language,
+		&q.StarterCode,
+		&answerKeyJSON,
+		&q.Explanation,
+		&q.Technologies,
+		&q.CompanyTags,
+		&rev.CreatedAt,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	// Парсим JSON с вариантами ответов
 	if err := json.Unmarshal(optionsJSON, &q.Options); err != nil {
 		return nil, err
 	}
+	if answerKeyJSON != nil {
+		if err := json.Unmarshal(answerKeyJSON, &q.AnswerKey); err != nil {
+			return nil, err
+		}
+	}
+	q.ID = rev.QuestionID
+	q.Revision = rev.Revision
+	rev.Question = q
+
+	return rev, nil
+}
+
+func scanQuestionSubmission(row pgx.Row) (*models.QuestionSubmission, error) {
+	submission := &models.QuestionSubmission{}
+	q, err := scanQuestion(row,
+		&submission.ReviewerID,
+		&submission.ReviewComment,
+		&submission.ReviewedAt,
+		&submission.DuplicateOf,
+	)
+	if err != nil {
+		return nil, err
+	}
+	submission.Question = q
 
-	return &q, nil
+	return submission, nil
 }
@@ -0,0 +1,283 @@
+package repositories
+
+import (
+	"context"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ReviewRepository struct {
+	db *database.DB
+}
+
+func NewReviewRepository(db *database.DB) *ReviewRepository {
+	return &ReviewRepository{db: db}
+}
+
+// reviewColumns - общий список колонок для выборки отзыва вместе с именем автора
+const reviewColumns = `r.id, r.booking_id, r.mentor_id, r.author_id, COALESCE(u.name, u.username) AS author_name,
+              r.rating, r.text, r.mentor_reply, r.replied_at, r.status, r.created_at, r.updated_at`
+
+// CreateReview сохраняет отзыв и пересчитывает рейтинг ментора в одной транзакции
+func (r *ReviewRepository) CreateReview(ctx context.Context, review *models.Review) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = tx.QueryRow(ctx, `INSERT INTO reviews (booking_id, mentor_id, author_id, rating, text)
+              VALUES ($1, $2, $3, $4, $5)
+              RETURNING id, status, created_at, updated_at`,
+		review.BookingID, review.MentorID, review.AuthorID, review.Rating, review.Text,
+	).Scan(&review.ID, &review.Status, &review.CreatedAt, &review.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := refreshMentorRating(ctx, tx, review.MentorID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetReviewByID получает отзыв по ID
+func (r *ReviewRepository) GetReviewByID(ctx context.Context, id int) (*models.Review, error) {
+	query := `SELECT ` + reviewColumns + `
+              FROM reviews r
+              JOIN users u ON u.id = r.author_id
+              WHERE r.id = $1`
+
+	return scanReview(r.db.Pool.QueryRow(ctx, query, id))
+}
+
+// GetMentorReviews получает страницу опубликованных отзывов о менторе и их общее количество
+func (r *ReviewRepository) GetMentorReviews(ctx context.Context, mentorID, limit, offset int) ([]*models.Review, int, error) {
+	var total int
+	countQuery := `SELECT COUNT(*) FROM reviews WHERE mentor_id = $1 AND status = 'published'`
+	if err := r.db.Pool.QueryRow(ctx, countQuery, mentorID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + reviewColumns + `
+              FROM reviews r
+              JOIN users u ON u.id = r.author_id
+              WHERE r.mentor_id = $1 AND r.status = 'published'
+              ORDER BY r.created_at DESC, r.id DESC
+              LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var reviews []*models.Review
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return reviews, total, nil
+}
+
+// GetRatingTrend получает среднюю оценку опубликованных отзывов о менторе по периодам
+// period ('week' или 'month', в UTC), начиная с since. Периоды без отзывов не возвращаются.
+func (r *ReviewRepository) GetRatingTrend(ctx context.Context, mentorID int, period string, since time.Time) ([]models.RatingPoint, error) {
+	query := `SELECT date_trunc($2, created_at AT TIME ZONE 'UTC') AS period_start,
+                     AVG(rating)::float8, COUNT(*)
+              FROM reviews
+              WHERE mentor_id = $1 AND status = 'published' AND created_at >= $3
+              GROUP BY period_start
+              ORDER BY period_start`
+
+	rows, err := r.db.Pool.Query(ctx, query, mentorID, period, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []models.RatingPoint
+	for rows.Next() {
+		var p models.RatingPoint
+		if err := rows.Scan(&p.PeriodStart, &p.Average, &p.ReviewsCount); err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+
+	return points, rows.Err()
+}
+
+// SetReply сохраняет ответ ментора на отзыв
+func (r *ReviewRepository) SetReply(ctx context.Context, id int, reply string) error {
+	query := `UPDATE reviews
+              SET mentor_reply = $2, replied_at = now(), updated_at = now()
+              WHERE id = $1`
+
+	_, err := r.db.Pool.Exec(ctx, query, id, reply)
+	return err
+}
+
+// SetStatus меняет статус отзыва, пересчитывает рейтинг ментора и закрывает открытые жалобы
+// на отзыв с итогом reportStatus. Возвращает pgx.ErrNoRows, если отзыв не найден.
+func (r *ReviewRepository) SetStatus(ctx context.Context, id int, status, reportStatus string, moderatorID int) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var mentorID int
+	err = tx.QueryRow(ctx, `UPDATE reviews SET status = $2, updated_at = now()
+              WHERE id = $1
+              RETURNING mentor_id`, id, status).Scan(&mentorID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE review_reports
+              SET status = $2, resolved_by = $3, resolved_at = now()
+              WHERE review_id = $1 AND status = 'open'`, id, reportStatus, moderatorID)
+	if err != nil {
+		return err
+	}
+
+	if err := refreshMentorRating(ctx, tx, mentorID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// CreateReport сохраняет жалобу на отзыв
+func (r *ReviewRepository) CreateReport(ctx context.Context, report *models.ReviewReport) error {
+	query := `INSERT INTO review_reports (review_id, reporter_id, reason)
+              VALUES ($1, $2, $3)
+              RETURNING id, status, created_at`
+
+	return r.db.Pool.QueryRow(ctx, query, report.ReviewID, report.ReporterID, report.Reason).
+		Scan(&report.ID, &report.Status, &report.CreatedAt)
+}
+
+// GetReportsByStatus получает страницу жалоб с указанным статусом вместе с отзывами
+func (r *ReviewRepository) GetReportsByStatus(ctx context.Context, status string, limit, offset int) ([]*models.ReviewReport, int, error) {
+	var total int
+	countQuery := `SELECT COUNT(*) FROM review_reports WHERE status = $1`
+	if err := r.db.Pool.QueryRow(ctx, countQuery, status).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT rr.id, rr.review_id, rr.reporter_id, rr.reason, rr.status, rr.resolved_by,
+                     rr.resolved_at, rr.created_at, ` + reviewColumns + `
+              FROM review_reports rr
+              JOIN reviews r ON r.id = rr.review_id
+              JOIN users u ON u.id = r.author_id
+              WHERE rr.status = $1
+              ORDER BY rr.created_at, rr.id
+              LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Pool.Query(ctx, query, status, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var reports []*models.ReviewReport
+	for rows.Next() {
+		report := &models.ReviewReport{Review: &models.Review{}}
+		rv := report.Review
+		err := rows.Scan(
+			&report.ID,
+			&report.ReviewID,
+			&report.ReporterID,
+			&report.Reason,
+			&report.Status,
+			&report.ResolvedBy,
+			&report.ResolvedAt,
+			&report.CreatedAt,
+			&rv.ID,
+			&rv.BookingID,
+			&rv.MentorID,
+			&rv.AuthorID,
+			&rv.AuthorName,
+			&rv.Rating,
+			&rv.Text,
+			&rv.MentorReply,
+			&rv.RepliedAt,
+			&rv.Status,
+			&rv.CreatedAt,
+			&rv.UpdatedAt,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return reports, total, nil
+}
+
+// DismissReport отклоняет открытую жалобу. Возвращает pgx.ErrNoRows, если открытой жалобы нет.
+func (r *ReviewRepository) DismissReport(ctx context.Context, id, moderatorID int) error {
+	var reportID int
+	return r.db.Pool.QueryRow(ctx, `UPDATE review_reports
+              SET status = 'dismissed', resolved_by = $2, resolved_at = now()
+              WHERE id = $1 AND status = 'open'
+              RETURNING id`, id, moderatorID).Scan(&reportID)
+}
+
+// refreshMentorRating пересчитывает денормализованные рейтинг и количество отзывов ментора
+// по опубликованным отзывам. Строка ментора блокируется до подсчета: иначе параллельная транзакция
+// с другим отзывом посчитала бы агрегат по старому снимку и перезаписала результат.
+func refreshMentorRating(ctx context.Context, tx pgx.Tx, mentorID int) error {
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM mentors WHERE id = $1 FOR UPDATE`, mentorID); err != nil {
+		return err
+	}
+
+	_, err := tx.Exec(ctx, `UPDATE mentors m
+              SET rating = agg.rating, reviews_count = agg.reviews_count
+              FROM (
+                  SELECT ROUND(AVG(rating), 2) AS rating, COUNT(*) AS reviews_count
+                  FROM reviews
+                  WHERE mentor_id = $1 AND status = 'published'
+              ) agg
+              WHERE m.id = $1`, mentorID)
+	return err
+}
+
+// scanReview читает отзыв из строки результата, выбранной с колонками reviewColumns
+func scanReview(row pgx.Row) (*models.Review, error) {
+	review := &models.Review{}
+	err := row.Scan(
+		&review.ID,
+		&review.BookingID,
+		&review.MentorID,
+		&review.AuthorID,
+		&review.AuthorName,
+		&review.Rating,
+		&review.Text,
+		&review.MentorReply,
+		&review.RepliedAt,
+		&review.Status,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"context"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/data/database"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type SessionNoteRepository struct {
+	db *database.DB
+}
+
+func NewSessionNoteRepository(db *database.DB) *SessionNoteRepository {
+	return &SessionNoteRepository{db: db}
+}
+
+// sessionNoteColumns - общий список колонок для выборки заметки о занятии
+const sessionNoteColumns = `id, booking_id, author_id, visibility, summary, strengths, improvements,
+              next_steps, created_at, updated_at`
+
+// CreateNote сохраняет заметку и заполняет ее ID и даты
+func (r *SessionNoteRepository) CreateNote(ctx context.Context, n *models.SessionNote) error {
+	query := `INSERT INTO session_notes (booking_id, author_id, visibility, summary, strengths, improvements, next_steps)
+              VALUES ($1, $2, $3, $4, $5, $6, $7)
+              RETURNING id, created_at, updated_at`
+
+	return r.db.Pool.QueryRow(ctx, query,
+		n.BookingID, n.AuthorID, n.Visibility, n.Summary, n.Strengths, n.Improvements, n.NextSteps,
+	).Scan(&n.ID, &n.CreatedAt, &n.UpdatedAt)
+}
+
+// GetNoteByID получает заметку по ID
+func (r *SessionNoteRepository) GetNoteByID(ctx context.Context, id int) (*models.SessionNote, error) {
+	query := `SELECT ` + sessionNoteColumns + ` FROM session_notes WHERE id = $1`
+
+	return scanSessionNote(r.db.Pool.QueryRow(ctx, query, id))
+}
+
+// GetBookingNotes получает заметки о занятии, доступные пользователю:
+// общие и его собственные личные
+func (r *SessionNoteRepository) GetBookingNotes(ctx context.Context, bookingID, viewerID int) ([]*models.SessionNote, error) {
+	query := `SELECT ` + sessionNoteColumns + `
+              FROM session_notes
+              WHERE booking_id = $1 AND (visibility = 'shared' OR author_id = $2)
+              ORDER BY created_at, id`
+
+	rows, err := r.db.Pool.Query(ctx, query, bookingID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []*models.SessionNote
+	for rows.Next() {
+		n, err := scanSessionNote(rows)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, n)
+	}
+	return notes, rows.Err()
+}
+
+// UpdateNote сохраняет содержимое и видимость заметки и обновляет updated_at
+func (r *SessionNoteRepository) UpdateNote(ctx context.Context, n *models.SessionNote) error {
+	query := `UPDATE session_notes
+              SET visibility = $2, summary = $3, strengths = $4, improvements = $5, next_steps = $6, updated_at = now()
+              WHERE id = $1
+              RETURNING updated_at`
+
+	return r.db.Pool.QueryRow(ctx, query,
+		n.ID, n.Visibility, n.Summary, n.Strengths, n.Improvements, n.NextSteps,
+	).Scan(&n.UpdatedAt)
+}
+
+// DeleteNote удаляет заметку
+func (r *SessionNoteRepository) DeleteNote(ctx context.Context, id int) error {
+	_, err := r.db.Pool.Exec(ctx, `DELETE FROM session_notes WHERE id = $1`, id)
+	return err
+}
+
+// scanSessionNote читает заметку из строки результата, выбранной с колонками sessionNoteColumns
+func scanSessionNote(row pgx.Row) (*models.SessionNote, error) {
+	n := &models.SessionNote{}
+	err := row.Scan(
+		&n.ID,
+		&n.BookingID,
+		&n.AuthorID,
+		&n.Visibility,
+		&n.Summary,
+		&n.Strengths,
+		&n.Improvements,
+		&n.NextSteps,
+		&n.CreatedAt,
+		&n.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
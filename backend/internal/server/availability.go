@@ -0,0 +1,206 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// GetMyAvailability получает расписание текущего ментора
+// (GET /mentors/me/availability)
+func (s *ServerImplementation) GetMyAvailability(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	availability, err := s.availabilityService.GetMyAvailability(ctx.Request().Context(), userID)
+	if err != nil {
+		return availabilityError(ctx, err, "Failed to fetch availability", "AVAILABILITY_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIAvailability(availability))
+}
+
+// UpdateMyAvailability обновляет расписание текущего ментора
+// (PUT /mentors/me/availability)
+func (s *ServerImplementation) UpdateMyAvailability(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.AvailabilityUpdate
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	rules := make([]models.AvailabilityRule, 0, len(req.Rules))
+	for _, r := range req.Rules {
+		rules = append(rules, models.AvailabilityRule{
+			Weekday:   time.Weekday(r.Weekday),
+			StartTime: r.StartTime,
+			EndTime:   r.EndTime,
+		})
+	}
+
+	availability, err := s.availabilityService.UpdateMySchedule(ctx.Request().Context(), userID, req.Timezone, rules)
+	if err != nil {
+		return availabilityError(ctx, err, "Failed to update availability", "AVAILABILITY_UPDATE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIAvailability(availability))
+}
+
+// CreateAvailabilityException добавляет исключение в расписание текущего ментора
+// (POST /mentors/me/availability/exceptions)
+func (s *ServerImplementation) CreateAvailabilityException(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.AvailabilityExceptionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	exception := &models.AvailabilityException{
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		IsAvailable: req.IsAvailable != nil && *req.IsAvailable,
+		Reason:      req.Reason,
+	}
+
+	if err := s.availabilityService.AddException(ctx.Request().Context(), userID, exception); err != nil {
+		return availabilityError(ctx, err, "Failed to create availability exception", "AVAILABILITY_EXCEPTION_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPIAvailabilityException(*exception))
+}
+
+// DeleteAvailabilityException удаляет исключение из расписания текущего ментора
+// (DELETE /mentors/me/availability/exceptions/{id})
+func (s *ServerImplementation) DeleteAvailabilityException(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	if err := s.availabilityService.DeleteException(ctx.Request().Context(), userID, id); err != nil {
+		return availabilityError(ctx, err, "Failed to delete availability exception", "AVAILABILITY_EXCEPTION_ERROR")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListMentorSlots получает свободные слоты ментора
+// (GET /mentors/{id}/slots)
+func (s *ServerImplementation) ListMentorSlots(ctx echo.Context, id int, params openapi.ListMentorSlotsParams) error {
+	duration := 60 * time.Minute // по умолчанию
+	if params.DurationMinutes != nil {
+		duration = time.Duration(*params.DurationMinutes) * time.Minute
+	}
+
+	slots, timezone, err := s.availabilityService.GetSlots(ctx.Request().Context(), id, params.From, params.To, duration)
+	if err != nil {
+		return availabilityError(ctx, err, "Failed to fetch slots", "SLOTS_FETCH_ERROR")
+	}
+
+	items := make([]openapi.Slot, 0, len(slots))
+	for _, slot := range slots {
+		items = append(items, openapi.Slot{StartsAt: slot.StartsAt, EndsAt: slot.EndsAt})
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.SlotList{
+		Timezone: timezone,
+		Items:    items,
+	})
+}
+
+// availabilityError преобразует ошибку календаря в HTTP ответ
+func availabilityError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidSchedule), errors.Is(err, services.ErrInvalidSlotsRange):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_SCHEDULE"),
+		})
+	case errors.Is(err, services.ErrNotMentor):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: "User is not a mentor",
+			Code:    strPtr("NOT_MENTOR"),
+		})
+	case errors.Is(err, services.ErrMentorNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Mentor not found",
+			Code:    strPtr("MENTOR_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrExceptionNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Availability exception not found",
+			Code:    strPtr("AVAILABILITY_EXCEPTION_NOT_FOUND"),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: message,
+		Code:    strPtr(code),
+	})
+}
+
+// toOpenAPIAvailability преобразует расписание ментора в формат OpenAPI
+func toOpenAPIAvailability(a *models.Availability) openapi.Availability {
+	rules := make([]openapi.AvailabilityRule, 0, len(a.Rules))
+	for _, r := range a.Rules {
+		rules = append(rules, openapi.AvailabilityRule{
+			Weekday:   int(r.Weekday),
+			StartTime: r.StartTime,
+			EndTime:   r.EndTime,
+		})
+	}
+
+	exceptions := make([]openapi.AvailabilityException, 0, len(a.Exceptions))
+	for _, e := range a.Exceptions {
+		exceptions = append(exceptions, toOpenAPIAvailabilityException(e))
+	}
+
+	return openapi.Availability{
+		Timezone:   a.Timezone,
+		Rules:      rules,
+		Exceptions: exceptions,
+	}
+}
+
+// toOpenAPIAvailabilityException преобразует исключение из расписания в формат OpenAPI
+func toOpenAPIAvailabilityException(e models.AvailabilityException) openapi.AvailabilityException {
+	return openapi.AvailabilityException{
+		Id:          e.ID,
+		StartsAt:    e.StartsAt,
+		EndsAt:      e.EndsAt,
+		IsAvailable: e.IsAvailable,
+		Reason:      e.Reason,
+	}
+}
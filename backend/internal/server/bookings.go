@@ -0,0 +1,247 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// CreateBooking бронирует занятие с ментором
+// (POST /mentors/{id}/bookings)
+func (s *ServerImplementation) CreateBooking(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.BookingRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	duration := 60 * time.Minute // по умолчанию
+	if req.DurationMinutes != nil {
+		duration = time.Duration(*req.DurationMinutes) * time.Minute
+	}
+
+	booking, err := s.bookingService.Book(ctx.Request().Context(), userID, id, req.StartsAt, duration, req.Comment)
+	if err != nil {
+		return bookingError(ctx, err, "Failed to create booking", "BOOKING_CREATION_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPIBooking(booking))
+}
+
+// GetBookingById получает бронирование
+// (GET /bookings/{id})
+func (s *ServerImplementation) GetBookingById(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	booking, err := s.bookingService.Get(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return bookingError(ctx, err, "Failed to fetch booking", "BOOKING_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIBooking(booking))
+}
+
+// ListMyBookings получает бронирования текущего пользователя как ученика
+// (GET /users/me/bookings)
+func (s *ServerImplementation) ListMyBookings(ctx echo.Context, params openapi.ListMyBookingsParams) error {
+	return s.listBookings(ctx, (*string)(params.Status), params.Limit, params.Offset, s.bookingService.ListMenteeBookings)
+}
+
+// ListMentorBookings получает бронирования учеников текущего ментора
+// (GET /mentors/me/bookings)
+func (s *ServerImplementation) ListMentorBookings(ctx echo.Context, params openapi.ListMentorBookingsParams) error {
+	return s.listBookings(ctx, (*string)(params.Status), params.Limit, params.Offset, s.bookingService.ListMentorBookings)
+}
+
+// ConfirmBooking подтверждает занятие
+// (POST /bookings/{id}/confirm)
+func (s *ServerImplementation) ConfirmBooking(ctx echo.Context, id int) error {
+	return s.changeBookingStatus(ctx, id, s.bookingService.Confirm)
+}
+
+// CancelBooking отменяет занятие
+// (POST /bookings/{id}/cancel)
+func (s *ServerImplementation) CancelBooking(ctx echo.Context, id int) error {
+	// Тело запроса необязательно: при пустом теле Bind ничего не заполняет
+	var req openapi.BookingCancelRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	return s.changeBookingStatus(ctx, id, func(c context.Context, userID, id int) (*models.Booking, error) {
+		return s.bookingService.Cancel(c, userID, id, req.Reason)
+	})
+}
+
+// RescheduleBooking переносит занятие на новое время
+// (POST /bookings/{id}/reschedule)
+func (s *ServerImplementation) RescheduleBooking(ctx echo.Context, id int) error {
+	var req openapi.BookingRescheduleRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	return s.changeBookingStatus(ctx, id, func(c context.Context, userID, id int) (*models.Booking, error) {
+		return s.bookingService.Reschedule(c, userID, id, req.StartsAt)
+	})
+}
+
+// CompleteBooking отмечает занятие проведенным
+// (POST /bookings/{id}/complete)
+func (s *ServerImplementation) CompleteBooking(ctx echo.Context, id int) error {
+	return s.changeBookingStatus(ctx, id, s.bookingService.Complete)
+}
+
+// MarkBookingNoShow отмечает неявку ученика
+// (POST /bookings/{id}/no-show)
+func (s *ServerImplementation) MarkBookingNoShow(ctx echo.Context, id int) error {
+	return s.changeBookingStatus(ctx, id, s.bookingService.MarkNoShow)
+}
+
+// listBookings выполняет общую для ученика и ментора выборку бронирований
+func (s *ServerImplementation) listBookings(
+	ctx echo.Context,
+	status *string,
+	limitParam, offsetParam *int,
+	list func(ctx context.Context, userID int, status *string, limit, offset int) ([]*models.Booking, int, error),
+) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	limit := 20 // по умолчанию
+	if limitParam != nil && *limitParam > 0 {
+		limit = *limitParam
+	}
+
+	offset := 0
+	if offsetParam != nil {
+		offset = *offsetParam
+	}
+
+	bookings, total, err := list(ctx.Request().Context(), userID, status, limit, offset)
+	if err != nil {
+		return bookingError(ctx, err, "Failed to fetch bookings", "BOOKINGS_FETCH_ERROR")
+	}
+
+	items := make([]openapi.Booking, 0, len(bookings))
+	for _, b := range bookings {
+		items = append(items, toOpenAPIBooking(b))
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.BookingList{
+		Items: items,
+		Total: &total,
+	})
+}
+
+// changeBookingStatus выполняет общую для всех переходов смену статуса бронирования
+func (s *ServerImplementation) changeBookingStatus(
+	ctx echo.Context,
+	id int,
+	change func(ctx context.Context, userID, id int) (*models.Booking, error),
+) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	booking, err := change(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return bookingError(ctx, err, "Failed to update booking", "BOOKING_UPDATE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIBooking(booking))
+}
+
+// bookingError преобразует ошибку бронирования в HTTP ответ
+func bookingError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidBooking):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_BOOKING"),
+		})
+	case errors.Is(err, services.ErrMentorNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Mentor not found",
+			Code:    strPtr("MENTOR_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrBookingNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Booking not found",
+			Code:    strPtr("BOOKING_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrNotMentor), errors.Is(err, services.ErrBookingForbidden):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("FORBIDDEN"),
+		})
+	case errors.Is(err, services.ErrSlotUnavailable):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Slot is not available",
+			Code:    strPtr("SLOT_UNAVAILABLE"),
+		})
+	case errors.Is(err, services.ErrInvalidBookingTransition):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_BOOKING_TRANSITION"),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: message,
+		Code:    strPtr(code),
+	})
+}
+
+// toOpenAPIBooking преобразует бронирование в формат OpenAPI
+func toOpenAPIBooking(b *models.Booking) openapi.Booking {
+	return openapi.Booking{
+		Id:           b.ID,
+		MentorId:     b.MentorID,
+		MentorName:   b.MentorName,
+		MenteeId:     b.MenteeID,
+		MenteeName:   b.MenteeName,
+		StartsAt:     b.StartsAt,
+		EndsAt:       b.EndsAt,
+		Status:       openapi.BookingStatus(b.Status),
+		Comment:      b.Comment,
+		CancelReason: b.CancelReason,
+		CreatedAt:    b.CreatedAt,
+	}
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/services"
+)
+
+// mimeCalendar - тип содержимого файлов iCalendar
+const mimeCalendar = "text/calendar; charset=utf-8"
+
+// GetBookingCalendar выгружает занятие в формате iCalendar
+// (GET /bookings/{id}/calendar.ics)
+func (s *ServerImplementation) GetBookingCalendar(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	ics, err := s.calendarService.BookingICS(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return bookingError(ctx, err, "Failed to build calendar", "CALENDAR_BUILD_ERROR")
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="booking-%d.ics"`, id))
+	return ctx.Blob(http.StatusOK, mimeCalendar, ics)
+}
+
+// GetCalendarFeed отдает ленту занятий по приватному токену
+// (GET /calendar/feeds/{token})
+func (s *ServerImplementation) GetCalendarFeed(ctx echo.Context, token string) error {
+	ics, err := s.calendarService.Feed(ctx.Request().Context(), token)
+	if err != nil {
+		if errors.Is(err, services.ErrCalendarFeedNotFound) {
+			return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+				Message: "Calendar feed not found",
+				Code:    strPtr("CALENDAR_FEED_NOT_FOUND"),
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to build calendar feed",
+			Code:    strPtr("CALENDAR_BUILD_ERROR"),
+		})
+	}
+
+	// Календари сами опрашивают ленту, кешировать ее не нужно
+	ctx.Response().Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	return ctx.Blob(http.StatusOK, mimeCalendar, ics)
+}
+
+// CreateCalendarFeed выпускает новую ссылку на ленту календаря
+// (POST /users/me/calendar-feed)
+func (s *ServerImplementation) CreateCalendarFeed(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	token, createdAt, err := s.calendarService.RotateFeedToken(ctx.Request().Context(), userID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to create calendar feed",
+			Code:    strPtr("CALENDAR_FEED_ERROR"),
+		})
+	}
+
+	url := fmt.Sprintf("%s://%s/api/v1/calendar/feeds/%s", ctx.Scheme(), ctx.Request().Host, token)
+	return ctx.JSON(http.StatusCreated, openapi.CalendarFeed{
+		Url:       url,
+		CreatedAt: createdAt,
+	})
+}
+
+// DeleteCalendarFeed отключает ленту календаря
+// (DELETE /users/me/calendar-feed)
+func (s *ServerImplementation) DeleteCalendarFeed(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	if err := s.calendarService.RevokeFeedToken(ctx.Request().Context(), userID); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to delete calendar feed",
+			Code:    strPtr("CALENDAR_FEED_ERROR"),
+		})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// GetMentorDashboard получает сводку активности текущего ментора
+// (GET /mentors/me/dashboard)
+func (s *ServerImplementation) GetMentorDashboard(ctx echo.Context, params openapi.GetMentorDashboardParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	period := models.DashboardPeriodMonth // по умолчанию
+	if params.Period != nil {
+		period = string(*params.Period)
+	}
+
+	dashboard, err := s.dashboardService.MentorDashboard(ctx.Request().Context(), userID, period)
+	if err != nil {
+		return dashboardError(ctx, err, "Failed to fetch dashboard", "DASHBOARD_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIMentorDashboard(dashboard))
+}
+
+// ShareProgressWithMentor разрешает ментору видеть прогресс текущего пользователя
+// (PUT /mentors/{id}/progress-sharing)
+func (s *ServerImplementation) ShareProgressWithMentor(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	if err := s.dashboardService.ShareProgress(ctx.Request().Context(), userID, id); err != nil {
+		return dashboardError(ctx, err, "Failed to share progress", "PROGRESS_SHARING_ERROR")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// UnshareProgressWithMentor отзывает у ментора доступ к прогрессу текущего пользователя
+// (DELETE /mentors/{id}/progress-sharing)
+func (s *ServerImplementation) UnshareProgressWithMentor(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	if err := s.dashboardService.UnshareProgress(ctx.Request().Context(), userID, id); err != nil {
+		return dashboardError(ctx, err, "Failed to revoke progress sharing", "PROGRESS_SHARING_ERROR")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// dashboardError преобразует ошибку дашборда в HTTP ответ
+func dashboardError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidDashboardPeriod):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_PERIOD"),
+		})
+	case errors.Is(err, services.ErrInvalidProgressSharing):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_PROGRESS_SHARING"),
+		})
+	case errors.Is(err, services.ErrNotMentor):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: "User is not a mentor",
+			Code:    strPtr("NOT_MENTOR"),
+		})
+	case errors.Is(err, services.ErrMentorNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Mentor not found",
+			Code:    strPtr("MENTOR_NOT_FOUND"),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: message,
+		Code:    strPtr(code),
+	})
+}
+
+// toOpenAPIMentorDashboard преобразует дашборд ментора в формат OpenAPI
+func toOpenAPIMentorDashboard(d *models.MentorDashboard) openapi.MentorDashboard {
+	result := openapi.MentorDashboard{
+		Period: openapi.DashboardPeriod(d.Period),
+		Stats: openapi.SessionStats{
+			Total:          d.Stats.Total,
+			Upcoming:       d.Stats.Upcoming,
+			Completed:      d.Stats.Completed,
+			NoShow:         d.Stats.NoShow,
+			Cancelled:      d.Stats.Cancelled,
+			CompletionRate: d.Stats.CompletionRate,
+			NoShowRate:     d.Stats.NoShowRate,
+		},
+		Upcoming:     make([]openapi.Booking, 0, len(d.Upcoming)),
+		Recent:       make([]openapi.Booking, 0, len(d.Recent)),
+		Revenue:      make([]openapi.RevenuePoint, 0, len(d.Revenue)),
+		Rating:       d.Rating,
+		ReviewsCount: d.ReviewsCount,
+		RatingTrend:  make([]openapi.RatingPoint, 0, len(d.RatingTrend)),
+		Mentees:      make([]openapi.MenteeSummary, 0, len(d.Mentees)),
+	}
+	if d.Currency != nil {
+		currency := openapi.Currency(*d.Currency)
+		result.Currency = &currency
+	}
+
+	for _, b := range d.Upcoming {
+		result.Upcoming = append(result.Upcoming, toOpenAPIBooking(b))
+	}
+	for _, b := range d.Recent {
+		result.Recent = append(result.Recent, toOpenAPIBooking(b))
+	}
+	for _, p := range d.Revenue {
+		result.Revenue = append(result.Revenue, openapi.RevenuePoint{
+			PeriodStart: p.PeriodStart,
+			Sessions:    p.Sessions,
+			Revenue:     p.Revenue,
+		})
+	}
+	for _, p := range d.RatingTrend {
+		result.RatingTrend = append(result.RatingTrend, openapi.RatingPoint{
+			PeriodStart:  p.PeriodStart,
+			Average:      p.Average,
+			ReviewsCount: p.ReviewsCount,
+		})
+	}
+	for _, m := range d.Mentees {
+		result.Mentees = append(result.Mentees, toOpenAPIMenteeSummary(m))
+	}
+	return result
+}
+
+// toOpenAPIMenteeSummary преобразует ученика ментора в формат OpenAPI
+func toOpenAPIMenteeSummary(m *models.MenteeSummary) openapi.MenteeSummary {
+	mentee := openapi.MenteeSummary{
+		UserId:            m.UserID,
+		Name:              m.Name,
+		AvatarUrl:         m.AvatarURL,
+		CompletedSessions: m.CompletedSessions,
+		LastSessionAt:     m.LastSessionAt,
+		NextSessionAt:     m.NextSessionAt,
+		ProgressShared:    m.ProgressShared,
+	}
+	if !m.ProgressShared {
+		return mentee
+	}
+
+	courses := make([]openapi.CourseProgress, 0, len(m.Courses))
+	for _, c := range m.Courses {
+		courses = append(courses, openapi.CourseProgress{
+			CourseId:         c.CourseID,
+			Title:            c.Title,
+			CompletedModules: c.CompletedModules,
+			TotalModules:     c.TotalModules,
+			ProgressPct:      c.ProgressPct,
+		})
+	}
+	mentee.Courses = &courses
+	if m.Answers != nil {
+		mentee.Answers = &openapi.AnswerStats{
+			Answered: m.Answers.Answered,
+			Correct:  m.Answers.Correct,
+		}
+	}
+	return mentee
+}
@@ -0,0 +1,407 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// ListEvents получает предстоящие события
+// (GET /events)
+func (s *ServerImplementation) ListEvents(ctx echo.Context, params openapi.ListEventsParams) error {
+	// Для анонимного пользователя статус регистрации не заполняется
+	viewerID, _ := GetUserID(ctx)
+
+	filter := models.EventFilter{
+		MentorID: params.MentorId,
+		From:     time.Now(),
+	}
+	if params.Type != nil {
+		eventType := string(*params.Type)
+		filter.Type = &eventType
+	}
+	if params.From != nil {
+		filter.From = *params.From
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	events, total, err := s.eventService.ListUpcoming(ctx.Request().Context(), viewerID, filter, limit, offset)
+	if err != nil {
+		return eventError(ctx, err, "Failed to fetch events", "EVENTS_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.EventList{
+		Items: toOpenAPIEvents(events),
+		Total: &total,
+	})
+}
+
+// GetEventById получает событие по ID
+// (GET /events/{id})
+func (s *ServerImplementation) GetEventById(ctx echo.Context, id int) error {
+	viewerID, _ := GetUserID(ctx)
+
+	event, err := s.eventService.Get(ctx.Request().Context(), viewerID, id)
+	if err != nil {
+		return eventError(ctx, err, "Failed to fetch event", "EVENT_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIEvent(event))
+}
+
+// CreateEvent создает событие текущего ментора
+// (POST /mentors/me/events)
+func (s *ServerImplementation) CreateEvent(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.EventRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	event := &models.Event{
+		Type:        string(req.Type),
+		Title:       req.Title,
+		Description: req.Description,
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		Capacity:    req.Capacity,
+	}
+
+	created, err := s.eventService.Create(ctx.Request().Context(), userID, event)
+	if err != nil {
+		return eventError(ctx, err, "Failed to create event", "EVENT_CREATION_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPIEvent(created))
+}
+
+// UpdateEvent изменяет событие ведущим
+// (PUT /events/{id})
+func (s *ServerImplementation) UpdateEvent(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.EventUpdate
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	input := &models.Event{
+		Title:       req.Title,
+		Description: req.Description,
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		Capacity:    req.Capacity,
+	}
+
+	updated, err := s.eventService.Update(ctx.Request().Context(), userID, id, input)
+	if err != nil {
+		return eventError(ctx, err, "Failed to update event", "EVENT_UPDATE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIEvent(updated))
+}
+
+// CancelEvent отменяет событие ведущим
+// (POST /events/{id}/cancel)
+func (s *ServerImplementation) CancelEvent(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	cancelled, err := s.eventService.Cancel(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return eventError(ctx, err, "Failed to cancel event", "EVENT_CANCEL_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIEvent(cancelled))
+}
+
+// RegisterForEvent регистрирует текущего пользователя на событие
+// (POST /events/{id}/registration)
+func (s *ServerImplementation) RegisterForEvent(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	event, err := s.eventService.Register(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return eventError(ctx, err, "Failed to register for event", "EVENT_REGISTRATION_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIEvent(event))
+}
+
+// CancelEventRegistration отменяет регистрацию текущего пользователя на событие
+// (DELETE /events/{id}/registration)
+func (s *ServerImplementation) CancelEventRegistration(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	if err := s.eventService.CancelRegistration(ctx.Request().Context(), userID, id); err != nil {
+		return eventError(ctx, err, "Failed to cancel registration", "EVENT_REGISTRATION_ERROR")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListEventParticipants получает участников и лист ожидания события
+// (GET /events/{id}/participants)
+func (s *ServerImplementation) ListEventParticipants(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	registrations, err := s.eventService.ListParticipants(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return eventError(ctx, err, "Failed to fetch participants", "EVENT_PARTICIPANTS_FETCH_ERROR")
+	}
+
+	items := make([]openapi.EventParticipant, 0, len(registrations))
+	for _, reg := range registrations {
+		items = append(items, toOpenAPIEventParticipant(reg))
+	}
+	return ctx.JSON(http.StatusOK, openapi.EventParticipantList{Items: items})
+}
+
+// MarkEventAttendance отмечает посещение события участником
+// (PUT /events/{id}/participants/{userId}/attendance)
+func (s *ServerImplementation) MarkEventAttendance(ctx echo.Context, id int, participantID int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.EventAttendance
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	reg, err := s.eventService.MarkAttendance(ctx.Request().Context(), userID, id, participantID, req.Attended)
+	if err != nil {
+		return eventError(ctx, err, "Failed to mark attendance", "EVENT_ATTENDANCE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIEventParticipant(reg))
+}
+
+// ListMyHostedEvents получает события текущего ментора
+// (GET /mentors/me/events)
+func (s *ServerImplementation) ListMyHostedEvents(ctx echo.Context, params openapi.ListMyHostedEventsParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	events, total, err := s.eventService.ListHosted(ctx.Request().Context(), userID, limit, offset)
+	if err != nil {
+		return eventError(ctx, err, "Failed to fetch events", "EVENTS_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.EventList{
+		Items: toOpenAPIEvents(events),
+		Total: &total,
+	})
+}
+
+// ListMyEvents получает события, на которые зарегистрирован текущий пользователь
+// (GET /users/me/event-registrations)
+func (s *ServerImplementation) ListMyEvents(ctx echo.Context, params openapi.ListMyEventsParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	events, total, err := s.eventService.ListUserEvents(ctx.Request().Context(), userID, limit, offset)
+	if err != nil {
+		return eventError(ctx, err, "Failed to fetch events", "EVENTS_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.EventList{
+		Items: toOpenAPIEvents(events),
+		Total: &total,
+	})
+}
+
+// eventError преобразует ошибку события в HTTP ответ
+func eventError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidEvent):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_EVENT"),
+		})
+	case errors.Is(err, services.ErrNotMentor):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: "User is not a mentor",
+			Code:    strPtr("NOT_MENTOR"),
+		})
+	case errors.Is(err, services.ErrNotEventHost):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: "Only the event host can do this",
+			Code:    strPtr("NOT_EVENT_HOST"),
+		})
+	case errors.Is(err, services.ErrEventNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Event not found",
+			Code:    strPtr("EVENT_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrNotRegistered):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Registration not found",
+			Code:    strPtr("NOT_REGISTERED"),
+		})
+	case errors.Is(err, services.ErrEventClosed):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Event is cancelled or has already started",
+			Code:    strPtr("EVENT_CLOSED"),
+		})
+	case errors.Is(err, services.ErrAlreadyRegistered):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Already registered for this event",
+			Code:    strPtr("ALREADY_REGISTERED"),
+		})
+	case errors.Is(err, services.ErrOwnEvent):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Cannot register for your own event",
+			Code:    strPtr("OWN_EVENT"),
+		})
+	case errors.Is(err, services.ErrEventNotStarted):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Event has not started yet",
+			Code:    strPtr("EVENT_NOT_STARTED"),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: message,
+		Code:    strPtr(code),
+	})
+}
+
+// toOpenAPIEvents преобразует список событий в формат OpenAPI
+func toOpenAPIEvents(events []*models.Event) []openapi.Event {
+	items := make([]openapi.Event, 0, len(events))
+	for _, e := range events {
+		items = append(items, toOpenAPIEvent(e))
+	}
+	return items
+}
+
+// toOpenAPIEvent преобразует событие в формат OpenAPI
+func toOpenAPIEvent(e *models.Event) openapi.Event {
+	event := openapi.Event{
+		Id:               e.ID,
+		MentorId:         e.MentorID,
+		HostName:         e.HostName,
+		Type:             openapi.EventType(e.Type),
+		Title:            e.Title,
+		Description:      e.Description,
+		StartsAt:         e.StartsAt,
+		EndsAt:           e.EndsAt,
+		Capacity:         e.Capacity,
+		RegisteredCount:  e.RegisteredCount,
+		WaitlistCount:    e.WaitlistCount,
+		SpotsLeft:        e.SpotsLeft(),
+		Status:           openapi.EventStatus(e.Status),
+		WaitlistPosition: e.WaitlistPosition,
+		CreatedAt:        e.CreatedAt,
+	}
+	if e.ViewerStatus != nil {
+		status := openapi.EventRegistrationStatus(*e.ViewerStatus)
+		event.MyStatus = &status
+	}
+	return event
+}
+
+// toOpenAPIEventParticipant преобразует регистрацию на событие в формат OpenAPI
+func toOpenAPIEventParticipant(reg *models.EventRegistration) openapi.EventParticipant {
+	return openapi.EventParticipant{
+		UserId:       reg.UserID,
+		Name:         reg.UserName,
+		AvatarUrl:    reg.AvatarURL,
+		Status:       openapi.EventRegistrationStatus(reg.Status),
+		Attended:     reg.Attended,
+		RegisteredAt: reg.QueuedAt,
+	}
+}
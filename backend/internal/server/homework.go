@@ -0,0 +1,375 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// ListSessionNotes получает заметки о занятии, доступные текущему пользователю
+// (GET /bookings/{id}/notes)
+func (s *ServerImplementation) ListSessionNotes(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	notes, err := s.sessionNoteService.List(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return homeworkError(ctx, err, "Failed to fetch session notes", "SESSION_NOTES_FETCH_ERROR")
+	}
+
+	items := make([]openapi.SessionNote, 0, len(notes))
+	for _, n := range notes {
+		items = append(items, toOpenAPISessionNote(n))
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.SessionNoteList{Items: items})
+}
+
+// CreateSessionNote сохраняет заметку ментора о занятии
+// (POST /bookings/{id}/notes)
+func (s *ServerImplementation) CreateSessionNote(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.SessionNoteRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	note, err := s.sessionNoteService.Create(ctx.Request().Context(), userID, id, toSessionNoteInput(req))
+	if err != nil {
+		return homeworkError(ctx, err, "Failed to create session note", "SESSION_NOTE_CREATE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPISessionNote(note))
+}
+
+// UpdateSessionNote изменяет заметку о занятии
+// (PUT /session-notes/{id})
+func (s *ServerImplementation) UpdateSessionNote(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.SessionNoteRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	note, err := s.sessionNoteService.Update(ctx.Request().Context(), userID, id, toSessionNoteInput(req))
+	if err != nil {
+		return homeworkError(ctx, err, "Failed to update session note", "SESSION_NOTE_UPDATE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPISessionNote(note))
+}
+
+// DeleteSessionNote удаляет заметку о занятии
+// (DELETE /session-notes/{id})
+func (s *ServerImplementation) DeleteSessionNote(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	if err := s.sessionNoteService.Delete(ctx.Request().Context(), userID, id); err != nil {
+		return homeworkError(ctx, err, "Failed to delete session note", "SESSION_NOTE_DELETE_ERROR")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListBookingHomework получает домашние задания по занятию
+// (GET /bookings/{id}/homework)
+func (s *ServerImplementation) ListBookingHomework(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	assignments, err := s.homeworkService.ListBookingHomework(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return homeworkError(ctx, err, "Failed to fetch homework", "HOMEWORK_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIHomeworkList(assignments, nil))
+}
+
+// AssignHomework выдает ученику домашнее задание по занятию
+// (POST /bookings/{id}/homework)
+func (s *ServerImplementation) AssignHomework(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.HomeworkRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	input := services.HomeworkInput{
+		Title:   req.Title,
+		Comment: req.Comment,
+		DueAt:   req.DueAt,
+	}
+	if req.QuestionIds != nil {
+		input.QuestionIDs = *req.QuestionIds
+	}
+	if req.ModuleIds != nil {
+		input.ModuleIDs = *req.ModuleIds
+	}
+
+	assignment, err := s.homeworkService.Assign(ctx.Request().Context(), userID, id, input)
+	if err != nil {
+		return homeworkError(ctx, err, "Failed to assign homework", "HOMEWORK_CREATE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPIHomework(assignment))
+}
+
+// GetHomework получает домашнее задание с прогрессом выполнения
+// (GET /homework/{id})
+func (s *ServerImplementation) GetHomework(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	assignment, err := s.homeworkService.Get(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return homeworkError(ctx, err, "Failed to fetch homework", "HOMEWORK_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIHomework(assignment))
+}
+
+// DeleteHomework удаляет домашнее задание
+// (DELETE /homework/{id})
+func (s *ServerImplementation) DeleteHomework(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	if err := s.homeworkService.Delete(ctx.Request().Context(), userID, id); err != nil {
+		return homeworkError(ctx, err, "Failed to delete homework", "HOMEWORK_DELETE_ERROR")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListMyHomework получает домашние задания текущего пользователя как ученика
+// (GET /users/me/homework)
+func (s *ServerImplementation) ListMyHomework(ctx echo.Context, params openapi.ListMyHomeworkParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	assignments, total, err := s.homeworkService.ListMenteeHomework(ctx.Request().Context(), userID, limit, offset)
+	if err != nil {
+		return homeworkError(ctx, err, "Failed to fetch homework", "HOMEWORK_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIHomeworkList(assignments, &total))
+}
+
+// ListMentorHomework получает домашние задания, выданные текущим ментором
+// (GET /mentors/me/homework)
+func (s *ServerImplementation) ListMentorHomework(ctx echo.Context, params openapi.ListMentorHomeworkParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	assignments, total, err := s.homeworkService.ListMentorHomework(ctx.Request().Context(), userID, limit, offset)
+	if err != nil {
+		return homeworkError(ctx, err, "Failed to fetch homework", "HOMEWORK_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIHomeworkList(assignments, &total))
+}
+
+// homeworkError преобразует ошибку заметок и домашних заданий в HTTP ответ
+func homeworkError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidNote), errors.Is(err, services.ErrInvalidHomework):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	case errors.Is(err, services.ErrNoteForbidden), errors.Is(err, services.ErrHomeworkForbidden):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("FORBIDDEN"),
+		})
+	case errors.Is(err, services.ErrNotMentor):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: "User is not a mentor",
+			Code:    strPtr("NOT_MENTOR"),
+		})
+	case errors.Is(err, services.ErrBookingNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Booking not found",
+			Code:    strPtr("BOOKING_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrNoteNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Session note not found",
+			Code:    strPtr("SESSION_NOTE_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrHomeworkNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Homework not found",
+			Code:    strPtr("HOMEWORK_NOT_FOUND"),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: message,
+		Code:    strPtr(code),
+	})
+}
+
+// toSessionNoteInput преобразует запрос заметки в формат сервиса
+func toSessionNoteInput(req openapi.SessionNoteRequest) services.SessionNoteInput {
+	input := services.SessionNoteInput{
+		Summary:      req.Summary,
+		Strengths:    req.Strengths,
+		Improvements: req.Improvements,
+		NextSteps:    req.NextSteps,
+	}
+	if req.Visibility != nil {
+		input.Visibility = string(*req.Visibility)
+	}
+	return input
+}
+
+// toOpenAPISessionNote преобразует заметку о занятии в формат OpenAPI
+func toOpenAPISessionNote(n *models.SessionNote) openapi.SessionNote {
+	return openapi.SessionNote{
+		Id:           n.ID,
+		BookingId:    n.BookingID,
+		AuthorId:     n.AuthorID,
+		Visibility:   openapi.NoteVisibility(n.Visibility),
+		Summary:      n.Summary,
+		Strengths:    n.Strengths,
+		Improvements: n.Improvements,
+		NextSteps:    n.NextSteps,
+		CreatedAt:    n.CreatedAt,
+		UpdatedAt:    n.UpdatedAt,
+	}
+}
+
+// toOpenAPIHomeworkList преобразует список заданий в формат OpenAPI
+func toOpenAPIHomeworkList(assignments []*models.HomeworkAssignment, total *int) openapi.HomeworkList {
+	items := make([]openapi.Homework, 0, len(assignments))
+	for _, a := range assignments {
+		items = append(items, toOpenAPIHomework(a))
+	}
+	return openapi.HomeworkList{
+		Items: items,
+		Total: total,
+	}
+}
+
+// toOpenAPIHomework преобразует домашнее задание в формат OpenAPI
+func toOpenAPIHomework(a *models.HomeworkAssignment) openapi.Homework {
+	items := make([]openapi.HomeworkItem, 0, len(a.Items))
+	for i := range a.Items {
+		item := &a.Items[i]
+		items = append(items, openapi.HomeworkItem{
+			Id:                 item.ID,
+			Type:               openapi.HomeworkItemType(item.Type()),
+			QuestionId:         item.QuestionID,
+			ModuleId:           item.ModuleID,
+			Title:              item.Title,
+			TotalQuestions:     item.TotalQuestions,
+			CompletedQuestions: item.CompletedQuestions,
+			Completed:          item.Completed(),
+		})
+	}
+
+	return openapi.Homework{
+		Id:             a.ID,
+		BookingId:      a.BookingID,
+		MentorId:       a.MentorID,
+		MentorName:     a.MentorName,
+		MenteeId:       a.MenteeID,
+		MenteeName:     a.MenteeName,
+		Title:          a.Title,
+		Comment:        a.Comment,
+		DueAt:          a.DueAt,
+		CreatedAt:      a.CreatedAt,
+		Items:          items,
+		CompletedItems: a.CompletedItems(),
+	}
+}
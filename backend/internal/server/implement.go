@@ -15,18 +15,53 @@ import (
 
 // ServerImplementation реализует интерфейс openapi.ServerInterface
 type ServerImplementation struct {
-	authService  *services.AuthService
-	repo         *repositories.UserRepository
-	sessionRepo  *repositories.SessionRepository
-	questionRepo *repositories.QuestionRepository
+	authService *services.AuthService
+	repo        *repositories.UserRepository
+	sessionRepo *repositories.SessionRepository
+
+	notificationService *services.NotificationService
+	mentorService       *services.MentorService
+
+	mentorApplicationService *services.MentorApplicationService
+	availabilityService      *services.AvailabilityService
+	bookingService           *services.BookingService
+	calendarService          *services.CalendarService
+	reviewService            *services.ReviewService
+	messageService           *services.MessageService
+	recommendationService    *services.RecommendationService
+	dashboardService         *services.DashboardService
+	sessionNoteService       *services.SessionNoteService
+	homeworkService          *services.HomeworkService
+	paymentService           *services.PaymentService
+	verificationService      *services.MentorVerificationService
+	eventService             *services.EventService
+	questionService          *services.QuestionService
+	submissionService        *services.QuestionSubmissionService
 }
 
-func NewServerImplementation(authService *services.AuthService, repo *repositories.UserRepository, sessionRepo *repositories.SessionRepository, questionRepo *repositories.QuestionRepository) *ServerImplementation {
+func NewServerImplementation(authService *services.AuthService, repo *repositories.UserRepository, sessionRepo *repositories.SessionRepository, notificationService *services.NotificationService, mentorService *services.MentorService, mentorApplicationService *services.MentorApplicationService, availabilityService *services.AvailabilityService, bookingService *services.BookingService, calendarService *services.CalendarService, reviewService *services.ReviewService, messageService *services.MessageService, recommendationService *services.RecommendationService, dashboardService *services.DashboardService, sessionNoteService *services.SessionNoteService, homeworkService *services.HomeworkService, paymentService *services.PaymentService, verificationService *services.MentorVerificationService, eventService *services.EventService, questionService *services.QuestionService, submissionService *services.QuestionSubmissionService) *ServerImplementation {
 	return &ServerImplementation{
-		authService:  authService,
-		repo:         repo,
-		sessionRepo:  sessionRepo,
-		questionRepo: questionRepo,
+		authService:         authService,
+		repo:                repo,
+		sessionRepo:         sessionRepo,
+		notificationService: notificationService,
+		mentorService:       mentorService,
+
+		mentorApplicationService: mentorApplicationService,
+		availabilityService:      availabilityService,
+		bookingService:           bookingService,
+		calendarService:          calendarService,
+		reviewService:            reviewService,
+		messageService:           messageService,
+		recommendationService:    recommendationService,
+		dashboardService:         dashboardService,
+		sessionNoteService:       sessionNoteService,
+		homeworkService:          homeworkService,
+		paymentService:           paymentService,
+		verificationService:      verificationService,
+		eventService:             eventService,
+		questionService:          questionService,
+		submissionService:        submissionService,
 	}
 }
 
@@ -244,42 +279,6 @@ func (s *ServerImplementation) GetCurrentUser(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, profile)
 }
 
-// ListMentors получает список менторов
-// (GET /mentors)
-func (s *ServerImplementation) ListMentors(ctx echo.Context, params openapi.ListMentorsParams) error {
-	// TODO: Реализовать логику получения списка менторов
-	mentorList := openapi.MentorList{
-		Items: []openapi.MentorCard{
-			{
-				Id:       1,
-				FullName: "Alice Smith",
-				Title:    "Senior Go Developer",
-				Skills:   []string{"Go", "Docker", "Kubernetes"},
-				YearsOfExperience: func() *int {
-					i := 5
-					return &i
-				}(),
-			},
-			{
-				Id:       2,
-				FullName: "Bob Johnson",
-				Title:    "Backend Engineer",
-				Skills:   []string{"Go", "PostgreSQL", "gRPC"},
-				YearsOfExperience: func() *int {
-					i := 7
-					return &i
-				}(),
-			},
-		},
-		Total: func() *int {
-			i := 2
-			return &i
-		}(),
-	}
-
-	return ctx.JSON(http.StatusOK, mentorList)
-}
-
 // Logout отзывает текущую сессию пользователя
 // (POST /auth/logout)
 func (s *ServerImplementation) Logout(ctx echo.Context) error {
@@ -305,74 +304,6 @@ func (s *ServerImplementation) Logout(ctx echo.Context) error {
 	})
 }
 
-// ListQuestions получает список всех вопросов
-// (GET /questions)
-func (s *ServerImplementation) ListQuestions(ctx echo.Context, params openapi.ListQuestionsParams) error {
-	// Определяем лимит и оффсет
-	limit := 30 // по умолчанию
-	if params.Limit != nil {
-		limit = *params.Limit
-	}
-
-	offset := 0
-	if params.Offset != nil {
-		offset = *params.Offset
-	}
-
-	// Получаем вопросы из БД
-	questions, total, err := s.questionRepo.GetAllQuestions(ctx.Request().Context(), params.Technology, limit, offset)
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
-			Message: "Failed to fetch questions",
-			Code:    strPtr("QUESTIONS_FETCH_ERROR"),
-		})
-	}
-
-	// Преобразуем в формат OpenAPI
-	items := make([]openapi.QuestionListItem, 0, len(questions))
-	for _, q := range questions {
-		items = append(items, openapi.QuestionListItem{
-			Id:         q.ID,
-			Title:      q.Title,
-			Technology: q.Technology,
-		})
-	}
-
-	questionList := openapi.QuestionList{
-		Items: items,
-		Total: &total,
-	}
-
-	return ctx.JSON(http.StatusOK, questionList)
-}
-
-// GetQuestionById получает полную информацию о вопросе по ID
-// (GET /questions/{id})
-func (s *ServerImplementation) GetQuestionById(ctx echo.Context, id int) error {
-	// Получаем вопрос из БД
-	question, err := s.questionRepo.GetQuestionByID(ctx.Request().Context(), id)
-	if err != nil {
-		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
-			Message: "Question not found",
-			Code:    strPtr("QUESTION_NOT_FOUND"),
-		})
-	}
-
-	// Преобразуем в формат OpenAPI
-	questionDetail := openapi.QuestionDetail{
-		Id:            question.ID,
-		Title:         question.Title,
-		Content:       question.Content,
-		Difficulty:    openapi.QuestionDetailDifficulty(question.Difficulty),
-		Technology:    question.Technology,
-		Options:       question.Options,
-		CorrectAnswer: question.CorrectAnswer,
-		Explanation:   &question.Explanation,
-	}
-
-	return ctx.JSON(http.StatusOK, questionDetail)
-}
-
 // strPtr возвращает указатель на строку
 func strPtr(s string) *string {
 	return &s
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// SubmitMentorApplication подает заявку на менторство
+// (POST /mentors/applications)
+func (s *ServerImplementation) SubmitMentorApplication(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.MentorApplicationRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	app := &models.MentorApplication{
+		UserID:          userID,
+		Specialization:  req.Specialization,
+		Grade:           req.Grade,
+		ExperienceYears: req.YearsOfExperience,
+		Description:     req.Description,
+	}
+	if req.Tags != nil {
+		app.Tags = *req.Tags
+	}
+	if req.Contacts != nil {
+		app.Contacts = fromOpenAPIContacts(*req.Contacts)
+	}
+	if req.Pricelist != nil {
+		app.Pricelist = fromOpenAPIPricelist(*req.Pricelist)
+	}
+
+	created, err := s.mentorApplicationService.Submit(ctx.Request().Context(), app)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidApplication):
+			return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+				Message: err.Error(),
+				Code:    strPtr("INVALID_APPLICATION"),
+			})
+		case errors.Is(err, services.ErrAlreadyMentor):
+			return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+				Message: "User is already a mentor",
+				Code:    strPtr("ALREADY_MENTOR"),
+			})
+		case errors.Is(err, services.ErrApplicationPending):
+			return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+				Message: "Mentor application is already pending",
+				Code:    strPtr("APPLICATION_ALREADY_PENDING"),
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to submit mentor application",
+			Code:    strPtr("APPLICATION_CREATION_ERROR"),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPIMentorApplication(created))
+}
+
+// ListMyMentorApplications получает заявки текущего пользователя
+// (GET /mentors/applications/me)
+func (s *ServerImplementation) ListMyMentorApplications(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	apps, err := s.mentorApplicationService.ListUserApplications(ctx.Request().Context(), userID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch mentor applications",
+			Code:    strPtr("APPLICATIONS_FETCH_ERROR"),
+		})
+	}
+
+	total := len(apps)
+	return ctx.JSON(http.StatusOK, openapi.MentorApplicationList{
+		Items: toOpenAPIMentorApplications(apps),
+		Total: &total,
+	})
+}
+
+// ListMentorApplications получает очередь модерации заявок на менторство
+// (GET /moderation/mentor-applications)
+func (s *ServerImplementation) ListMentorApplications(ctx echo.Context, params openapi.ListMentorApplicationsParams) error {
+	status := models.MentorApplicationPending
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	apps, total, err := s.mentorApplicationService.ListQueue(ctx.Request().Context(), status, limit, offset)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch mentor applications",
+			Code:    strPtr("APPLICATIONS_FETCH_ERROR"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.MentorApplicationList{
+		Items: toOpenAPIMentorApplications(apps),
+		Total: &total,
+	})
+}
+
+// ApproveMentorApplication одобряет заявку на менторство
+// (POST /moderation/mentor-applications/{id}/approve)
+func (s *ServerImplementation) ApproveMentorApplication(ctx echo.Context, id int) error {
+	return s.reviewMentorApplication(ctx, id, s.mentorApplicationService.Approve)
+}
+
+// RejectMentorApplication отклоняет заявку на менторство
+// (POST /moderation/mentor-applications/{id}/reject)
+func (s *ServerImplementation) RejectMentorApplication(ctx echo.Context, id int) error {
+	return s.reviewMentorApplication(ctx, id, s.mentorApplicationService.Reject)
+}
+
+// reviewMentorApplication выполняет общее для одобрения и отклонения рассмотрение заявки
+func (s *ServerImplementation) reviewMentorApplication(
+	ctx echo.Context,
+	id int,
+	review func(ctx context.Context, id, reviewerID int, comment *string) (*models.MentorApplication, error),
+) error {
+	reviewerID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.MentorApplicationReview
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	app, err := review(ctx.Request().Context(), id, reviewerID, req.Comment)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrApplicationNotFound):
+			return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+				Message: "Mentor application not found",
+				Code:    strPtr("APPLICATION_NOT_FOUND"),
+			})
+		case errors.Is(err, services.ErrApplicationReviewed):
+			return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+				Message: "Mentor application is already reviewed",
+				Code:    strPtr("APPLICATION_ALREADY_REVIEWED"),
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to review mentor application",
+			Code:    strPtr("APPLICATION_REVIEW_ERROR"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIMentorApplication(app))
+}
+
+// toOpenAPIMentorApplications преобразует список заявок в формат OpenAPI
+func toOpenAPIMentorApplications(apps []*models.MentorApplication) []openapi.MentorApplication {
+	items := make([]openapi.MentorApplication, 0, len(apps))
+	for _, app := range apps {
+		items = append(items, toOpenAPIMentorApplication(app))
+	}
+	return items
+}
+
+// toOpenAPIMentorApplication преобразует заявку в формат OpenAPI
+func toOpenAPIMentorApplication(app *models.MentorApplication) openapi.MentorApplication {
+	tags := app.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	item := openapi.MentorApplication{
+		Id:                app.ID,
+		UserId:            app.UserID,
+		ApplicantName:     app.ApplicantName,
+		Specialization:    app.Specialization,
+		Grade:             app.Grade,
+		YearsOfExperience: app.ExperienceYears,
+		Description:       app.Description,
+		Tags:              tags,
+		Status:            openapi.MentorApplicationStatus(app.Status),
+		ReviewComment:     app.ReviewComment,
+		ReviewedAt:        app.ReviewedAt,
+		CreatedAt:         app.CreatedAt,
+	}
+	if app.Contacts != nil {
+		contacts := toOpenAPIContacts(app.Contacts)
+		item.Contacts = &contacts
+	}
+	if app.Pricelist != nil {
+		pricelist := toOpenAPIPricelist(app.Pricelist)
+		item.Pricelist = &pricelist
+	}
+
+	return item
+}
@@ -0,0 +1,282 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// SubmitVerification отправляет подтверждение опыта текущего ментора на проверку
+// (POST /mentors/me/verifications)
+func (s *ServerImplementation) SubmitVerification(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.MentorVerificationRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	v := &models.MentorVerification{
+		Type:        string(req.Type),
+		Title:       req.Title,
+		EvidenceURL: req.EvidenceUrl,
+		Details:     req.Details,
+	}
+
+	created, err := s.verificationService.Submit(ctx.Request().Context(), userID, v)
+	if err != nil {
+		return verificationError(ctx, err, "Failed to submit verification", "VERIFICATION_CREATION_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPIMentorVerification(created))
+}
+
+// ListMyVerifications получает подтверждения опыта текущего ментора
+// (GET /mentors/me/verifications)
+func (s *ServerImplementation) ListMyVerifications(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	items, err := s.verificationService.ListOwn(ctx.Request().Context(), userID)
+	if err != nil {
+		return verificationError(ctx, err, "Failed to fetch verifications", "VERIFICATIONS_FETCH_ERROR")
+	}
+
+	total := len(items)
+	return ctx.JSON(http.StatusOK, openapi.MentorVerificationList{
+		Items: toOpenAPIMentorVerifications(items),
+		Total: &total,
+	})
+}
+
+// WithdrawVerification удаляет подтверждение текущего ментора до проверки
+// (DELETE /mentors/me/verifications/{id})
+func (s *ServerImplementation) WithdrawVerification(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	if err := s.verificationService.Withdraw(ctx.Request().Context(), userID, id); err != nil {
+		return verificationError(ctx, err, "Failed to withdraw verification", "VERIFICATION_DELETE_ERROR")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListVerifications получает очередь модерации подтверждений опыта менторов
+// (GET /moderation/verifications)
+func (s *ServerImplementation) ListVerifications(ctx echo.Context, params openapi.ListVerificationsParams) error {
+	status := models.VerificationPending
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	items, total, err := s.verificationService.ListQueue(ctx.Request().Context(), status, limit, offset)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch verifications",
+			Code:    strPtr("VERIFICATIONS_FETCH_ERROR"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.MentorVerificationList{
+		Items: toOpenAPIMentorVerifications(items),
+		Total: &total,
+	})
+}
+
+// VerifyVerification подтверждает утверждение ментора
+// (POST /moderation/verifications/{id}/verify)
+func (s *ServerImplementation) VerifyVerification(ctx echo.Context, id int) error {
+	reviewerID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.VerificationDecision
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	v, err := s.verificationService.Verify(ctx.Request().Context(), id, reviewerID, req.Comment, req.ExpiresAt)
+	if err != nil {
+		return verificationError(ctx, err, "Failed to review verification", "VERIFICATION_REVIEW_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIMentorVerification(v))
+}
+
+// RejectVerification отклоняет утверждение ментора
+// (POST /moderation/verifications/{id}/reject)
+func (s *ServerImplementation) RejectVerification(ctx echo.Context, id int) error {
+	reviewerID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.VerificationDecision
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	v, err := s.verificationService.Reject(ctx.Request().Context(), id, reviewerID, req.Comment)
+	if err != nil {
+		return verificationError(ctx, err, "Failed to review verification", "VERIFICATION_REVIEW_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIMentorVerification(v))
+}
+
+// RevokeVerification отзывает действующее подтверждение ментора
+// (POST /moderation/verifications/{id}/revoke)
+func (s *ServerImplementation) RevokeVerification(ctx echo.Context, id int) error {
+	reviewerID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.VerificationRevocation
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	v, err := s.verificationService.Revoke(ctx.Request().Context(), id, reviewerID, req.Reason)
+	if err != nil {
+		return verificationError(ctx, err, "Failed to revoke verification", "VERIFICATION_REVOKE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIMentorVerification(v))
+}
+
+// verificationError преобразует ошибку подтверждения в HTTP ответ
+func verificationError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidVerification):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_VERIFICATION"),
+		})
+	case errors.Is(err, services.ErrNotMentor):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: "User is not a mentor",
+			Code:    strPtr("NOT_MENTOR"),
+		})
+	case errors.Is(err, services.ErrVerificationNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Verification not found",
+			Code:    strPtr("VERIFICATION_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrVerificationReviewed):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Verification is already reviewed",
+			Code:    strPtr("VERIFICATION_ALREADY_REVIEWED"),
+		})
+	case errors.Is(err, services.ErrVerificationNotActive):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Verification is not active",
+			Code:    strPtr("VERIFICATION_NOT_ACTIVE"),
+		})
+	case errors.Is(err, services.ErrTooManyPendingVerifications):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Too many verifications are pending review",
+			Code:    strPtr("TOO_MANY_PENDING_VERIFICATIONS"),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: message,
+		Code:    strPtr(code),
+	})
+}
+
+// toOpenAPIMentorVerifications преобразует список подтверждений в формат OpenAPI
+func toOpenAPIMentorVerifications(items []*models.MentorVerification) []openapi.MentorVerification {
+	result := make([]openapi.MentorVerification, 0, len(items))
+	for _, v := range items {
+		result = append(result, toOpenAPIMentorVerification(v))
+	}
+	return result
+}
+
+// toOpenAPIMentorVerification преобразует подтверждение в формат OpenAPI.
+// Истекшие подтверждения отдаются со статусом expired.
+func toOpenAPIMentorVerification(v *models.MentorVerification) openapi.MentorVerification {
+	return openapi.MentorVerification{
+		Id:            v.ID,
+		MentorId:      v.MentorID,
+		MentorName:    v.MentorName,
+		Type:          openapi.VerificationType(v.Type),
+		Title:         v.Title,
+		EvidenceUrl:   v.EvidenceURL,
+		Details:       v.Details,
+		Status:        openapi.VerificationStatus(v.EffectiveStatus(time.Now())),
+		ReviewComment: v.ReviewComment,
+		ReviewedAt:    v.ReviewedAt,
+		ExpiresAt:     v.ExpiresAt,
+		CreatedAt:     v.CreatedAt,
+	}
+}
+
+// toOpenAPIMentorBadges преобразует значки подтверждений ментора в формат OpenAPI
+func toOpenAPIMentorBadges(badges []models.MentorBadge) []openapi.MentorBadge {
+	result := make([]openapi.MentorBadge, 0, len(badges))
+	for _, b := range badges {
+		result = append(result, openapi.MentorBadge{
+			Type:       openapi.VerificationType(b.Type),
+			Title:      b.Title,
+			VerifiedAt: b.VerifiedAt,
+			ExpiresAt:  b.ExpiresAt,
+		})
+	}
+	return result
+}
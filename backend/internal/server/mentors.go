@@ -0,0 +1,362 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// ListMentors получает список менторов
+// (GET /mentors)
+func (s *ServerImplementation) ListMentors(ctx echo.Context, params openapi.ListMentorsParams) error {
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	filter := models.MentorFilter{
+		Specialization: params.Specialization,
+		TagsMatchAll:   params.TagsMode != nil && *params.TagsMode == openapi.ListMentorsParamsTagsModeAll,
+		Grade:          params.Grade,
+		MinExperience:  params.MinExperience,
+		PriceMin:       params.PriceMin,
+		PriceMax:       params.PriceMax,
+		Available:      params.Available,
+	}
+	if params.Tags != nil {
+		filter.Tags = *params.Tags
+	}
+	if params.Currency != nil {
+		currency := string(*params.Currency)
+		filter.Currency = &currency
+	}
+	if params.Language != nil {
+		language := strings.ToLower(strings.TrimSpace(*params.Language))
+		filter.Language = &language
+	}
+	if params.Sort != nil {
+		filter.Sort = string(*params.Sort)
+		// Рейтинг и опыт по умолчанию сортируются по убыванию, цена - по возрастанию
+		filter.SortDesc = filter.Sort != models.MentorSortPrice
+		if params.Order != nil {
+			filter.SortDesc = *params.Order == openapi.Desc
+		}
+	}
+
+	mentors, total, err := s.mentorService.ListMentors(ctx.Request().Context(), filter, limit, offset)
+	if errors.Is(err, services.ErrInvalidMentorFilter) {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_MENTOR_FILTER"),
+		})
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch mentors",
+			Code:    strPtr("MENTORS_FETCH_ERROR"),
+		})
+	}
+
+	items := make([]openapi.MentorCard, 0, len(mentors))
+	for _, m := range mentors {
+		items = append(items, toOpenAPIMentorCard(m))
+	}
+
+	mentorList := openapi.MentorList{
+		Items: items,
+		Total: &total,
+	}
+
+	return ctx.JSON(http.StatusOK, mentorList)
+}
+
+// GetMentorById получает полный профиль ментора
+// (GET /mentors/{id})
+func (s *ServerImplementation) GetMentorById(ctx echo.Context, id int) error {
+	mentor, err := s.mentorService.GetMentor(ctx.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, services.ErrMentorNotFound) {
+			return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+				Message: "Mentor not found",
+				Code:    strPtr("MENTOR_NOT_FOUND"),
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch mentor",
+			Code:    strPtr("MENTOR_FETCH_ERROR"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIMentorProfile(mentor))
+}
+
+// UpdateMyMentorProfile обновляет карточку текущего ментора
+// (PUT /mentors/me)
+func (s *ServerImplementation) UpdateMyMentorProfile(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.MentorProfileUpdate
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	update := &models.Mentor{
+		Specialization:  req.Specialization,
+		Grade:           req.Grade,
+		ExperienceYears: req.YearsOfExperience,
+		Description:     req.Description,
+		// По умолчанию ментор принимает новых учеников
+		IsAvailable: req.IsAvailable == nil || *req.IsAvailable,
+	}
+	if req.Skills != nil {
+		update.Tags = *req.Skills
+	}
+	if req.Languages != nil {
+		update.Languages = *req.Languages
+	}
+	if req.Contacts != nil {
+		update.Contacts = fromOpenAPIContacts(*req.Contacts)
+	}
+	if req.Pricelist != nil {
+		update.Pricelist = fromOpenAPIPricelist(*req.Pricelist)
+	}
+
+	mentor, err := s.mentorService.UpdateOwnProfile(ctx.Request().Context(), userID, update)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidMentorProfile):
+			return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+				Message: err.Error(),
+				Code:    strPtr("INVALID_MENTOR_PROFILE"),
+			})
+		case errors.Is(err, services.ErrNotMentor):
+			return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+				Message: "User is not a mentor",
+				Code:    strPtr("NOT_MENTOR"),
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to update mentor profile",
+			Code:    strPtr("MENTOR_UPDATE_ERROR"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIMentorProfile(mentor))
+}
+
+// toOpenAPIMentorCard преобразует ментора в карточку каталога
+func toOpenAPIMentorCard(m *models.Mentor) openapi.MentorCard {
+	title := m.Specialization
+	if m.Grade != nil && *m.Grade != "" {
+		title = *m.Grade + " " + m.Specialization
+	}
+
+	skills := m.Tags
+	if skills == nil {
+		skills = []string{}
+	}
+
+	languages := m.Languages
+	if languages == nil {
+		languages = []string{}
+	}
+
+	card := openapi.MentorCard{
+		Id:                m.ID,
+		FullName:          m.FullName,
+		Title:             title,
+		Grade:             m.Grade,
+		Skills:            skills,
+		YearsOfExperience: m.ExperienceYears,
+		Languages:         &languages,
+		IsAvailable:       &m.IsAvailable,
+		Rating:            m.Rating,
+		ReviewsCount:      &m.ReviewsCount,
+	}
+	if r := m.PriceRange(); r != nil {
+		card.PriceRange = &openapi.PriceRange{
+			Min:      r.Min,
+			Max:      r.Max,
+			Currency: openapi.Currency(r.Currency),
+		}
+	}
+	if len(m.Contacts) > 0 {
+		channels := make([]openapi.MentorContactType, 0, len(m.Contacts))
+		for _, c := range m.Contacts {
+			channels = append(channels, openapi.MentorContactType(c.Type))
+		}
+		card.ContactChannels = &channels
+	}
+	if len(m.Badges) > 0 {
+		badges := toOpenAPIMentorBadges(m.Badges)
+		card.Badges = &badges
+	}
+
+	return card
+}
+
+// toOpenAPIMentorProfile преобразует ментора в полный профиль
+func toOpenAPIMentorProfile(m *models.Mentor) openapi.MentorProfile {
+	skills := m.Tags
+	if skills == nil {
+		skills = []string{}
+	}
+
+	languages := m.Languages
+	if languages == nil {
+		languages = []string{}
+	}
+
+	profile := openapi.MentorProfile{
+		Id:                m.ID,
+		UserId:            m.UserID,
+		FullName:          m.FullName,
+		AvatarUrl:         m.AvatarURL,
+		Specialization:    m.Specialization,
+		Grade:             m.Grade,
+		YearsOfExperience: m.ExperienceYears,
+		Description:       m.Description,
+		Skills:            skills,
+		Languages:         languages,
+		IsAvailable:       m.IsAvailable,
+		Rating:            m.Rating,
+		ReviewsCount:      m.ReviewsCount,
+		CreatedAt:         m.CreatedAt,
+	}
+	if m.Contacts != nil {
+		contacts := toOpenAPIContacts(m.Contacts)
+		profile.Contacts = &contacts
+	}
+	if m.Pricelist != nil {
+		pricelist := toOpenAPIPricelist(m.Pricelist)
+		profile.Pricelist = &pricelist
+	}
+	if len(m.Badges) > 0 {
+		badges := toOpenAPIMentorBadges(m.Badges)
+		profile.Badges = &badges
+	}
+
+	return profile
+}
+
+// fromOpenAPIContacts преобразует контакты из запроса в модель
+func fromOpenAPIContacts(contacts []openapi.MentorContact) []models.MentorContact {
+	result := make([]models.MentorContact, 0, len(contacts))
+	for _, c := range contacts {
+		result = append(result, models.MentorContact{
+			Type:  string(c.Type),
+			Value: c.Value,
+		})
+	}
+	return result
+}
+
+// fromOpenAPIPricelist преобразует прайс-лист из запроса в модель
+func fromOpenAPIPricelist(pricelist []openapi.MentorPriceItem) []models.MentorPriceItem {
+	result := make([]models.MentorPriceItem, 0, len(pricelist))
+	for _, item := range pricelist {
+		result = append(result, models.MentorPriceItem{
+			Title:           item.Title,
+			Description:     item.Description,
+			Price:           item.Price,
+			Currency:        string(item.Currency),
+			DurationMinutes: item.DurationMinutes,
+		})
+	}
+	return result
+}
+
+// toOpenAPIContacts преобразует контакты ментора в формат OpenAPI
+func toOpenAPIContacts(contacts []models.MentorContact) []openapi.MentorContact {
+	result := make([]openapi.MentorContact, 0, len(contacts))
+	for _, c := range contacts {
+		result = append(result, openapi.MentorContact{
+			Type:  openapi.MentorContactType(c.Type),
+			Value: c.Value,
+		})
+	}
+	return result
+}
+
+// toOpenAPIPricelist преобразует прайс-лист ментора в формат OpenAPI
+func toOpenAPIPricelist(pricelist []models.MentorPriceItem) []openapi.MentorPriceItem {
+	result := make([]openapi.MentorPriceItem, 0, len(pricelist))
+	for _, item := range pricelist {
+		result = append(result, openapi.MentorPriceItem{
+			Title:           item.Title,
+			Description:     item.Description,
+			Price:           item.Price,
+			Currency:        openapi.Currency(item.Currency),
+			DurationMinutes: item.DurationMinutes,
+		})
+	}
+	return result
+}
+
+// ListRecommendedMentors подбирает менторов под слабые технологии текущего пользователя
+// (GET /mentors/recommended)
+func (s *ServerImplementation) ListRecommendedMentors(ctx echo.Context, params openapi.ListRecommendedMentorsParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	limit := 10 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	result, err := s.recommendationService.RecommendMentors(ctx.Request().Context(), userID, limit)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to recommend mentors",
+			Code:    strPtr("RECOMMENDATIONS_FETCH_ERROR"),
+		})
+	}
+
+	weaknesses := make([]openapi.TechnologyWeakness, 0, len(result.Weaknesses))
+	for _, w := range result.Weaknesses {
+		weaknesses = append(weaknesses, openapi.TechnologyWeakness{
+			Technology: w.Technology,
+			Answered:   w.Answered,
+			Incorrect:  w.Incorrect,
+			Score:      w.Score,
+		})
+	}
+
+	items := make([]openapi.MentorRecommendation, 0, len(result.Items))
+	for _, rec := range result.Items {
+		items = append(items, openapi.MentorRecommendation{
+			Mentor:              toOpenAPIMentorCard(rec.Mentor),
+			Score:               rec.Score,
+			MatchedTechnologies: rec.MatchedTechnologies,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.MentorRecommendationList{
+		WeakTechnologies: weaknesses,
+		Items:            items,
+	})
+}
@@ -0,0 +1,299 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// sseHeartbeat - период комментариев, которые не дают прокси закрыть простаивающий поток
+const sseHeartbeat = 25 * time.Second
+
+// ListConversations получает диалоги текущего пользователя
+// (GET /conversations)
+func (s *ServerImplementation) ListConversations(ctx echo.Context, params openapi.ListConversationsParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	conversations, total, unread, err := s.messageService.ListConversations(ctx.Request().Context(), userID, limit, offset)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch conversations",
+			Code:    strPtr("CONVERSATIONS_FETCH_ERROR"),
+		})
+	}
+
+	items := make([]openapi.Conversation, 0, len(conversations))
+	for _, c := range conversations {
+		items = append(items, toOpenAPIConversation(c, userID))
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ConversationList{
+		Items:       items,
+		Total:       &total,
+		UnreadCount: unread,
+	})
+}
+
+// ListMessages получает историю сообщений диалога
+// (GET /conversations/{id}/messages)
+func (s *ServerImplementation) ListMessages(ctx echo.Context, id int, params openapi.ListMessagesParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	limit := 50 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	page, err := s.messageService.ListMessages(ctx.Request().Context(), userID, id, params.Cursor, limit)
+	if err != nil {
+		return messageError(ctx, err, "Failed to fetch messages", "MESSAGES_FETCH_ERROR")
+	}
+
+	items := make([]openapi.Message, 0, len(page.Items))
+	for _, m := range page.Items {
+		items = append(items, toOpenAPIMessage(m))
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.MessageList{
+		Items:      items,
+		NextCursor: page.NextCursor,
+	})
+}
+
+// SendMessage отправляет сообщение в диалог
+// (POST /conversations/{id}/messages)
+func (s *ServerImplementation) SendMessage(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.MessageRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	msg, err := s.messageService.Send(ctx.Request().Context(), userID, id, req.Body)
+	if err != nil {
+		return messageError(ctx, err, "Failed to send message", "MESSAGE_SEND_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPIMessage(msg))
+}
+
+// MarkConversationRead отмечает диалог прочитанным
+// (POST /conversations/{id}/read)
+func (s *ServerImplementation) MarkConversationRead(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	// Тело запроса необязательно: при пустом теле Bind ничего не заполняет
+	var req openapi.ConversationReadRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	receipt, err := s.messageService.MarkRead(ctx.Request().Context(), userID, id, req.MessageId)
+	if err != nil {
+		return messageError(ctx, err, "Failed to mark conversation read", "CONVERSATION_READ_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIReadReceipt(receipt))
+}
+
+// StreamEvents отдает поток событий реального времени текущего пользователя (Server-Sent Events)
+// (GET /users/me/events)
+func (s *ServerImplementation) StreamEvents(ctx echo.Context, params openapi.StreamEventsParams) error {
+	// Браузерный EventSource не умеет передавать заголовки, поэтому токен можно передать в запросе
+	token := strings.TrimPrefix(ctx.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+	if token == "" && params.AccessToken != nil {
+		token = *params.AccessToken
+	}
+
+	claims, err := s.authService.ValidateToken(token)
+	if err != nil {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "Invalid or expired token",
+			Code:    strPtr("INVALID_TOKEN"),
+		})
+	}
+
+	sub := s.messageService.Subscribe(claims.UserID)
+	defer s.messageService.Unsubscribe(sub)
+
+	w := ctx.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Отключаем буферизацию ответа в nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprint(w, "retry: 3000\n\n"); err != nil {
+		return nil
+	}
+	w.Flush()
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+
+	// Поток живет, пока не истечет access токен: после переподключения клиент пришлет новый
+	expired := make(<-chan time.Time)
+	if claims.ExpiresAt != nil {
+		timer := time.NewTimer(time.Until(claims.ExpiresAt.Time))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-expired:
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+		case event, ok := <-sub.Events():
+			if !ok {
+				// Подписка закрыта, клиент переподключится
+				return nil
+			}
+			if err := writeSSE(w, event); err != nil {
+				return nil
+			}
+		}
+		w.Flush()
+	}
+}
+
+// writeSSE записывает событие реального времени в формате Server-Sent Events
+func writeSSE(w *echo.Response, event services.RealtimeEvent) error {
+	var data interface{}
+	switch event.Type {
+	case services.RealtimeEventMessage:
+		data = openapi.RealtimeMessageEvent{
+			Message:     toOpenAPIMessage(event.Message),
+			UnreadCount: event.UnreadCount,
+		}
+	case services.RealtimeEventRead:
+		data = openapi.RealtimeReadEvent{
+			Receipt:     toOpenAPIReadReceipt(event.Receipt),
+			UnreadCount: event.UnreadCount,
+		}
+	default:
+		return nil
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, payload)
+	return err
+}
+
+// messageError преобразует ошибку переписки в HTTP ответ
+func messageError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidMessage):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_MESSAGE"),
+		})
+	case errors.Is(err, services.ErrConversationNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Conversation not found",
+			Code:    strPtr("CONVERSATION_NOT_FOUND"),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: message,
+		Code:    strPtr(code),
+	})
+}
+
+// toOpenAPIConversation преобразует диалог в формат OpenAPI с точки зрения участника viewerID
+func toOpenAPIConversation(c *models.Conversation, viewerID int) openapi.Conversation {
+	conversation := openapi.Conversation{
+		Id:                           c.ID,
+		MentorId:                     c.MentorID,
+		MentorUserId:                 c.MentorUserID,
+		MentorName:                   c.MentorName,
+		MenteeId:                     c.MenteeID,
+		MenteeName:                   c.MenteeName,
+		UnreadCount:                  c.UnreadCount,
+		CounterpartLastReadMessageId: c.CounterpartLastReadID(viewerID),
+		CreatedAt:                    c.CreatedAt,
+	}
+	if c.LastMessage != nil {
+		last := toOpenAPIMessage(c.LastMessage)
+		conversation.LastMessage = &last
+	}
+	return conversation
+}
+
+// toOpenAPIMessage преобразует сообщение в формат OpenAPI
+func toOpenAPIMessage(m *models.Message) openapi.Message {
+	return openapi.Message{
+		Id:             m.ID,
+		ConversationId: m.ConversationID,
+		SenderId:       m.SenderID,
+		Body:           m.Body,
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+// toOpenAPIReadReceipt преобразует отметку прочтения в формат OpenAPI
+func toOpenAPIReadReceipt(r *models.ReadReceipt) openapi.ReadReceipt {
+	return openapi.ReadReceipt{
+		ConversationId:    r.ConversationID,
+		UserId:            r.UserID,
+		LastReadMessageId: r.LastReadMessageID,
+	}
+}
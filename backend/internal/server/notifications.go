@@ -0,0 +1,204 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// ListNotifications получает ленту уведомлений текущего пользователя
+// (GET /users/me/notifications)
+func (s *ServerImplementation) ListNotifications(ctx echo.Context, params openapi.ListNotificationsParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	unreadOnly := params.UnreadOnly != nil && *params.UnreadOnly
+
+	page, err := s.notificationService.List(ctx.Request().Context(), userID, params.Cursor, limit, unreadOnly)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch notifications",
+			Code:    strPtr("NOTIFICATIONS_FETCH_ERROR"),
+		})
+	}
+
+	items := make([]openapi.Notification, 0, len(page.Items))
+	for _, n := range page.Items {
+		items = append(items, toOpenAPINotification(n))
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.NotificationList{
+		Items:       items,
+		UnreadCount: page.UnreadCount,
+		NextCursor:  page.NextCursor,
+	})
+}
+
+// MarkNotificationRead отмечает уведомление прочитанным
+// (POST /users/me/notifications/{id}/read)
+func (s *ServerImplementation) MarkNotificationRead(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	err := s.notificationService.MarkRead(ctx.Request().Context(), userID, id)
+	if err != nil {
+		if errors.Is(err, services.ErrNotificationNotFound) {
+			return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+				Message: "Notification not found",
+				Code:    strPtr("NOTIFICATION_NOT_FOUND"),
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to update notification",
+			Code:    strPtr("NOTIFICATION_UPDATE_ERROR"),
+		})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// MarkAllNotificationsRead отмечает все уведомления прочитанными
+// (POST /users/me/notifications/read-all)
+func (s *ServerImplementation) MarkAllNotificationsRead(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	if err := s.notificationService.MarkAllRead(ctx.Request().Context(), userID); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to update notifications",
+			Code:    strPtr("NOTIFICATION_UPDATE_ERROR"),
+		})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetNotificationPreferences получает настройки доставки уведомлений
+// (GET /users/me/notification-preferences)
+func (s *ServerImplementation) GetNotificationPreferences(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	prefs, err := s.notificationService.GetPreferences(ctx.Request().Context(), userID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch notification preferences",
+			Code:    strPtr("PREFERENCES_FETCH_ERROR"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPINotificationPreferences(prefs))
+}
+
+// UpdateNotificationPreferences обновляет настройки доставки уведомлений
+// (PUT /users/me/notification-preferences)
+func (s *ServerImplementation) UpdateNotificationPreferences(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.NotificationPreferences
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	prefs := make([]models.NotificationPreference, 0, len(req.Items))
+	for _, p := range req.Items {
+		prefs = append(prefs, models.NotificationPreference{
+			Type:            p.Type,
+			EmailEnabled:    p.Email,
+			TelegramEnabled: p.Telegram,
+		})
+	}
+
+	err := s.notificationService.UpdatePreferences(ctx.Request().Context(), userID, prefs)
+	if err != nil {
+		if errors.Is(err, services.ErrUnknownNotificationType) {
+			return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+				Message: "Unknown notification type",
+				Code:    strPtr("UNKNOWN_NOTIFICATION_TYPE"),
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to update notification preferences",
+			Code:    strPtr("PREFERENCES_UPDATE_ERROR"),
+		})
+	}
+
+	updated, err := s.notificationService.GetPreferences(ctx.Request().Context(), userID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch notification preferences",
+			Code:    strPtr("PREFERENCES_FETCH_ERROR"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPINotificationPreferences(updated))
+}
+
+// toOpenAPINotification преобразует уведомление в формат OpenAPI
+func toOpenAPINotification(n *models.Notification) openapi.Notification {
+	item := openapi.Notification{
+		Id:        n.ID,
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		IsRead:    n.ReadAt != nil,
+		CreatedAt: n.CreatedAt,
+		ReadAt:    n.ReadAt,
+	}
+	if n.Payload != nil {
+		payload := n.Payload
+		item.Payload = &payload
+	}
+	return item
+}
+
+// toOpenAPINotificationPreferences преобразует настройки доставки в формат OpenAPI
+func toOpenAPINotificationPreferences(prefs []models.NotificationPreference) openapi.NotificationPreferences {
+	items := make([]openapi.NotificationPreference, 0, len(prefs))
+	for _, p := range prefs {
+		items = append(items, openapi.NotificationPreference{
+			Type:     p.Type,
+			Email:    p.EmailEnabled,
+			Telegram: p.TelegramEnabled,
+		})
+	}
+	return openapi.NotificationPreferences{Items: items}
+}
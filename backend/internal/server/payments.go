@@ -0,0 +1,197 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// maxWebhookBodySize - максимальный размер тела webhook платежного провайдера
+const maxWebhookBodySize = 64 << 10
+
+// GetBookingPayment получает оплату занятия
+// (GET /bookings/{id}/payment)
+func (s *ServerImplementation) GetBookingPayment(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	payment, err := s.paymentService.GetBookingPayment(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return paymentError(ctx, err, "Failed to fetch payment", "PAYMENT_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIPayment(payment))
+}
+
+// CreateBookingPayment создает платеж за занятие
+// (POST /bookings/{id}/payment)
+func (s *ServerImplementation) CreateBookingPayment(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	payment, err := s.paymentService.CreatePayment(ctx.Request().Context(), userID, id)
+	if err != nil {
+		return paymentError(ctx, err, "Failed to create payment", "PAYMENT_CREATE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPIPayment(payment))
+}
+
+// HandlePaymentWebhook принимает событие платежного провайдера
+// (POST /payments/webhook)
+func (s *ServerImplementation) HandlePaymentWebhook(ctx echo.Context) error {
+	// Подпись считается по исходному телу, поэтому читаем его без разбора
+	payload, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxWebhookBodySize))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	signature := ctx.Request().Header.Get("X-Payment-Signature")
+	if err := s.paymentService.HandleWebhook(ctx.Request().Context(), payload, signature); err != nil {
+		if errors.Is(err, services.ErrInvalidWebhook) {
+			return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+				Message: err.Error(),
+				Code:    strPtr("INVALID_WEBHOOK"),
+			})
+		}
+		// Провайдер повторит доставку
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to process webhook",
+			Code:    strPtr("WEBHOOK_PROCESSING_ERROR"),
+		})
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// ListLedgerEntries получает журнал движения денег
+// (GET /admin/payments/ledger)
+func (s *ServerImplementation) ListLedgerEntries(ctx echo.Context, params openapi.ListLedgerEntriesParams) error {
+	limit := 50 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	entries, total, err := s.paymentService.ListLedger(ctx.Request().Context(), params.PaymentId, limit, offset)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch ledger",
+			Code:    strPtr("LEDGER_FETCH_ERROR"),
+		})
+	}
+
+	items := make([]openapi.LedgerEntry, 0, len(entries))
+	for _, e := range entries {
+		items = append(items, openapi.LedgerEntry{
+			Id:        e.ID,
+			PaymentId: e.PaymentID,
+			BookingId: e.BookingID,
+			Type:      openapi.LedgerEntryType(e.EntryType),
+			Amount:    e.Amount,
+			Currency:  openapi.Currency(e.Currency),
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.LedgerEntryList{
+		Items: items,
+		Total: &total,
+	})
+}
+
+// ListUnbalancedPayments получает оплаты, расходящиеся с журналом
+// (GET /admin/payments/unbalanced)
+func (s *ServerImplementation) ListUnbalancedPayments(ctx echo.Context) error {
+	payments, err := s.paymentService.ListUnbalanced(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to reconcile payments",
+			Code:    strPtr("RECONCILIATION_ERROR"),
+		})
+	}
+
+	items := make([]openapi.Payment, 0, len(payments))
+	for _, p := range payments {
+		// Секреты плательщиков администратору не нужны
+		p.ClientSecret = nil
+		items = append(items, toOpenAPIPayment(p))
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.PaymentList{Items: items})
+}
+
+// paymentError преобразует ошибку оплаты в HTTP ответ
+func paymentError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrPaymentNotRequired):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("PAYMENT_NOT_REQUIRED"),
+		})
+	case errors.Is(err, services.ErrPaymentNotAllowed):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("PAYMENT_NOT_ALLOWED"),
+		})
+	case errors.Is(err, services.ErrPaymentExists):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("PAYMENT_EXISTS"),
+		})
+	case errors.Is(err, services.ErrBookingNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Booking not found",
+			Code:    strPtr("BOOKING_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrPaymentNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Payment not found",
+			Code:    strPtr("PAYMENT_NOT_FOUND"),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: message,
+		Code:    strPtr(code),
+	})
+}
+
+// toOpenAPIPayment преобразует оплату в формат OpenAPI
+func toOpenAPIPayment(p *models.Payment) openapi.Payment {
+	return openapi.Payment{
+		Id:               p.ID,
+		BookingId:        p.BookingID,
+		Amount:           p.Amount,
+		Currency:         openapi.Currency(p.Currency),
+		Provider:         p.Provider,
+		ProviderIntentId: p.ProviderIntentID,
+		ClientSecret:     p.ClientSecret,
+		Status:           openapi.PaymentStatus(p.Status),
+		CapturedAmount:   p.CapturedAmount,
+		RefundedAmount:   p.RefundedAmount,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+	}
+}
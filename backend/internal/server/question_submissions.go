@@ -0,0 +1,334 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// SubmitQuestion предлагает вопрос в банк вопросов
+// (POST /questions/submissions)
+func (s *ServerImplementation) SubmitQuestion(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.QuestionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	q, err := fromOpenAPIQuestionRequest(req)
+	if err != nil {
+		return submissionError(ctx, err, "Invalid request body", "INVALID_REQUEST")
+	}
+
+	submission, err := s.submissionService.Submit(ctx.Request().Context(), userID, q)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to submit question", "QUESTION_SUBMISSION_ERROR")
+	}
+
+	resp, err := toOpenAPIQuestionSubmission(submission)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to submit question", "QUESTION_SUBMISSION_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, resp)
+}
+
+// ListMyQuestionSubmissions получает вопросы, предложенные текущим пользователем
+// (GET /questions/submissions/me)
+func (s *ServerImplementation) ListMyQuestionSubmissions(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	submissions, err := s.submissionService.ListUserSubmissions(ctx.Request().Context(), userID)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to fetch question submissions", "QUESTION_SUBMISSIONS_FETCH_ERROR")
+	}
+
+	items, err := toOpenAPIQuestionSubmissions(submissions)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to fetch question submissions", "QUESTION_SUBMISSIONS_FETCH_ERROR")
+	}
+
+	total := len(items)
+	return ctx.JSON(http.StatusOK, openapi.QuestionSubmissionList{
+		Items: items,
+		Total: &total,
+	})
+}
+
+// UpdateQuestionSubmission исправляет свой предложенный вопрос
+// (PUT /questions/submissions/{id})
+func (s *ServerImplementation) UpdateQuestionSubmission(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.QuestionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	q, err := fromOpenAPIQuestionRequest(req)
+	if err != nil {
+		return submissionError(ctx, err, "Invalid request body", "INVALID_REQUEST")
+	}
+	q.ID = id
+
+	submission, err := s.submissionService.Update(ctx.Request().Context(), userID, q)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to update question submission", "QUESTION_SUBMISSION_UPDATE_ERROR")
+	}
+
+	resp, err := toOpenAPIQuestionSubmission(submission)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to update question submission", "QUESTION_SUBMISSION_UPDATE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// ListQuestionSubmissions получает очередь модерации предложенных вопросов
+// (GET /moderation/questions)
+func (s *ServerImplementation) ListQuestionSubmissions(ctx echo.Context, params openapi.ListQuestionSubmissionsParams) error {
+	status := models.QuestionDraft
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	submissions, total, err := s.submissionService.ListQueue(ctx.Request().Context(), status, limit, offset)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to fetch question submissions", "QUESTION_SUBMISSIONS_FETCH_ERROR")
+	}
+
+	items, err := toOpenAPIQuestionSubmissions(submissions)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to fetch question submissions", "QUESTION_SUBMISSIONS_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.QuestionSubmissionList{
+		Items: items,
+		Total: &total,
+	})
+}
+
+// EditQuestionSubmission исправляет вопрос из очереди модерации
+// (PUT /moderation/questions/{id})
+func (s *ServerImplementation) EditQuestionSubmission(ctx echo.Context, id int) error {
+	moderatorID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.QuestionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	q, err := fromOpenAPIQuestionRequest(req)
+	if err != nil {
+		return submissionError(ctx, err, "Invalid request body", "INVALID_REQUEST")
+	}
+	q.ID = id
+
+	submission, err := s.submissionService.Edit(ctx.Request().Context(), moderatorID, q)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to update question submission", "QUESTION_SUBMISSION_UPDATE_ERROR")
+	}
+
+	return s.questionSubmissionResponse(ctx, submission)
+}
+
+// ApproveQuestionSubmission публикует предложенный вопрос
+// (POST /moderation/questions/{id}/approve)
+func (s *ServerImplementation) ApproveQuestionSubmission(ctx echo.Context, id int) error {
+	reviewerID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.QuestionSubmissionReview
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	submission, err := s.submissionService.Approve(ctx.Request().Context(), id, reviewerID, req.Comment)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to review question submission", "QUESTION_SUBMISSION_REVIEW_ERROR")
+	}
+
+	return s.questionSubmissionResponse(ctx, submission)
+}
+
+// RejectQuestionSubmission отклоняет предложенный вопрос
+// (POST /moderation/questions/{id}/reject)
+func (s *ServerImplementation) RejectQuestionSubmission(ctx echo.Context, id int) error {
+	reviewerID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.QuestionSubmissionRejection
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	submission, err := s.submissionService.Reject(ctx.Request().Context(), id, reviewerID, req.Reason)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to review question submission", "QUESTION_SUBMISSION_REVIEW_ERROR")
+	}
+
+	return s.questionSubmissionResponse(ctx, submission)
+}
+
+// MergeQuestionSubmission объединяет предложенный вопрос с опубликованным
+// (POST /moderation/questions/{id}/merge)
+func (s *ServerImplementation) MergeQuestionSubmission(ctx echo.Context, id int) error {
+	reviewerID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.QuestionSubmissionMerge
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	submission, err := s.submissionService.Merge(ctx.Request().Context(), id, reviewerID, req.DuplicateOf, req.Comment)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to review question submission", "QUESTION_SUBMISSION_REVIEW_ERROR")
+	}
+
+	return s.questionSubmissionResponse(ctx, submission)
+}
+
+// questionSubmissionResponse отправляет предложенный вопрос после действия модератора
+func (s *ServerImplementation) questionSubmissionResponse(ctx echo.Context, submission *models.QuestionSubmission) error {
+	resp, err := toOpenAPIQuestionSubmission(submission)
+	if err != nil {
+		return submissionError(ctx, err, "Failed to review question submission", "QUESTION_SUBMISSION_REVIEW_ERROR")
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// submissionError преобразует ошибку предложенного вопроса в ответ; ошибки содержимого
+// вопроса обрабатываются так же, как в банке вопросов
+func submissionError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidSubmissionReview):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_SUBMISSION_REVIEW"),
+		})
+	case errors.Is(err, services.ErrSubmitterNotVerified):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: "Link a Telegram, Google or GitHub account to submit questions",
+			Code:    strPtr("SUBMITTER_NOT_VERIFIED"),
+		})
+	case errors.Is(err, services.ErrTooManySubmissions):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Too many questions are awaiting moderation",
+			Code:    strPtr("TOO_MANY_SUBMISSIONS"),
+		})
+	case errors.Is(err, services.ErrSubmissionReviewed):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Question submission is already reviewed",
+			Code:    strPtr("SUBMISSION_ALREADY_REVIEWED"),
+		})
+	case errors.Is(err, services.ErrSubmissionNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Question submission not found",
+			Code:    strPtr("SUBMISSION_NOT_FOUND"),
+		})
+	}
+	return questionError(ctx, err, message, code)
+}
+
+// toOpenAPIQuestionSubmissions преобразует список предложенных вопросов в формат OpenAPI
+func toOpenAPIQuestionSubmissions(submissions []*models.QuestionSubmission) ([]openapi.QuestionSubmission, error) {
+	items := make([]openapi.QuestionSubmission, 0, len(submissions))
+	for _, submission := range submissions {
+		item, err := toOpenAPIQuestionSubmission(submission)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// toOpenAPIQuestionSubmission преобразует предложенный вопрос в формат OpenAPI.
+// Ключ ответа отдается всегда: вопрос видят только автор и модераторы.
+func toOpenAPIQuestionSubmission(submission *models.QuestionSubmission) (openapi.QuestionSubmission, error) {
+	detail, err := toOpenAPIQuestionDetail(submission.Question, nil)
+	if err != nil {
+		return openapi.QuestionSubmission{}, err
+	}
+
+	return openapi.QuestionSubmission{
+		Question:      detail,
+		Status:        openapi.QuestionSubmissionStatus(submission.Question.Status),
+		ReviewComment: submission.ReviewComment,
+		ReviewedAt:    submission.ReviewedAt,
+		DuplicateOf:   submission.DuplicateOf,
+	}, nil
+}
@@ -0,0 +1,815 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/questionio"
+	"it_rabotyagi/internal/business/services"
+)
+
+// ListQuestions получает список вопросов с фильтрами и сортировкой
+// (GET /questions)
+func (s *ServerImplementation) ListQuestions(ctx echo.Context, params openapi.ListQuestionsParams) error {
+	// Определяем лимит и оффсет
+	limit := 30 // по умолчанию
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	filter := models.QuestionFilter{
+		TechnologiesMatchAll: params.TechnologyMode != nil && *params.TechnologyMode == openapi.ListQuestionsParamsTechnologyModeAll,
+	}
+	if params.Technology != nil {
+		filter.Technologies = *params.Technology
+	}
+	if params.Difficulty != nil {
+		for _, d := range *params.Difficulty {
+			filter.Difficulties = append(filter.Difficulties, string(d))
+		}
+	}
+	if params.Company != nil {
+		filter.CompanyTags = *params.Company
+	}
+	if params.Sort != nil {
+		filter.Sort = string(*params.Sort)
+	}
+
+	// Отбор по прогрессу возможен только для авторизованного пользователя
+	if params.Status != nil {
+		userID, ok := GetUserID(ctx)
+		if !ok {
+			return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+				Message: "Authentication required to filter by status",
+				Code:    strPtr("UNAUTHORIZED"),
+			})
+		}
+		filter.UserID = userID
+		filter.Status = string(*params.Status)
+	}
+
+	// Получаем вопросы из БД
+	questions, total, err := s.questionService.List(ctx.Request().Context(), filter, limit, offset)
+	if err != nil {
+		return questionError(ctx, err, "Failed to fetch questions", "QUESTIONS_FETCH_ERROR")
+	}
+
+	// Преобразуем в формат OpenAPI
+	items := make([]openapi.QuestionListItem, 0, len(questions))
+	for _, q := range questions {
+		items = append(items, openapi.QuestionListItem{
+			Id:            q.ID,
+			Title:         q.Title,
+			Type:          openapi.QuestionType(q.Type),
+			Difficulty:    openapi.QuestionListItemDifficulty(q.Difficulty),
+			Technologies:  q.Technologies,
+			CompanyTags:   q.CompanyTags,
+			CreatedAt:     q.CreatedAt,
+			AnsweredCount: q.AnsweredCount,
+			AttemptsCount: q.AttemptsCount,
+			SuccessRate:   q.SuccessRate,
+		})
+	}
+
+	questionList := openapi.QuestionList{
+		Items: items,
+		Total: &total,
+	}
+
+	return ctx.JSON(http.StatusOK, questionList)
+}
+
+// SearchQuestions ищет вопросы по тексту
+// (GET /questions/search)
+func (s *ServerImplementation) SearchQuestions(ctx echo.Context, params openapi.SearchQuestionsParams) error {
+	limit := 30 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	filter := models.QuestionFilter{
+		TechnologiesMatchAll: params.TechnologyMode != nil && *params.TechnologyMode == openapi.SearchQuestionsParamsTechnologyModeAll,
+	}
+	if params.Technology != nil {
+		filter.Technologies = *params.Technology
+	}
+	if params.Difficulty != nil {
+		for _, d := range *params.Difficulty {
+			filter.Difficulties = append(filter.Difficulties, string(d))
+		}
+	}
+	if params.Company != nil {
+		filter.CompanyTags = *params.Company
+	}
+
+	hits, total, err := s.questionService.Search(ctx.Request().Context(), params.Q, filter, limit, offset)
+	if err != nil {
+		return questionError(ctx, err, "Failed to search questions", "QUESTIONS_SEARCH_ERROR")
+	}
+
+	items := make([]openapi.QuestionSearchHit, 0, len(hits))
+	for _, h := range hits {
+		items = append(items, openapi.QuestionSearchHit{
+			Id:             h.ID,
+			Title:          h.Title,
+			Type:           openapi.QuestionType(h.Type),
+			Difficulty:     openapi.QuestionSearchHitDifficulty(h.Difficulty),
+			Technologies:   h.Technologies,
+			CompanyTags:    h.CompanyTags,
+			Rank:           h.Rank,
+			TitleHighlight: h.TitleHighlight,
+			Snippet:        h.Snippet,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.QuestionSearchResult{
+		Items: items,
+		Total: total,
+	})
+}
+
+// ListTechnologies получает технологии с количеством вопросов
+// (GET /technologies)
+func (s *ServerImplementation) ListTechnologies(ctx echo.Context) error {
+	technologies, err := s.questionService.ListTechnologies(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch technologies",
+			Code:    strPtr("TECHNOLOGIES_FETCH_ERROR"),
+		})
+	}
+
+	items := make([]openapi.Technology, 0, len(technologies))
+	for _, t := range technologies {
+		items = append(items, openapi.Technology{
+			Id:            t.ID,
+			Name:          t.Name,
+			QuestionCount: t.QuestionCount,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.TechnologyList{Items: items})
+}
+
+// GetQuestionById получает полную информацию о вопросе по ID
+// (GET /questions/{id})
+func (s *ServerImplementation) GetQuestionById(ctx echo.Context, id int) error {
+	// Анонимный пользователь не видит правильный ответ и объяснение
+	viewerID, _ := GetUserID(ctx)
+	role, _ := GetRole(ctx)
+
+	question, progress, err := s.questionService.Get(ctx.Request().Context(), viewerID, role, id)
+	if err != nil {
+		return questionError(ctx, err, "Failed to fetch question", "QUESTION_FETCH_ERROR")
+	}
+
+	detail, err := toOpenAPIQuestionDetail(question, progress)
+	if err != nil {
+		return questionError(ctx, err, "Failed to fetch question", "QUESTION_FETCH_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, detail)
+}
+
+// CreateQuestion создает вопрос от имени текущего автора
+// (POST /questions)
+func (s *ServerImplementation) CreateQuestion(ctx echo.Context) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.QuestionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	q, err := fromOpenAPIQuestionRequest(req)
+	if err != nil {
+		return questionError(ctx, err, "Invalid request body", "INVALID_REQUEST")
+	}
+
+	question, err := s.questionService.Create(ctx.Request().Context(), userID, q)
+	if err != nil {
+		return questionError(ctx, err, "Failed to create question", "QUESTION_CREATE_ERROR")
+	}
+
+	detail, err := toOpenAPIQuestionDetail(question, nil)
+	if err != nil {
+		return questionError(ctx, err, "Failed to create question", "QUESTION_CREATE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, detail)
+}
+
+// UpdateQuestion заменяет содержимое вопроса
+// (PUT /questions/{id})
+func (s *ServerImplementation) UpdateQuestion(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+	role, _ := GetRole(ctx)
+
+	var req openapi.QuestionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	q, err := fromOpenAPIQuestionRequest(req)
+	if err != nil {
+		return questionError(ctx, err, "Invalid request body", "INVALID_REQUEST")
+	}
+	q.ID = id
+
+	question, err := s.questionService.Update(ctx.Request().Context(), userID, role, q)
+	if err != nil {
+		return questionError(ctx, err, "Failed to update question", "QUESTION_UPDATE_ERROR")
+	}
+
+	detail, err := toOpenAPIQuestionDetail(question, nil)
+	if err != nil {
+		return questionError(ctx, err, "Failed to update question", "QUESTION_UPDATE_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, detail)
+}
+
+// DeleteQuestion удаляет вопрос
+// (DELETE /questions/{id})
+func (s *ServerImplementation) DeleteQuestion(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+	role, _ := GetRole(ctx)
+
+	if err := s.questionService.Delete(ctx.Request().Context(), userID, role, id); err != nil {
+		return questionError(ctx, err, "Failed to delete question", "QUESTION_DELETE_ERROR")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ImportQuestions импортирует вопросы из файла в теле запроса
+// (POST /questions/import)
+func (s *ServerImplementation) ImportQuestions(ctx echo.Context, params openapi.ImportQuestionsParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+	role, _ := GetRole(ctx)
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	report, err := s.questionService.Import(ctx.Request().Context(), userID, role, string(params.Format), ctx.Request().Body, dryRun)
+	if err != nil {
+		return questionError(ctx, err, "Failed to import questions", "QUESTIONS_IMPORT_ERROR")
+	}
+
+	rows := make([]openapi.QuestionImportRowResult, 0, len(report.Rows))
+	for _, row := range report.Rows {
+		result := openapi.QuestionImportRowResult{
+			Row:        row.Row,
+			Key:        row.ExternalKey,
+			QuestionId: row.QuestionID,
+			Action:     openapi.QuestionImportRowResultAction(row.Action),
+		}
+		if row.Error != "" {
+			result.Error = strPtr(row.Error)
+		}
+		rows = append(rows, result)
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.QuestionImportReport{
+		DryRun:    report.DryRun,
+		Applied:   report.Applied,
+		Created:   report.Created,
+		Updated:   report.Updated,
+		Unchanged: report.Unchanged,
+		Failed:    report.Failed,
+		Rows:      rows,
+	})
+}
+
+// ExportQuestions выгружает вопросы с ключами ответов в файл
+// (GET /questions/export)
+func (s *ServerImplementation) ExportQuestions(ctx echo.Context, params openapi.ExportQuestionsParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+	role, _ := GetRole(ctx)
+
+	filter := models.QuestionFilter{
+		TechnologiesMatchAll: params.TechnologyMode != nil && *params.TechnologyMode == openapi.ExportQuestionsParamsTechnologyModeAll,
+	}
+	if params.Technology != nil {
+		filter.Technologies = *params.Technology
+	}
+	if params.Difficulty != nil {
+		for _, d := range *params.Difficulty {
+			filter.Difficulties = append(filter.Difficulties, string(d))
+		}
+	}
+	if params.Company != nil {
+		filter.CompanyTags = *params.Company
+	}
+
+	format := string(params.Format)
+	var buf bytes.Buffer
+	if err := s.questionService.Export(ctx.Request().Context(), userID, role, format, filter, &buf); err != nil {
+		return questionError(ctx, err, "Failed to export questions", "QUESTIONS_EXPORT_ERROR")
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="questions.%s"`, questionio.Extension(format)))
+	return ctx.Blob(http.StatusOK, questionio.ContentType(format), buf.Bytes())
+}
+
+// ListQuestionRevisions возвращает историю правок вопроса
+// (GET /questions/{id}/revisions)
+func (s *ServerImplementation) ListQuestionRevisions(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+	role, _ := GetRole(ctx)
+
+	revisions, err := s.questionService.ListRevisions(ctx.Request().Context(), userID, role, id)
+	if err != nil {
+		return questionError(ctx, err, "Failed to get question revisions", "QUESTION_REVISIONS_ERROR")
+	}
+
+	items := make([]openapi.QuestionRevision, 0, len(revisions))
+	for _, r := range revisions {
+		item, err := toOpenAPIQuestionRevision(r)
+		if err != nil {
+			return questionError(ctx, err, "Failed to get question revisions", "QUESTION_REVISIONS_ERROR")
+		}
+		items = append(items, item)
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.QuestionRevisionList{Items: items})
+}
+
+// DiffQuestionRevisions сравнивает две ревизии вопроса
+// (GET /questions/{id}/diff)
+func (s *ServerImplementation) DiffQuestionRevisions(ctx echo.Context, id int, params openapi.DiffQuestionRevisionsParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+	role, _ := GetRole(ctx)
+
+	diff, err := s.questionService.DiffRevisions(ctx.Request().Context(), userID, role, id, params.From, params.To)
+	if err != nil {
+		return questionError(ctx, err, "Failed to diff question revisions", "QUESTION_DIFF_ERROR")
+	}
+
+	resp, err := toOpenAPIQuestionRevisionDiff(diff)
+	if err != nil {
+		return questionError(ctx, err, "Failed to diff question revisions", "QUESTION_DIFF_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// RollbackQuestion откатывает вопрос к ревизии
+// (POST /questions/{id}/revisions/{revision}/rollback)
+func (s *ServerImplementation) RollbackQuestion(ctx echo.Context, id int, revision int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+	role, _ := GetRole(ctx)
+
+	question, err := s.questionService.Rollback(ctx.Request().Context(), userID, role, id, revision)
+	if err != nil {
+		return questionError(ctx, err, "Failed to roll back question", "QUESTION_ROLLBACK_ERROR")
+	}
+
+	detail, err := toOpenAPIQuestionDetail(question, nil)
+	if err != nil {
+		return questionError(ctx, err, "Failed to roll back question", "QUESTION_ROLLBACK_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, detail)
+}
+
+// SubmitQuestionAnswer проверяет ответ текущего пользователя на вопрос
+// (POST /questions/{id}/answers)
+func (s *ServerImplementation) SubmitQuestionAnswer(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.AnswerSubmission
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	answer := models.SubmittedAnswer{Value: req.Value}
+	if req.Answer != nil {
+		answer.Text = *req.Answer
+	}
+	if req.Choices != nil {
+		answer.Choices = *req.Choices
+	}
+
+	recall := ""
+	if req.Recall != nil {
+		recall = string(*req.Recall)
+	}
+
+	result, err := s.questionService.SubmitAnswer(ctx.Request().Context(), userID, id, answer, recall, req.TimeSpentSeconds)
+	if err != nil {
+		return questionError(ctx, err, "Failed to submit answer", "ANSWER_SUBMIT_ERROR")
+	}
+
+	answerKey, err := toOpenAPIAnswerKey(result.QuestionType, result.AnswerKey)
+	if err != nil {
+		return questionError(ctx, err, "Failed to submit answer", "ANSWER_SUBMIT_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.AnswerResult{
+		QuestionId:  id,
+		IsCorrect:   result.Progress.IsCorrect,
+		AnswerKey:   answerKey,
+		Explanation: result.Explanation,
+		Progress:    *toOpenAPIQuestionProgress(result.Progress),
+	})
+}
+
+// GetMyReviewQueue получает вопросы, которые текущему пользователю пора повторить
+// (GET /users/me/review-queue)
+func (s *ServerImplementation) GetMyReviewQueue(ctx echo.Context, params openapi.GetMyReviewQueueParams) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	limit := 20
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Offset != nil && *params.Offset > 0 {
+		offset = *params.Offset
+	}
+
+	var filter models.QuestionFilter
+	if params.Technology != nil {
+		filter.Technologies = *params.Technology
+	}
+	if params.Difficulty != nil {
+		for _, d := range *params.Difficulty {
+			filter.Difficulties = append(filter.Difficulties, string(d))
+		}
+	}
+
+	queue, err := s.questionService.ReviewQueue(ctx.Request().Context(), userID, filter, limit, offset)
+	if err != nil {
+		return questionError(ctx, err, "Failed to fetch review queue", "REVIEW_QUEUE_FETCH_ERROR")
+	}
+
+	items := make([]openapi.ReviewQueueItem, 0, len(queue.Items))
+	for _, item := range queue.Items {
+		q := item.Question
+		items = append(items, openapi.ReviewQueueItem{
+			QuestionId:   q.ID,
+			Title:        q.Title,
+			Type:         openapi.QuestionType(q.Type),
+			Difficulty:   openapi.ReviewQueueItemDifficulty(q.Difficulty),
+			Technologies: q.Technologies,
+			CompanyTags:  q.CompanyTags,
+			IsCorrect:    item.IsCorrect,
+			Attempts:     item.Attempts,
+			Schedule:     toOpenAPIReviewSchedule(item.Schedule),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ReviewQueue{
+		Items:     items,
+		Total:     queue.Total,
+		NextDueAt: queue.NextDueAt,
+	})
+}
+
+// questionError преобразует ошибку сервиса вопросов в HTTP-ответ
+func questionError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidAnswer):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_ANSWER"),
+		})
+	case errors.Is(err, services.ErrInvalidQuestionFilter):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_QUESTION_FILTER"),
+		})
+	case errors.Is(err, services.ErrInvalidImport):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_IMPORT"),
+		})
+	case errors.Is(err, services.ErrInvalidQuestion):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_QUESTION"),
+		})
+	case errors.Is(err, services.ErrQuestionForbidden):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: "Only the question author or an admin can do this",
+			Code:    strPtr("QUESTION_FORBIDDEN"),
+		})
+	case errors.Is(err, services.ErrQuestionRevisionNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Question revision not found",
+			Code:    strPtr("QUESTION_REVISION_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrQuestionNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Question not found",
+			Code:    strPtr("QUESTION_NOT_FOUND"),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: message,
+		Code:    strPtr(code),
+	})
+}
+
+// fromOpenAPIQuestionRequest преобразует тело запроса в вопрос. Тип вопроса
+// определяется типом ключа ответа.
+func fromOpenAPIQuestionRequest(req openapi.QuestionRequest) (*models.Question, error) {
+	questionType, key, err := fromOpenAPIAnswerKey(req.AnswerKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: answerKey %v", services.ErrInvalidQuestion, err)
+	}
+
+	q := &models.Question{
+		Title:        req.Title,
+		Content:      req.Content,
+		Difficulty:   string(req.Difficulty),
+		Type:         questionType,
+		Language:     req.Language,
+		StarterCode:  req.StarterCode,
+		AnswerKey:    key,
+		Explanation:  req.Explanation,
+		Technologies: req.Technologies,
+	}
+	if req.Options != nil {
+		q.Options = *req.Options
+	}
+	if req.CompanyTags != nil {
+		q.CompanyTags = *req.CompanyTags
+	}
+	return q, nil
+}
+
+// fromOpenAPIAnswerKey преобразует ключ ответа из запроса и возвращает тип вопроса
+func fromOpenAPIAnswerKey(k openapi.QuestionAnswerKey) (string, *models.AnswerKey, error) {
+	value, err := k.ValueByDiscriminator()
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch v := value.(type) {
+	case openapi.SingleChoiceAnswerKey:
+		return models.QuestionTypeSingleChoice, &models.AnswerKey{Answer: v.Answer}, nil
+	case openapi.MultipleChoiceAnswerKey:
+		return models.QuestionTypeMultipleChoice, &models.AnswerKey{Answers: v.Answers}, nil
+	case openapi.OrderingAnswerKey:
+		return models.QuestionTypeOrdering, &models.AnswerKey{Order: v.Order}, nil
+	case openapi.NumericAnswerKey:
+		key := &models.AnswerKey{Value: &v.Value}
+		if v.Tolerance != nil {
+			key.Tolerance = *v.Tolerance
+		}
+		return models.QuestionTypeNumeric, key, nil
+	case openapi.FreeTextAnswerKey:
+		key := &models.AnswerKey{Patterns: v.Patterns}
+		if v.CaseSensitive != nil {
+			key.CaseSensitive = *v.CaseSensitive
+		}
+		return models.QuestionTypeFreeText, key, nil
+	case openapi.CodeAnswerKey:
+		return models.QuestionTypeCode, &models.AnswerKey{Solutions: v.Solutions}, nil
+	}
+	return "", nil, fmt.Errorf("unsupported answer key %T", value)
+}
+
+// toOpenAPIAnswerKey преобразует ключ ответа вопроса указанного типа в формат OpenAPI
+func toOpenAPIAnswerKey(questionType string, key *models.AnswerKey) (openapi.QuestionAnswerKey, error) {
+	var result openapi.QuestionAnswerKey
+	var err error
+
+	switch questionType {
+	case models.QuestionTypeSingleChoice:
+		err = result.FromSingleChoiceAnswerKey(openapi.SingleChoiceAnswerKey{Answer: key.Answer})
+	case models.QuestionTypeMultipleChoice:
+		err = result.FromMultipleChoiceAnswerKey(openapi.MultipleChoiceAnswerKey{Answers: key.Answers})
+	case models.QuestionTypeOrdering:
+		err = result.FromOrderingAnswerKey(openapi.OrderingAnswerKey{Order: key.Order})
+	case models.QuestionTypeNumeric:
+		var value float64
+		if key.Value != nil {
+			value = *key.Value
+		}
+		err = result.FromNumericAnswerKey(openapi.NumericAnswerKey{Value: value, Tolerance: &key.Tolerance})
+	case models.QuestionTypeFreeText:
+		err = result.FromFreeTextAnswerKey(openapi.FreeTextAnswerKey{Patterns: key.Patterns, CaseSensitive: &key.CaseSensitive})
+	case models.QuestionTypeCode:
+		err = result.FromCodeAnswerKey(openapi.CodeAnswerKey{Solutions: key.Solutions})
+	default:
+		err = fmt.Errorf("unknown question type %q", questionType)
+	}
+
+	return result, err
+}
+
+// toOpenAPIQuestionDetail преобразует вопрос в формат OpenAPI. Ключ ответа
+// заполняется, только если сервис его не скрыл.
+func toOpenAPIQuestionDetail(q *models.Question, progress *models.QuestionProgress) (openapi.QuestionDetail, error) {
+	detail := openapi.QuestionDetail{
+		Id:           q.ID,
+		Title:        q.Title,
+		Content:      q.Content,
+		Difficulty:   openapi.QuestionDetailDifficulty(q.Difficulty),
+		Type:         openapi.QuestionType(q.Type),
+		Options:      q.Options,
+		Language:     q.Language,
+		StarterCode:  q.StarterCode,
+		Technologies: q.Technologies,
+		Explanation:  q.Explanation,
+		CompanyTags:  &q.CompanyTags,
+		AuthorId:     q.AuthorID,
+		AuthorName:   q.AuthorName,
+		Revision:     q.Revision,
+		UpdatedAt:    &q.UpdatedAt,
+	}
+	if detail.Options == nil {
+		detail.Options = []string{}
+	}
+	if q.AnswerKey != nil {
+		answerKey, err := toOpenAPIAnswerKey(q.Type, q.AnswerKey)
+		if err != nil {
+			return openapi.QuestionDetail{}, err
+		}
+		detail.AnswerKey = &answerKey
+	}
+	if progress != nil {
+		detail.MyProgress = toOpenAPIQuestionProgress(progress)
+	}
+	return detail, nil
+}
+
+// toOpenAPIQuestionProgress преобразует прогресс по вопросу в формат OpenAPI
+func toOpenAPIQuestionProgress(p *models.QuestionProgress) *openapi.QuestionProgress {
+	return &openapi.QuestionProgress{
+		IsCorrect:        p.IsCorrect,
+		Attempts:         p.Attempts,
+		LastAnswer:       p.LastAnswer,
+		TimeSpentSeconds: p.TimeSpentSeconds,
+		Revision:         p.Revision,
+		AnsweredAt:       p.AnsweredAt,
+		Schedule:         toOpenAPIReviewSchedule(p.Schedule),
+	}
+}
+
+// toOpenAPIReviewSchedule преобразует расписание повторения в формат OpenAPI
+func toOpenAPIReviewSchedule(r models.ReviewSchedule) openapi.ReviewSchedule {
+	return openapi.ReviewSchedule{
+		EaseFactor:   r.EaseFactor,
+		IntervalDays: r.IntervalDays,
+		Repetitions:  r.Repetitions,
+		Lapses:       r.Lapses,
+		DueAt:        r.DueAt,
+	}
+}
+
+// toOpenAPIQuestionRevision преобразует ревизию вопроса в формат OpenAPI
+func toOpenAPIQuestionRevision(r *models.QuestionRevision) (openapi.QuestionRevision, error) {
+	q := r.Question
+	revision := openapi.QuestionRevision{
+		Revision:       r.Revision,
+		EditorId:       r.EditorID,
+		RolledBackFrom: r.RolledBackFrom,
+		Title:          q.Title,
+		Content:        q.Content,
+		Difficulty:     openapi.QuestionRevisionDifficulty(q.Difficulty),
+		Type:           openapi.QuestionType(q.Type),
+		Options:        q.Options,
+		Language:       q.Language,
+		StarterCode:    q.StarterCode,
+		Technologies:   q.Technologies,
+		Explanation:    q.Explanation,
+		CompanyTags:    &q.CompanyTags,
+		CreatedAt:      r.CreatedAt,
+	}
+	if revision.Options == nil {
+		revision.Options = []string{}
+	}
+	if revision.Technologies == nil {
+		revision.Technologies = []string{}
+	}
+	if q.AnswerKey != nil {
+		answerKey, err := toOpenAPIAnswerKey(q.Type, q.AnswerKey)
+		if err != nil {
+			return openapi.QuestionRevision{}, err
+		}
+		revision.AnswerKey = &answerKey
+	}
+	return revision, nil
+}
+
+// toOpenAPIQuestionRevisionDiff преобразует разницу ревизий в формат OpenAPI
+func toOpenAPIQuestionRevisionDiff(d *models.QuestionRevisionDiff) (openapi.QuestionRevisionDiff, error) {
+	from, err := toOpenAPIQuestionRevision(d.From)
+	if err != nil {
+		return openapi.QuestionRevisionDiff{}, err
+	}
+	to, err := toOpenAPIQuestionRevision(d.To)
+	if err != nil {
+		return openapi.QuestionRevisionDiff{}, err
+	}
+
+	diff := openapi.QuestionRevisionDiff{
+		From:          from,
+		To:            to,
+		ChangedFields: d.ChangedFields,
+		TextDiffs:     make([]openapi.QuestionTextDiff, 0, len(d.TextDiffs)),
+	}
+	if diff.ChangedFields == nil {
+		diff.ChangedFields = []string{}
+	}
+	for _, td := range d.TextDiffs {
+		lines := make([]openapi.DiffLine, 0, len(td.Lines))
+		for _, line := range td.Lines {
+			lines = append(lines, openapi.DiffLine{
+				Op:   openapi.DiffLineOp(line.Op),
+				Text: line.Text,
+			})
+		}
+		diff.TextDiffs = append(diff.TextDiffs, openapi.QuestionTextDiff{
+			Field: openapi.QuestionTextDiffField(td.Field),
+			Lines: lines,
+		})
+	}
+	return diff, nil
+}
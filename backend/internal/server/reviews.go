@@ -0,0 +1,296 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
+	"it_rabotyagi/internal/business/services"
+)
+
+// CreateReview оставляет отзыв о проведенном занятии
+// (POST /bookings/{id}/review)
+func (s *ServerImplementation) CreateReview(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.ReviewRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	review, err := s.reviewService.Create(ctx.Request().Context(), userID, id, req.Rating, req.Text)
+	if err != nil {
+		return reviewError(ctx, err, "Failed to create review", "REVIEW_CREATION_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPIReview(review))
+}
+
+// ListMentorReviews получает опубликованные отзывы о менторе
+// (GET /mentors/{id}/reviews)
+func (s *ServerImplementation) ListMentorReviews(ctx echo.Context, id int, params openapi.ListMentorReviewsParams) error {
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	reviews, total, err := s.reviewService.ListMentorReviews(ctx.Request().Context(), id, limit, offset)
+	if err != nil {
+		return reviewError(ctx, err, "Failed to fetch reviews", "REVIEWS_FETCH_ERROR")
+	}
+
+	items := make([]openapi.Review, 0, len(reviews))
+	for _, r := range reviews {
+		items = append(items, toOpenAPIReview(r))
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ReviewList{
+		Items: items,
+		Total: &total,
+	})
+}
+
+// ReplyToReview сохраняет ответ ментора на отзыв
+// (POST /reviews/{id}/reply)
+func (s *ServerImplementation) ReplyToReview(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.ReviewReplyRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	review, err := s.reviewService.Reply(ctx.Request().Context(), userID, id, req.Reply)
+	if err != nil {
+		return reviewError(ctx, err, "Failed to reply to review", "REVIEW_REPLY_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIReview(review))
+}
+
+// ReportReview отправляет жалобу на отзыв
+// (POST /reviews/{id}/report)
+func (s *ServerImplementation) ReportReview(ctx echo.Context, id int) error {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	var req openapi.ReviewReportRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: "Invalid request body",
+			Code:    strPtr("INVALID_REQUEST"),
+		})
+	}
+
+	report, err := s.reviewService.Report(ctx.Request().Context(), userID, id, req.Reason)
+	if err != nil {
+		return reviewError(ctx, err, "Failed to report review", "REVIEW_REPORT_ERROR")
+	}
+
+	return ctx.JSON(http.StatusCreated, toOpenAPIReviewReport(report))
+}
+
+// ListReviewReports получает очередь жалоб на отзывы
+// (GET /moderation/review-reports)
+func (s *ServerImplementation) ListReviewReports(ctx echo.Context, params openapi.ListReviewReportsParams) error {
+	status := models.ReviewReportOpen
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+
+	limit := 20 // по умолчанию
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	reports, total, err := s.reviewService.ListReports(ctx.Request().Context(), status, limit, offset)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+			Message: "Failed to fetch review reports",
+			Code:    strPtr("REVIEW_REPORTS_FETCH_ERROR"),
+		})
+	}
+
+	items := make([]openapi.ReviewReport, 0, len(reports))
+	for _, r := range reports {
+		items = append(items, toOpenAPIReviewReport(r))
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ReviewReportList{
+		Items: items,
+		Total: &total,
+	})
+}
+
+// DismissReviewReport отклоняет жалобу на отзыв
+// (POST /moderation/review-reports/{id}/dismiss)
+func (s *ServerImplementation) DismissReviewReport(ctx echo.Context, id int) error {
+	moderatorID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	if err := s.reviewService.DismissReport(ctx.Request().Context(), moderatorID, id); err != nil {
+		return reviewError(ctx, err, "Failed to dismiss review report", "REVIEW_MODERATION_ERROR")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// HideReview скрывает отзыв
+// (POST /moderation/reviews/{id}/hide)
+func (s *ServerImplementation) HideReview(ctx echo.Context, id int) error {
+	return s.moderateReview(ctx, id, s.reviewService.Hide)
+}
+
+// RestoreReview возвращает отзыв в публикацию
+// (POST /moderation/reviews/{id}/restore)
+func (s *ServerImplementation) RestoreReview(ctx echo.Context, id int) error {
+	return s.moderateReview(ctx, id, s.reviewService.Restore)
+}
+
+// moderateReview выполняет общую для скрытия и восстановления смену статуса отзыва
+func (s *ServerImplementation) moderateReview(
+	ctx echo.Context,
+	id int,
+	moderate func(ctx context.Context, moderatorID, id int) (*models.Review, error),
+) error {
+	moderatorID, ok := GetUserID(ctx)
+	if !ok {
+		return ctx.JSON(http.StatusUnauthorized, openapi.ErrorResponse{
+			Message: "User not authenticated",
+			Code:    strPtr("UNAUTHORIZED"),
+		})
+	}
+
+	review, err := moderate(ctx.Request().Context(), moderatorID, id)
+	if err != nil {
+		return reviewError(ctx, err, "Failed to moderate review", "REVIEW_MODERATION_ERROR")
+	}
+
+	return ctx.JSON(http.StatusOK, toOpenAPIReview(review))
+}
+
+// reviewError преобразует ошибку отзыва в HTTP ответ
+func reviewError(ctx echo.Context, err error, message, code string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidReview):
+		return ctx.JSON(http.StatusBadRequest, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("INVALID_REVIEW"),
+		})
+	case errors.Is(err, services.ErrReviewNotAllowed):
+		return ctx.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: err.Error(),
+			Code:    strPtr("FORBIDDEN"),
+		})
+	case errors.Is(err, services.ErrBookingNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Booking not found",
+			Code:    strPtr("BOOKING_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrMentorNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Mentor not found",
+			Code:    strPtr("MENTOR_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrReviewNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Review not found",
+			Code:    strPtr("REVIEW_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrReviewReportNotFound):
+		return ctx.JSON(http.StatusNotFound, openapi.ErrorResponse{
+			Message: "Review report not found",
+			Code:    strPtr("REVIEW_REPORT_NOT_FOUND"),
+		})
+	case errors.Is(err, services.ErrReviewExists):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Review for this booking already exists",
+			Code:    strPtr("REVIEW_ALREADY_EXISTS"),
+		})
+	case errors.Is(err, services.ErrAlreadyReported):
+		return ctx.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: "Review is already reported",
+			Code:    strPtr("REVIEW_ALREADY_REPORTED"),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
+		Message: message,
+		Code:    strPtr(code),
+	})
+}
+
+// toOpenAPIReview преобразует отзыв в формат OpenAPI
+func toOpenAPIReview(r *models.Review) openapi.Review {
+	return openapi.Review{
+		Id:          r.ID,
+		BookingId:   r.BookingID,
+		MentorId:    r.MentorID,
+		AuthorId:    r.AuthorID,
+		AuthorName:  r.AuthorName,
+		Rating:      r.Rating,
+		Text:        r.Text,
+		MentorReply: r.MentorReply,
+		RepliedAt:   r.RepliedAt,
+		Status:      openapi.ReviewStatus(r.Status),
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+// toOpenAPIReviewReport преобразует жалобу на отзыв в формат OpenAPI
+func toOpenAPIReviewReport(r *models.ReviewReport) openapi.ReviewReport {
+	report := openapi.ReviewReport{
+		Id:         r.ID,
+		ReviewId:   r.ReviewID,
+		ReporterId: r.ReporterID,
+		Reason:     r.Reason,
+		Status:     openapi.ReviewReportStatus(r.Status),
+		ResolvedAt: r.ResolvedAt,
+		CreatedAt:  r.CreatedAt,
+	}
+	if r.Review != nil {
+		review := toOpenAPIReview(r.Review)
+		report.Review = &review
+	}
+	return report
+}
@@ -2,16 +2,21 @@ package server
 
 import (
 	"it_rabotyagi/api/openapi"
+	"it_rabotyagi/internal/business/models"
 	"it_rabotyagi/internal/business/services"
 	"it_rabotyagi/internal/data/repositories"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
+// eventStreamPath - поток событий реального времени; в его URL может быть токен доступа
+const eventStreamPath = "/api/v1/users/me/events"
+
 // RegisterRoutes регистрирует все маршруты и Swagger
-func RegisterRoutes(e *echo.Echo, authService *services.AuthService, repo *repositories.UserRepository, sessionRepo *repositories.SessionRepository, questionRepo *repositories.QuestionRepository) error {
+func RegisterRoutes(e *echo.Echo, authService *services.AuthService, repo *repositories.UserRepository, sessionRepo *repositories.SessionRepository, notificationService *services.NotificationService, mentorService *services.MentorService, mentorApplicationService *services.MentorApplicationService, availabilityService *services.AvailabilityService, bookingService *services.BookingService, calendarService *services.CalendarService, reviewService *services.ReviewService, messageService *services.MessageService, recommendationService *services.RecommendationService, dashboardService *services.DashboardService, sessionNoteService *services.SessionNoteService, homeworkService *services.HomeworkService, paymentService *services.PaymentService, verificationService *services.MentorVerificationService, eventService *services.EventService, questionService *services.QuestionService, submissionService *services.QuestionSubmissionService) error {
 	// Middleware
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
@@ -19,7 +24,12 @@ func RegisterRoutes(e *echo.Echo, authService *services.AuthService, repo *repos
 		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
 	}))
 	e.Use(middleware.Recover())
-	e.Use(middleware.Logger())
+	e.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
+		// Поток событий пишется в лог отдельно, без токена из параметров запроса
+		Skipper: func(c echo.Context) bool {
+			return c.Request().URL.Path == eventStreamPath
+		},
+	}))
 
 	// Специальный обработчик для openapi.yaml с отключенным кешем
 	e.GET("/api-docs/openapi.yaml", func(c echo.Context) error {
@@ -38,7 +48,7 @@ func RegisterRoutes(e *echo.Echo, authService *services.AuthService, repo *repos
 	})
 
 	// Создаем реализацию обработчиков
-	impl := NewServerImplementation(authService, repo, sessionRepo, questionRepo)
+	impl := NewServerImplementation(authService, repo, sessionRepo, notificationService, mentorService, mentorApplicationService, availabilityService, bookingService, calendarService, reviewService, messageService, recommendationService, dashboardService, sessionNoteService, homeworkService, paymentService, verificationService, eventService, questionService, submissionService)
 
 	// Регистрируем обработчики через обертку
 	wrapper := openapi.ServerInterfaceWrapper{Handler: impl}
@@ -47,20 +57,137 @@ func RegisterRoutes(e *echo.Echo, authService *services.AuthService, repo *repos
 	e.POST("/api/v1/auth/register", wrapper.RegisterUser)
 	e.POST("/api/v1/auth/login", wrapper.LoginUser)
 	e.POST("/api/v1/auth/refresh", wrapper.RefreshTokens)
+	// Лента календаря защищена токеном в ссылке, а не заголовком авторизации
+	e.GET("/api/v1/calendar/feeds/:token", wrapper.GetCalendarFeed)
+	// Поток событий сам проверяет токен: EventSource передает его в параметре запроса
+	e.GET(eventStreamPath, wrapper.StreamEvents, middleware.LoggerWithConfig(middleware.LoggerConfig{
+		Format: strings.Replace(middleware.DefaultLoggerConfig.Format, "${uri}", "${path}", 1),
+	}))
+	// Webhook платежного провайдера проверяется подписью тела запроса
+	e.POST("/api/v1/payments/webhook", wrapper.HandlePaymentWebhook)
 
 	// Защищенные маршруты (требуют авторизации)
 	authRequired := e.Group("/api/v1")
 	authRequired.Use(AuthMiddleware(authService))
 	authRequired.GET("/users/me", wrapper.GetCurrentUser)
+	authRequired.GET("/users/me/notifications", wrapper.ListNotifications)
+	authRequired.POST("/users/me/notifications/read-all", wrapper.MarkAllNotificationsRead)
+	authRequired.POST("/users/me/notifications/:id/read", wrapper.MarkNotificationRead)
+	authRequired.GET("/users/me/notification-preferences", wrapper.GetNotificationPreferences)
+	authRequired.PUT("/users/me/notification-preferences", wrapper.UpdateNotificationPreferences)
+	authRequired.POST("/mentors/applications", wrapper.SubmitMentorApplication)
+	authRequired.GET("/mentors/applications/me", wrapper.ListMyMentorApplications)
+	authRequired.PUT("/mentors/me", wrapper.UpdateMyMentorProfile)
+	authRequired.GET("/mentors/recommended", wrapper.ListRecommendedMentors)
+	authRequired.GET("/mentors/me/dashboard", wrapper.GetMentorDashboard)
+	authRequired.GET("/mentors/me/verifications", wrapper.ListMyVerifications)
+	authRequired.POST("/mentors/me/verifications", wrapper.SubmitVerification)
+	authRequired.DELETE("/mentors/me/verifications/:id", wrapper.WithdrawVerification)
+	authRequired.PUT("/mentors/:id/progress-sharing", wrapper.ShareProgressWithMentor)
+	authRequired.DELETE("/mentors/:id/progress-sharing", wrapper.UnshareProgressWithMentor)
+	authRequired.GET("/mentors/me/availability", wrapper.GetMyAvailability)
+	authRequired.PUT("/mentors/me/availability", wrapper.UpdateMyAvailability)
+	authRequired.POST("/mentors/me/availability/exceptions", wrapper.CreateAvailabilityException)
+	authRequired.DELETE("/mentors/me/availability/exceptions/:id", wrapper.DeleteAvailabilityException)
+	authRequired.GET("/mentors/me/bookings", wrapper.ListMentorBookings)
+	authRequired.POST("/mentors/:id/bookings", wrapper.CreateBooking)
+	authRequired.GET("/users/me/bookings", wrapper.ListMyBookings)
+	authRequired.POST("/users/me/calendar-feed", wrapper.CreateCalendarFeed)
+	authRequired.DELETE("/users/me/calendar-feed", wrapper.DeleteCalendarFeed)
+	authRequired.GET("/bookings/:id", wrapper.GetBookingById)
+	authRequired.GET("/bookings/:id/calendar.ics", wrapper.GetBookingCalendar)
+	authRequired.POST("/bookings/:id/confirm", wrapper.ConfirmBooking)
+	authRequired.POST("/bookings/:id/cancel", wrapper.CancelBooking)
+	authRequired.POST("/bookings/:id/reschedule", wrapper.RescheduleBooking)
+	authRequired.POST("/bookings/:id/complete", wrapper.CompleteBooking)
+	authRequired.POST("/bookings/:id/no-show", wrapper.MarkBookingNoShow)
+	authRequired.GET("/bookings/:id/payment", wrapper.GetBookingPayment)
+	authRequired.POST("/bookings/:id/payment", wrapper.CreateBookingPayment)
+	authRequired.POST("/bookings/:id/review", wrapper.CreateReview)
+	authRequired.GET("/bookings/:id/notes", wrapper.ListSessionNotes)
+	authRequired.POST("/bookings/:id/notes", wrapper.CreateSessionNote)
+	authRequired.PUT("/session-notes/:id", wrapper.UpdateSessionNote)
+	authRequired.DELETE("/session-notes/:id", wrapper.DeleteSessionNote)
+	authRequired.GET("/bookings/:id/homework", wrapper.ListBookingHomework)
+	authRequired.POST("/bookings/:id/homework", wrapper.AssignHomework)
+	authRequired.GET("/homework/:id", wrapper.GetHomework)
+	authRequired.DELETE("/homework/:id", wrapper.DeleteHomework)
+	authRequired.GET("/users/me/homework", wrapper.ListMyHomework)
+	authRequired.GET("/mentors/me/homework", wrapper.ListMentorHomework)
+	authRequired.POST("/reviews/:id/reply", wrapper.ReplyToReview)
+	authRequired.POST("/reviews/:id/report", wrapper.ReportReview)
+	authRequired.GET("/mentors/me/events", wrapper.ListMyHostedEvents)
+	authRequired.POST("/mentors/me/events", wrapper.CreateEvent)
+	authRequired.GET("/users/me/event-registrations", wrapper.ListMyEvents)
+	authRequired.PUT("/events/:id", wrapper.UpdateEvent)
+	authRequired.POST("/events/:id/cancel", wrapper.CancelEvent)
+	authRequired.POST("/events/:id/registration", wrapper.RegisterForEvent)
+	authRequired.DELETE("/events/:id/registration", wrapper.CancelEventRegistration)
+	authRequired.GET("/events/:id/participants", wrapper.ListEventParticipants)
+	authRequired.PUT("/events/:id/participants/:userId/attendance", wrapper.MarkEventAttendance)
+	authRequired.GET("/conversations", wrapper.ListConversations)
+	authRequired.GET("/conversations/:id/messages", wrapper.ListMessages)
+	authRequired.POST("/conversations/:id/messages", wrapper.SendMessage)
+	authRequired.POST("/conversations/:id/read", wrapper.MarkConversationRead)
+	authRequired.POST("/questions/:id/answers", wrapper.SubmitQuestionAnswer)
+	authRequired.GET("/users/me/review-queue", wrapper.GetMyReviewQueue)
+	authRequired.POST("/questions/submissions", wrapper.SubmitQuestion)
+	authRequired.GET("/questions/submissions/me", wrapper.ListMyQuestionSubmissions)
+	authRequired.PUT("/questions/submissions/:id", wrapper.UpdateQuestionSubmission)
+
+	// Маршруты модерации (требуют роль модератора или администратора)
+	moderatorRequired := e.Group("/api/v1")
+	moderatorRequired.Use(AuthMiddleware(authService), RoleMiddleware(models.RoleModerator, models.RoleAdmin))
+	moderatorRequired.GET("/moderation/mentor-applications", wrapper.ListMentorApplications)
+	moderatorRequired.POST("/moderation/mentor-applications/:id/approve", wrapper.ApproveMentorApplication)
+	moderatorRequired.POST("/moderation/mentor-applications/:id/reject", wrapper.RejectMentorApplication)
+	moderatorRequired.GET("/moderation/verifications", wrapper.ListVerifications)
+	moderatorRequired.POST("/moderation/verifications/:id/verify", wrapper.VerifyVerification)
+	moderatorRequired.POST("/moderation/verifications/:id/reject", wrapper.RejectVerification)
+	moderatorRequired.POST("/moderation/verifications/:id/revoke", wrapper.RevokeVerification)
+	moderatorRequired.GET("/moderation/review-reports", wrapper.ListReviewReports)
+	moderatorRequired.POST("/moderation/review-reports/:id/dismiss", wrapper.DismissReviewReport)
+	moderatorRequired.POST("/moderation/reviews/:id/hide", wrapper.HideReview)
+	moderatorRequired.POST("/moderation/reviews/:id/restore", wrapper.RestoreReview)
+	moderatorRequired.GET("/moderation/questions", wrapper.ListQuestionSubmissions)
+	moderatorRequired.PUT("/moderation/questions/:id", wrapper.EditQuestionSubmission)
+	moderatorRequired.POST("/moderation/questions/:id/approve", wrapper.ApproveQuestionSubmission)
+	moderatorRequired.POST("/moderation/questions/:id/reject", wrapper.RejectQuestionSubmission)
+	moderatorRequired.POST("/moderation/questions/:id/merge", wrapper.MergeQuestionSubmission)
+
+	// Маршруты администратора
+	adminRequired := e.Group("/api/v1")
+	adminRequired.Use(AuthMiddleware(authService), RoleMiddleware(models.RoleAdmin))
+	adminRequired.GET("/admin/payments/ledger", wrapper.ListLedgerEntries)
+	adminRequired.GET("/admin/payments/unbalanced", wrapper.ListUnbalancedPayments)
+	adminRequired.POST("/questions/:id/revisions/:revision/rollback", wrapper.RollbackQuestion)
+
+	// Маршруты авторов вопросов (роль автора или администратора)
+	authorRequired := e.Group("/api/v1")
+	authorRequired.Use(AuthMiddleware(authService), RoleMiddleware(models.RoleAuthor, models.RoleAdmin))
+	authorRequired.POST("/questions", wrapper.CreateQuestion)
+	authorRequired.POST("/questions/import", wrapper.ImportQuestions)
+	authorRequired.GET("/questions/export", wrapper.ExportQuestions)
+	authorRequired.PUT("/questions/:id", wrapper.UpdateQuestion)
+	authorRequired.DELETE("/questions/:id", wrapper.DeleteQuestion)
+	authorRequired.GET("/questions/:id/revisions", wrapper.ListQuestionRevisions)
+	authorRequired.GET("/questions/:id/diff", wrapper.DiffQuestionRevisions)
 
 	// Маршруты с опциональной авторизацией
 	optionalAuth := e.Group("/api/v1")
 	optionalAuth.Use(OptionalAuthMiddleware(authService))
 	optionalAuth.GET("/mentors", wrapper.ListMentors)
+	optionalAuth.GET("/mentors/:id", wrapper.GetMentorById)
+	optionalAuth.GET("/mentors/:id/slots", wrapper.ListMentorSlots)
+	optionalAuth.GET("/mentors/:id/reviews", wrapper.ListMentorReviews)
+	optionalAuth.GET("/events", wrapper.ListEvents)
+	optionalAuth.GET("/events/:id", wrapper.GetEventById)
+	optionalAuth.GET("/questions", wrapper.ListQuestions)
+	optionalAuth.GET("/questions/:id", wrapper.GetQuestionById)
 
 	// Публичные маршруты для вопросов
-	e.GET("/api/v1/questions", wrapper.ListQuestions)
-	e.GET("/api/v1/questions/:id", wrapper.GetQuestionById)
+	e.GET("/api/v1/questions/search", wrapper.SearchQuestions)
+	e.GET("/api/v1/technologies", wrapper.ListTechnologies)
 
 	return nil
 }
@@ -0,0 +1,31 @@
+-- +goose Up
+-- Уведомления, которые показываются пользователю внутри приложения
+CREATE TABLE notifications (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    title TEXT NOT NULL CHECK (length(title) <= 200),
+    body TEXT NOT NULL,
+    payload JSONB,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Лента уведомлений читается постранично от новых к старым
+CREATE INDEX notifications_user_id_id_idx ON notifications (user_id, id DESC);
+-- Быстрый подсчет непрочитанных
+CREATE INDEX notifications_unread_idx ON notifications (user_id) WHERE read_at IS NULL;
+
+-- Настройки доставки уведомлений во внешние каналы (email, Telegram) по типам событий
+CREATE TABLE notification_preferences (
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    email_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    telegram_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, type)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_preferences;
+DROP TABLE IF EXISTS notifications;
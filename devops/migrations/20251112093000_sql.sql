@@ -0,0 +1,27 @@
+-- +goose Up
+-- Заявки пользователей на получение статуса ментора
+CREATE TABLE mentor_applications (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    specialization TEXT NOT NULL,
+    grade TEXT,
+    experience_years INT CHECK (experience_years >= 0),
+    description TEXT,
+    tags TEXT[],
+    contacts JSONB,
+    pricelist JSONB,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    reviewer_id INT REFERENCES users(id) ON DELETE SET NULL,
+    review_comment TEXT,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- У пользователя может быть только одна заявка на рассмотрении
+CREATE UNIQUE INDEX mentor_applications_pending_user_idx ON mentor_applications (user_id) WHERE status = 'pending';
+-- Очередь модерации читается по статусу в порядке подачи
+CREATE INDEX mentor_applications_status_idx ON mentor_applications (status, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS mentor_applications;
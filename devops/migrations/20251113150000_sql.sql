@@ -0,0 +1,59 @@
+-- +goose Up
+-- Контакты ментора хранятся списком каналов [{"type": "telegram", "value": "@alex"}].
+-- Старый формат {"telegram": "@alex"} переводим в новый.
+UPDATE mentors
+SET contacts = (
+    SELECT COALESCE(jsonb_agg(jsonb_build_object('type', lower(key), 'value', value)), '[]'::jsonb)
+    FROM jsonb_each_text(contacts)
+)
+WHERE jsonb_typeof(contacts) = 'object';
+
+UPDATE mentor_applications
+SET contacts = (
+    SELECT COALESCE(jsonb_agg(jsonb_build_object('type', lower(key), 'value', value)), '[]'::jsonb)
+    FROM jsonb_each_text(contacts)
+)
+WHERE jsonb_typeof(contacts) = 'object';
+
+-- В прайс-листе оставляем только услуги с названием, числовой ценой и валютой
+UPDATE mentors
+SET pricelist = (
+    SELECT COALESCE(jsonb_agg(item), '[]'::jsonb)
+    FROM jsonb_array_elements(pricelist) AS item
+    WHERE jsonb_typeof(item -> 'title') = 'string'
+      AND jsonb_typeof(item -> 'price') = 'number'
+      AND jsonb_typeof(item -> 'currency') = 'string'
+)
+WHERE jsonb_typeof(pricelist) = 'array';
+
+UPDATE mentor_applications
+SET pricelist = (
+    SELECT COALESCE(jsonb_agg(item), '[]'::jsonb)
+    FROM jsonb_array_elements(pricelist) AS item
+    WHERE jsonb_typeof(item -> 'title') = 'string'
+      AND jsonb_typeof(item -> 'price') = 'number'
+      AND jsonb_typeof(item -> 'currency') = 'string'
+)
+WHERE jsonb_typeof(pricelist) = 'array';
+
+UPDATE mentors SET contacts = NULL WHERE jsonb_typeof(contacts) <> 'array';
+UPDATE mentors SET pricelist = NULL WHERE jsonb_typeof(pricelist) <> 'array';
+UPDATE mentor_applications SET contacts = NULL WHERE jsonb_typeof(contacts) <> 'array';
+UPDATE mentor_applications SET pricelist = NULL WHERE jsonb_typeof(pricelist) <> 'array';
+
+ALTER TABLE mentors
+    ADD CONSTRAINT mentors_contacts_array_check CHECK (contacts IS NULL OR jsonb_typeof(contacts) = 'array'),
+    ADD CONSTRAINT mentors_pricelist_array_check CHECK (pricelist IS NULL OR jsonb_typeof(pricelist) = 'array');
+
+ALTER TABLE mentor_applications
+    ADD CONSTRAINT mentor_applications_contacts_array_check CHECK (contacts IS NULL OR jsonb_typeof(contacts) = 'array'),
+    ADD CONSTRAINT mentor_applications_pricelist_array_check CHECK (pricelist IS NULL OR jsonb_typeof(pricelist) = 'array');
+
+-- +goose Down
+ALTER TABLE mentor_applications
+    DROP CONSTRAINT IF EXISTS mentor_applications_pricelist_array_check,
+    DROP CONSTRAINT IF EXISTS mentor_applications_contacts_array_check;
+
+ALTER TABLE mentors
+    DROP CONSTRAINT IF EXISTS mentors_pricelist_array_check,
+    DROP CONSTRAINT IF EXISTS mentors_contacts_array_check;
@@ -0,0 +1,76 @@
+-- +goose Up
+-- Цены в прайс-листе - целые единицы валюты; дробные цены, оставшиеся от старого формата,
+-- округляем, чтобы они читались приложением
+UPDATE mentors
+SET pricelist = (
+    SELECT jsonb_agg(jsonb_set(item, '{price}', to_jsonb(ROUND((item ->> 'price')::numeric)::INT)))
+    FROM jsonb_array_elements(pricelist) AS item
+)
+WHERE jsonb_typeof(pricelist) = 'array' AND jsonb_array_length(pricelist) > 0;
+
+UPDATE mentor_applications
+SET pricelist = (
+    SELECT jsonb_agg(jsonb_set(item, '{price}', to_jsonb(ROUND((item ->> 'price')::numeric)::INT)))
+    FROM jsonb_array_elements(pricelist) AS item
+)
+WHERE jsonb_typeof(pricelist) = 'array' AND jsonb_array_length(pricelist) > 0;
+
+-- Минимальная и максимальная цена из прайс-листа ментора.
+-- Функции неизменяемые, поэтому их можно использовать в генерируемых колонках.
+-- Цена приводится через numeric, чтобы дробное значение не ломало запись прайс-листа.
+-- +goose StatementBegin
+CREATE FUNCTION mentor_pricelist_min_price(pricelist JSONB) RETURNS INT
+    LANGUAGE sql IMMUTABLE AS $$
+    SELECT FLOOR(MIN((item ->> 'price')::numeric))::INT
+    FROM jsonb_array_elements(CASE WHEN jsonb_typeof(pricelist) = 'array' THEN pricelist ELSE '[]'::jsonb END) AS item
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION mentor_pricelist_max_price(pricelist JSONB) RETURNS INT
+    LANGUAGE sql IMMUTABLE AS $$
+    SELECT CEIL(MAX((item ->> 'price')::numeric))::INT
+    FROM jsonb_array_elements(CASE WHEN jsonb_typeof(pricelist) = 'array' THEN pricelist ELSE '[]'::jsonb END) AS item
+$$;
+-- +goose StatementEnd
+
+ALTER TABLE mentors
+    -- Языки, на которых ментор проводит занятия
+    ADD COLUMN languages TEXT[] NOT NULL DEFAULT '{}',
+    -- Принимает ли ментор новых учеников
+    ADD COLUMN is_available BOOLEAN NOT NULL DEFAULT true,
+    -- Средняя оценка и количество отзывов
+    ADD COLUMN rating NUMERIC(3, 2),
+    ADD COLUMN reviews_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN price_min INT GENERATED ALWAYS AS (mentor_pricelist_min_price(pricelist)) STORED,
+    ADD COLUMN price_max INT GENERATED ALWAYS AS (mentor_pricelist_max_price(pricelist)) STORED,
+    -- Все услуги ментора указываются в одной валюте
+    ADD COLUMN price_currency TEXT GENERATED ALWAYS AS (pricelist -> 0 ->> 'currency') STORED;
+
+-- Индексы для фильтров каталога менторов
+CREATE INDEX mentors_tags_gin_idx ON mentors USING GIN (tags);
+CREATE INDEX mentors_languages_gin_idx ON mentors USING GIN (languages);
+CREATE INDEX mentors_grade_idx ON mentors (lower(grade));
+CREATE INDEX mentors_experience_years_idx ON mentors (experience_years);
+CREATE INDEX mentors_price_idx ON mentors (price_min, price_max);
+CREATE INDEX mentors_rating_idx ON mentors (rating DESC NULLS LAST);
+
+-- +goose Down
+DROP INDEX IF EXISTS mentors_rating_idx;
+DROP INDEX IF EXISTS mentors_price_idx;
+DROP INDEX IF EXISTS mentors_experience_years_idx;
+DROP INDEX IF EXISTS mentors_grade_idx;
+DROP INDEX IF EXISTS mentors_languages_gin_idx;
+DROP INDEX IF EXISTS mentors_tags_gin_idx;
+
+ALTER TABLE mentors
+    DROP COLUMN IF EXISTS price_currency,
+    DROP COLUMN IF EXISTS price_max,
+    DROP COLUMN IF EXISTS price_min,
+    DROP COLUMN IF EXISTS reviews_count,
+    DROP COLUMN IF EXISTS rating,
+    DROP COLUMN IF EXISTS is_available,
+    DROP COLUMN IF EXISTS languages;
+
+DROP FUNCTION IF EXISTS mentor_pricelist_max_price(JSONB);
+DROP FUNCTION IF EXISTS mentor_pricelist_min_price(JSONB);
@@ -0,0 +1,64 @@
+-- +goose Up
+-- Нужен для EXCLUDE ограничения по mentor_id и диапазону времени
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Часовой пояс, в котором ментор задает свое расписание
+ALTER TABLE mentors ADD COLUMN timezone TEXT NOT NULL DEFAULT 'Europe/Moscow';
+
+-- Еженедельные окна доступности ментора (время в часовом поясе ментора)
+CREATE TABLE mentor_availability_rules (
+    id SERIAL PRIMARY KEY,
+    mentor_id INT NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6), -- 0 - воскресенье
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    CHECK (start_time < end_time)
+);
+
+CREATE INDEX mentor_availability_rules_mentor_idx ON mentor_availability_rules (mentor_id, weekday);
+
+-- Разовые исключения: дополнительные окна или периоды недоступности
+CREATE TABLE mentor_availability_exceptions (
+    id SERIAL PRIMARY KEY,
+    mentor_id INT NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    is_available BOOLEAN NOT NULL DEFAULT false,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (starts_at < ends_at)
+);
+
+CREATE INDEX mentor_availability_exceptions_mentor_idx ON mentor_availability_exceptions (mentor_id, starts_at);
+
+-- Бронирования занятий с ментором
+CREATE TABLE bookings (
+    id SERIAL PRIMARY KEY,
+    mentor_id INT NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    mentee_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'requested'
+        CHECK (status IN ('requested', 'confirmed', 'cancelled', 'completed', 'no_show')),
+    comment TEXT,
+    cancel_reason TEXT,
+    cancelled_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (starts_at < ends_at),
+    -- Активные бронирования одного ментора не могут пересекаться по времени,
+    -- что защищает от двойного бронирования при конкурентных запросах
+    CONSTRAINT bookings_no_overlap EXCLUDE USING gist (
+        mentor_id WITH =,
+        tstzrange(starts_at, ends_at) WITH &&
+    ) WHERE (status IN ('requested', 'confirmed'))
+);
+
+CREATE INDEX bookings_mentee_idx ON bookings (mentee_id, starts_at DESC);
+CREATE INDEX bookings_mentor_idx ON bookings (mentor_id, starts_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS bookings;
+DROP TABLE IF EXISTS mentor_availability_exceptions;
+DROP TABLE IF EXISTS mentor_availability_rules;
+ALTER TABLE mentors DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,35 @@
+-- +goose Up
+-- Версия события для календарей (SEQUENCE в iCalendar). Растет при изменении времени
+-- или статуса, чтобы подписанные календари заменяли устаревшую копию события.
+ALTER TABLE bookings ADD COLUMN sequence INT NOT NULL DEFAULT 0;
+
+-- +goose StatementBegin
+CREATE FUNCTION bookings_bump_sequence() RETURNS trigger
+    LANGUAGE plpgsql AS $$
+BEGIN
+    IF NEW.starts_at IS DISTINCT FROM OLD.starts_at
+        OR NEW.ends_at IS DISTINCT FROM OLD.ends_at
+        OR NEW.status IS DISTINCT FROM OLD.status THEN
+        NEW.sequence := OLD.sequence + 1;
+    END IF;
+    RETURN NEW;
+END;
+$$;
+-- +goose StatementEnd
+
+CREATE TRIGGER bookings_bump_sequence_trg
+    BEFORE UPDATE ON bookings
+    FOR EACH ROW EXECUTE FUNCTION bookings_bump_sequence();
+
+-- Приватные токены подписки на календарь пользователя (храним только хеш)
+CREATE TABLE calendar_feed_tokens (
+    user_id INT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS calendar_feed_tokens;
+DROP TRIGGER IF EXISTS bookings_bump_sequence_trg ON bookings;
+DROP FUNCTION IF EXISTS bookings_bump_sequence();
+ALTER TABLE bookings DROP COLUMN IF EXISTS sequence;
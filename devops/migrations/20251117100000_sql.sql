@@ -0,0 +1,37 @@
+-- +goose Up
+-- Отзывы учеников о проведенных занятиях (один отзыв на занятие)
+CREATE TABLE reviews (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL UNIQUE REFERENCES bookings(id) ON DELETE CASCADE,
+    mentor_id INT NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    author_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    text TEXT,
+    mentor_reply TEXT,
+    replied_at TIMESTAMPTZ,
+    -- hidden - отзыв скрыт модератором и не учитывается в рейтинге
+    status TEXT NOT NULL DEFAULT 'published' CHECK (status IN ('published', 'hidden')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX reviews_mentor_idx ON reviews (mentor_id, status, created_at DESC);
+
+-- Жалобы пользователей на отзывы
+CREATE TABLE review_reports (
+    id SERIAL PRIMARY KEY,
+    review_id INT NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    reporter_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'resolved', 'dismissed')),
+    resolved_by INT REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (review_id, reporter_id)
+);
+
+CREATE INDEX review_reports_status_idx ON review_reports (status, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS review_reports;
+DROP TABLE IF EXISTS reviews;
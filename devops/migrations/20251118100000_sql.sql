@@ -0,0 +1,55 @@
+-- +goose Up
+-- Переписка ментора и ученика. Один диалог на пару ментор - ученик.
+CREATE TABLE conversations (
+    id SERIAL PRIMARY KEY,
+    mentor_id INT NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    mentee_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- ID последнего прочитанного сообщения каждой стороной (0 - ничего не прочитано)
+    mentor_last_read_id INT NOT NULL DEFAULT 0,
+    mentee_last_read_id INT NOT NULL DEFAULT 0,
+    last_message_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (mentor_id, mentee_id)
+);
+
+CREATE INDEX conversations_mentee_idx ON conversations (mentee_id);
+
+CREATE TABLE messages (
+    id SERIAL PRIMARY KEY,
+    conversation_id INT NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+    sender_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL CHECK (length(btrim(body)) > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX messages_conversation_idx ON messages (conversation_id, id DESC);
+
+-- Диалог появляется вместе с первым запросом на занятие
+-- +goose StatementBegin
+CREATE FUNCTION bookings_ensure_conversation() RETURNS trigger
+    LANGUAGE plpgsql AS $$
+BEGIN
+    INSERT INTO conversations (mentor_id, mentee_id)
+    VALUES (NEW.mentor_id, NEW.mentee_id)
+    ON CONFLICT (mentor_id, mentee_id) DO NOTHING;
+    RETURN NEW;
+END;
+$$;
+-- +goose StatementEnd
+
+CREATE TRIGGER bookings_ensure_conversation_trg
+    AFTER INSERT ON bookings
+    FOR EACH ROW EXECUTE FUNCTION bookings_ensure_conversation();
+
+-- Диалоги для уже существующих бронирований
+INSERT INTO conversations (mentor_id, mentee_id, created_at)
+SELECT mentor_id, mentee_id, MIN(created_at)
+FROM bookings
+GROUP BY mentor_id, mentee_id
+ON CONFLICT (mentor_id, mentee_id) DO NOTHING;
+
+-- +goose Down
+DROP TRIGGER IF EXISTS bookings_ensure_conversation_trg ON bookings;
+DROP FUNCTION IF EXISTS bookings_ensure_conversation();
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS conversations;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Согласия учеников на просмотр их прогресса в курсах конкретным ментором
+CREATE TABLE mentee_progress_consents (
+    mentee_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    mentor_id INT NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (mentee_id, mentor_id)
+);
+
+CREATE INDEX mentee_progress_consents_mentor_idx ON mentee_progress_consents (mentor_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS mentee_progress_consents;
@@ -0,0 +1,49 @@
+-- +goose Up
+-- Заметки ментора по занятию. private видны только автору, shared - также ученику
+CREATE TABLE session_notes (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    author_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    visibility TEXT NOT NULL DEFAULT 'private' CHECK (visibility IN ('private', 'shared')),
+    summary TEXT NOT NULL,
+    strengths TEXT,
+    improvements TEXT,
+    next_steps TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX session_notes_booking_idx ON session_notes (booking_id, created_at);
+
+-- Домашние задания, которые ментор выдает ученику по итогам занятия
+CREATE TABLE homework_assignments (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    mentor_id INT NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    mentee_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    comment TEXT,
+    due_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX homework_assignments_booking_idx ON homework_assignments (booking_id);
+CREATE INDEX homework_assignments_mentee_idx ON homework_assignments (mentee_id, created_at DESC);
+CREATE INDEX homework_assignments_mentor_idx ON homework_assignments (mentor_id, created_at DESC);
+
+-- Пункты домашнего задания: вопрос или модуль курса. Выполнение считается
+-- по верным ответам ученика в user_question_progress
+CREATE TABLE homework_items (
+    id SERIAL PRIMARY KEY,
+    assignment_id INT NOT NULL REFERENCES homework_assignments(id) ON DELETE CASCADE,
+    question_id INT REFERENCES questions(id) ON DELETE CASCADE,
+    module_id INT REFERENCES modules(id) ON DELETE CASCADE,
+    CHECK (num_nonnulls(question_id, module_id) = 1),
+    UNIQUE (assignment_id, question_id),
+    UNIQUE (assignment_id, module_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS homework_items;
+DROP TABLE IF EXISTS homework_assignments;
+DROP TABLE IF EXISTS session_notes;
@@ -0,0 +1,57 @@
+-- +goose Up
+-- Оплаты занятий. Деньги удерживаются провайдером (эскроу) до завершения занятия:
+-- held -> captured при проведении, при отмене часть или вся сумма возвращается ученику
+CREATE TABLE payments (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    payer_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    mentor_id INT NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    amount INT NOT NULL CHECK (amount > 0),
+    currency TEXT NOT NULL,
+    provider TEXT NOT NULL,
+    provider_intent_id TEXT NOT NULL,
+    client_secret TEXT,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN (
+        'pending', 'held', 'captured', 'partially_refunded', 'refunded', 'cancelled', 'failed'
+    )),
+    captured_amount INT NOT NULL DEFAULT 0,
+    refunded_amount INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (provider, provider_intent_id),
+    CHECK (captured_amount + refunded_amount <= amount)
+);
+
+-- У занятия может быть только одна незавершенная оплата; после неудачной можно создать новую
+CREATE UNIQUE INDEX payments_booking_active_idx ON payments (booking_id)
+    WHERE status NOT IN ('cancelled', 'failed');
+
+-- Обработанные события провайдера. Повторная доставка того же события игнорируется.
+CREATE TABLE payment_events (
+    provider TEXT NOT NULL,
+    event_id TEXT NOT NULL,
+    type TEXT NOT NULL,
+    provider_intent_id TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (provider, event_id)
+);
+
+-- Журнал движения денег для сверки с провайдером.
+-- Для каждой завершенной оплаты сумма hold равна сумме capture и refund.
+CREATE TABLE ledger_entries (
+    id SERIAL PRIMARY KEY,
+    payment_id INT NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    entry_type TEXT NOT NULL CHECK (entry_type IN ('hold', 'capture', 'refund')),
+    amount INT NOT NULL CHECK (amount > 0),
+    currency TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX ledger_entries_payment_idx ON ledger_entries (payment_id);
+CREATE INDEX ledger_entries_created_idx ON ledger_entries (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS payment_events;
+DROP TABLE IF EXISTS payments;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Подтверждения опыта ментора. Ментор прикладывает доказательство к каждому утверждению
+-- (место работы, сертификат, профиль GitHub), модератор проверяет их по отдельности.
+-- Подтвержденное утверждение показывается значком в карточке ментора до expires_at.
+CREATE TABLE mentor_verifications (
+    id SERIAL PRIMARY KEY,
+    mentor_id INT NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    type TEXT NOT NULL CHECK (type IN ('employment', 'certification', 'github')),
+    title TEXT NOT NULL,
+    evidence_url TEXT NOT NULL,
+    details TEXT,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'verified', 'rejected', 'revoked')),
+    reviewer_id INT REFERENCES users(id) ON DELETE SET NULL,
+    review_comment TEXT,
+    reviewed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (status <> 'verified' OR expires_at IS NOT NULL)
+);
+
+CREATE INDEX mentor_verifications_mentor_idx ON mentor_verifications (mentor_id, status);
+CREATE INDEX mentor_verifications_queue_idx ON mentor_verifications (status, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS mentor_verifications;
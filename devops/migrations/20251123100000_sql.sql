@@ -0,0 +1,43 @@
+-- +goose Up
+-- Групповые занятия и mock-собеседования, которые проводят менторы
+CREATE TABLE events (
+    id SERIAL PRIMARY KEY,
+    mentor_id INT NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    type TEXT NOT NULL CHECK (type IN ('group_session', 'mock_interview')),
+    title TEXT NOT NULL,
+    description TEXT,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    capacity INT NOT NULL CHECK (capacity > 0),
+    status TEXT NOT NULL DEFAULT 'scheduled' CHECK (status IN ('scheduled', 'cancelled')),
+    -- Когда участникам отправлено напоминание; сбрасывается при переносе события
+    reminder_sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX events_starts_idx ON events (status, starts_at);
+CREATE INDEX events_mentor_idx ON events (mentor_id, starts_at);
+
+-- Регистрации на события. Сверх вместимости участники попадают в лист ожидания
+-- и переводятся в участники в порядке queued_at, когда освобождается место.
+CREATE TABLE event_registrations (
+    id SERIAL PRIMARY KEY,
+    event_id INT NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('registered', 'waitlisted', 'cancelled')),
+    -- Отметка ментора о посещении, NULL - еще не отмечено
+    attended BOOLEAN,
+    queued_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (event_id, user_id)
+);
+
+CREATE INDEX event_registrations_queue_idx ON event_registrations (event_id, status, queued_at);
+CREATE INDEX event_registrations_user_idx ON event_registrations (user_id, status);
+
+-- +goose Down
+DROP TABLE IF EXISTS event_registrations;
+DROP TABLE IF EXISTS events;
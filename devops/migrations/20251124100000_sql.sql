@@ -0,0 +1,10 @@
+-- +goose Up
+-- Ответы на вопросы принимаются и вне курса (тренировка по банку вопросов),
+-- поэтому курс и модуль в прогрессе становятся необязательными
+ALTER TABLE user_question_progress ALTER COLUMN course_id DROP NOT NULL;
+ALTER TABLE user_question_progress ALTER COLUMN module_id DROP NOT NULL;
+
+-- +goose Down
+DELETE FROM user_question_progress WHERE course_id IS NULL OR module_id IS NULL;
+ALTER TABLE user_question_progress ALTER COLUMN module_id SET NOT NULL;
+ALTER TABLE user_question_progress ALTER COLUMN course_id SET NOT NULL;
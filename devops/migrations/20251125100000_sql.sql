@@ -0,0 +1,14 @@
+-- +goose Up
+-- Автор вопроса. У вопросов из начального наполнения автора нет, их редактирует администратор
+ALTER TABLE questions ADD COLUMN author_id INT REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX questions_author_idx ON questions (author_id);
+
+-- Начальное наполнение вставляло вопросы и технологии с явными ID, поэтому счетчики
+-- нужно сдвинуть, иначе первая вставка через API упадет на уникальности ключа
+SELECT setval(pg_get_serial_sequence('questions', 'id'), COALESCE((SELECT MAX(id) FROM questions), 0) + 1, false);
+SELECT setval(pg_get_serial_sequence('technologies', 'id'), COALESCE((SELECT MAX(id) FROM technologies), 0) + 1, false);
+
+-- +goose Down
+DROP INDEX IF EXISTS questions_author_idx;
+ALTER TABLE questions DROP COLUMN IF EXISTS author_id;
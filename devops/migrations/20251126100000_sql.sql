@@ -0,0 +1,28 @@
+-- +goose Up
+-- Типы вопросов: кроме одного варианта - несколько вариантов, упорядочивание, число,
+-- текст и код. Данные для проверки ответа каждого типа хранятся в answer_key
+-- и никогда не уходят клиенту до попытки ответить.
+ALTER TABLE questions
+    ADD COLUMN type TEXT NOT NULL DEFAULT 'single_choice'
+        CHECK (type IN ('single_choice', 'multiple_choice', 'ordering', 'numeric', 'free_text', 'code')),
+    ADD COLUMN answer_key JSONB,
+    -- Язык и заготовка решения для вопросов с ответом кодом
+    ADD COLUMN code_language TEXT,
+    ADD COLUMN starter_code TEXT;
+
+UPDATE questions SET answer_key = jsonb_build_object('answer', correct_answer)
+WHERE correct_answer IS NOT NULL;
+
+ALTER TABLE questions DROP COLUMN correct_answer;
+
+-- +goose Down
+ALTER TABLE questions ADD COLUMN correct_answer TEXT;
+
+UPDATE questions SET correct_answer = answer_key ->> 'answer'
+WHERE type = 'single_choice';
+
+ALTER TABLE questions
+    DROP COLUMN starter_code,
+    DROP COLUMN code_language,
+    DROP COLUMN answer_key,
+    DROP COLUMN type;
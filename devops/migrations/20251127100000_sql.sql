@@ -0,0 +1,18 @@
+-- +goose Up
+-- Полнотекстовый поиск по вопросам. Каждое поле индексируется в русской и английской
+-- конфигурациях, чтобы находились обе формы слов в смешанных текстах; заголовок весит
+-- больше текста вопроса, текст - больше объяснения.
+ALTER TABLE questions ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(content, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(content, '')), 'B') ||
+    setweight(to_tsvector('russian', coalesce(explanation, '')), 'C') ||
+    setweight(to_tsvector('english', coalesce(explanation, '')), 'C')
+) STORED;
+
+CREATE INDEX questions_search_idx ON questions USING GIN (search_vector);
+
+-- +goose Down
+DROP INDEX IF EXISTS questions_search_idx;
+ALTER TABLE questions DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Статистика ответов для сортировки списка вопросов считается по question_id,
+-- а уникальный ключ (user_id, question_id) для такого отбора не подходит
+CREATE INDEX user_question_progress_question_idx ON user_question_progress (question_id) INCLUDE (is_correct, attempts);
+
+CREATE INDEX questions_created_at_idx ON questions (created_at DESC, id DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS questions_created_at_idx;
+DROP INDEX IF EXISTS user_question_progress_question_idx;
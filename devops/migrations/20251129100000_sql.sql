@@ -0,0 +1,10 @@
+-- +goose Up
+-- Ключ вопроса из файла импорта. Повторный импорт с тем же ключом обновляет вопрос,
+-- вопросы, созданные через API, ключа не имеют
+ALTER TABLE questions ADD COLUMN external_key TEXT;
+
+CREATE UNIQUE INDEX questions_external_key_idx ON questions (external_key);
+
+-- +goose Down
+DROP INDEX IF EXISTS questions_external_key_idx;
+ALTER TABLE questions DROP COLUMN IF EXISTS external_key;
@@ -0,0 +1,66 @@
+-- +goose Up
+-- Неизменяемые ревизии вопроса: снимок содержимого после создания, каждой правки и отката
+CREATE TABLE question_revisions (
+    id SERIAL PRIMARY KEY,
+    question_id INT NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    revision INT NOT NULL,
+    -- Кто сохранил ревизию; NULL для начального наполнения и импорта без автора
+    editor_id INT REFERENCES users(id) ON DELETE SET NULL,
+    -- Номер ревизии, к которой вопрос откатили
+    rolled_back_from INT,
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    difficulty TEXT,
+    type TEXT NOT NULL,
+    options JSONB,
+    code_language TEXT,
+    starter_code TEXT,
+    answer_key JSONB,
+    explanation TEXT,
+    technologies TEXT[] NOT NULL DEFAULT '{}',
+    company_tag TEXT[],
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (question_id, revision)
+);
+
+-- Ревизии не меняются; исключение - обнуление editor_id при удалении пользователя
+-- +goose StatementBegin
+CREATE FUNCTION question_revisions_immutable() RETURNS trigger AS $$
+BEGIN
+    IF NEW.editor_id IS NULL AND to_jsonb(NEW) - 'editor_id' = to_jsonb(OLD) - 'editor_id' THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'question revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER question_revisions_immutable
+    BEFORE UPDATE ON question_revisions
+    FOR EACH ROW EXECUTE FUNCTION question_revisions_immutable();
+
+-- Номер текущей ревизии вопроса
+ALTER TABLE questions ADD COLUMN revision INT NOT NULL DEFAULT 1;
+
+-- Существующие вопросы получают первую ревизию с текущим содержимым
+INSERT INTO question_revisions (question_id, revision, editor_id, title, content, difficulty, type, options,
+                                code_language, starter_code, answer_key, explanation, technologies, company_tag,
+                                created_at)
+SELECT q.id, 1, q.author_id, q.title, q.content, q.difficulty, q.type, q.options,
+       q.code_language, q.starter_code, q.answer_key, q.explanation,
+       ARRAY(SELECT t.name FROM question_technologies qt
+             JOIN technologies t ON t.id = qt.technology_id
+             WHERE qt.question_id = q.id
+             ORDER BY t.name),
+       q.company_tag, q.updated_at
+FROM questions q;
+
+-- Ревизия, по которой проверен последний ответ. У ответов, данных до появления ревизий, NULL:
+-- неизвестно, какое содержимое вопроса видел пользователь
+ALTER TABLE user_question_progress ADD COLUMN revision_id INT REFERENCES question_revisions(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE user_question_progress DROP COLUMN IF EXISTS revision_id;
+ALTER TABLE questions DROP COLUMN IF EXISTS revision;
+DROP TABLE IF EXISTS question_revisions;
+DROP FUNCTION IF EXISTS question_revisions_immutable();
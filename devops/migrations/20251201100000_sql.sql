@@ -0,0 +1,22 @@
+-- +goose Up
+-- Статус публикации вопроса. Вопросы, предложенные пользователями, создаются черновиками (draft)
+-- и попадают в очередь модерации; rejected - отклонен с причиной, merged - дубликат опубликованного
+ALTER TABLE questions
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'published'
+        CHECK (status IN ('draft', 'published', 'rejected', 'merged')),
+    ADD COLUMN reviewer_id INT REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN review_comment TEXT,
+    ADD COLUMN reviewed_at TIMESTAMPTZ,
+    -- Опубликованный вопрос, с которым объединен дубликат
+    ADD COLUMN duplicate_of INT REFERENCES questions(id) ON DELETE SET NULL;
+
+CREATE INDEX questions_status_idx ON questions (status, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS questions_status_idx;
+ALTER TABLE questions
+    DROP COLUMN IF EXISTS duplicate_of,
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS review_comment,
+    DROP COLUMN IF EXISTS reviewer_id,
+    DROP COLUMN IF EXISTS status;
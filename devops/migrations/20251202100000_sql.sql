@@ -0,0 +1,30 @@
+-- +goose Up
+-- Расписание интервального повторения (SM-2): коэффициент легкости, текущий интервал в днях,
+-- число верных ответов подряд, число забываний и момент, когда вопрос снова нужно повторить
+ALTER TABLE user_question_progress
+    ADD COLUMN ease_factor DOUBLE PRECISION NOT NULL DEFAULT 2.5,
+    ADD COLUMN interval_days INT NOT NULL DEFAULT 0,
+    ADD COLUMN repetitions INT NOT NULL DEFAULT 0,
+    ADD COLUMN lapses INT NOT NULL DEFAULT 0,
+    ADD COLUMN due_at TIMESTAMPTZ;
+
+-- Уже отвеченные вопросы считаются один раз повторенными: верно отвеченные - с первым
+-- интервалом в один день, неверно отвеченные - сразу к повторению
+UPDATE user_question_progress
+SET repetitions = CASE WHEN is_correct THEN 1 ELSE 0 END,
+    lapses = CASE WHEN is_correct THEN 0 ELSE 1 END,
+    interval_days = CASE WHEN is_correct THEN 1 ELSE 0 END,
+    due_at = CASE WHEN is_correct THEN answered_at + INTERVAL '1 day' ELSE answered_at END;
+
+ALTER TABLE user_question_progress ALTER COLUMN due_at SET NOT NULL;
+
+CREATE INDEX user_question_progress_due_idx ON user_question_progress (user_id, due_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS user_question_progress_due_idx;
+ALTER TABLE user_question_progress
+    DROP COLUMN IF EXISTS due_at,
+    DROP COLUMN IF EXISTS lapses,
+    DROP COLUMN IF EXISTS repetitions,
+    DROP COLUMN IF EXISTS interval_days,
+    DROP COLUMN IF EXISTS ease_factor;
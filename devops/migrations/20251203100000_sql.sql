@@ -0,0 +1,14 @@
+-- +goose Up
+-- Технологии менторов и заявок хранятся в нижнем регистре, чтобы фильтр каталога
+-- по tags не зависел от написания и обслуживался GIN индексом
+UPDATE mentors
+SET tags = ARRAY(SELECT DISTINCT lower(btrim(t)) FROM unnest(tags) t WHERE btrim(t) <> '')
+WHERE tags IS NOT NULL;
+
+UPDATE mentor_applications
+SET tags = ARRAY(SELECT DISTINCT lower(btrim(t)) FROM unnest(tags) t WHERE btrim(t) <> '')
+WHERE tags IS NOT NULL;
+
+-- +goose Down
+-- Исходное написание технологий не сохранилось
+SELECT 1;
@@ -0,0 +1,28 @@
+-- +goose Up
+-- Оплаты и журнал движения денег нужны для сверки с провайдером, поэтому удаление
+-- пользователя, ментора или занятия не должно их стирать: такое удаление запрещается,
+-- пока есть оплаты
+ALTER TABLE payments
+    DROP CONSTRAINT payments_booking_id_fkey,
+    DROP CONSTRAINT payments_payer_id_fkey,
+    DROP CONSTRAINT payments_mentor_id_fkey,
+    ADD CONSTRAINT payments_booking_id_fkey FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE RESTRICT,
+    ADD CONSTRAINT payments_payer_id_fkey FOREIGN KEY (payer_id) REFERENCES users(id) ON DELETE RESTRICT,
+    ADD CONSTRAINT payments_mentor_id_fkey FOREIGN KEY (mentor_id) REFERENCES mentors(id) ON DELETE RESTRICT;
+
+ALTER TABLE ledger_entries
+    DROP CONSTRAINT ledger_entries_payment_id_fkey,
+    ADD CONSTRAINT ledger_entries_payment_id_fkey FOREIGN KEY (payment_id) REFERENCES payments(id) ON DELETE RESTRICT;
+
+-- +goose Down
+ALTER TABLE ledger_entries
+    DROP CONSTRAINT ledger_entries_payment_id_fkey,
+    ADD CONSTRAINT ledger_entries_payment_id_fkey FOREIGN KEY (payment_id) REFERENCES payments(id) ON DELETE CASCADE;
+
+ALTER TABLE payments
+    DROP CONSTRAINT payments_booking_id_fkey,
+    DROP CONSTRAINT payments_payer_id_fkey,
+    DROP CONSTRAINT payments_mentor_id_fkey,
+    ADD CONSTRAINT payments_booking_id_fkey FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE CASCADE,
+    ADD CONSTRAINT payments_payer_id_fkey FOREIGN KEY (payer_id) REFERENCES users(id) ON DELETE CASCADE,
+    ADD CONSTRAINT payments_mentor_id_fkey FOREIGN KEY (mentor_id) REFERENCES mentors(id) ON DELETE CASCADE;